@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+// Attendance is one clock-in/clock-out session. Timestamps come from the
+// server, WorkDate is the date of the clock-in in the business time zone. A
+// session without a clock out after its work date has passed is a missing
+// clock-out.
+type Attendance struct {
+	ID                uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID        uint       `gorm:"column:employee_id;index:idx_attendance_employee_date"`
+	WorkDate          time.Time  `gorm:"column:work_date;type:date;index:idx_attendance_employee_date"`
+	ClockIn           time.Time  `gorm:"column:clock_in"`
+	ClockOut          *time.Time `gorm:"column:clock_out"`
+	ClockInLatitude   *float64   `gorm:"column:clock_in_latitude"`
+	ClockInLongitude  *float64   `gorm:"column:clock_in_longitude"`
+	ClockOutLatitude  *float64   `gorm:"column:clock_out_latitude"`
+	ClockOutLongitude *float64   `gorm:"column:clock_out_longitude"`
+	Notes             string     `gorm:"column:notes"`
+	CreatedAt         *time.Time `gorm:"column:created_at"`
+	UpdatedAt         *time.Time `gorm:"column:updated_at"`
+}
+
+// MissingClockOut reports whether the session was left open past its day,
+// today is the current date in the business time zone.
+func (a Attendance) MissingClockOut(today time.Time) bool {
+	return a.ClockOut == nil && today.After(a.WorkDate)
+}
+
+type AttendanceRequest struct {
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	Notes     string   `json:"notes"`
+}
+
+type AttendanceResponse struct {
+	Id                uint       `json:"id"`
+	EmployeeId        uint       `json:"employee_id"`
+	WorkDate          time.Time  `json:"work_date"`
+	ClockIn           time.Time  `json:"clock_in"`
+	ClockOut          *time.Time `json:"clock_out,omitempty"`
+	ClockInLatitude   *float64   `json:"clock_in_latitude,omitempty"`
+	ClockInLongitude  *float64   `json:"clock_in_longitude,omitempty"`
+	ClockOutLatitude  *float64   `json:"clock_out_latitude,omitempty"`
+	ClockOutLongitude *float64   `json:"clock_out_longitude,omitempty"`
+	Notes             string     `json:"notes,omitempty"`
+}
+
+// DailyAttendance summarises the sessions of one day, WorkedMinutes only
+// counts closed sessions.
+type DailyAttendance struct {
+	Date            time.Time  `json:"date"`
+	FirstClockIn    time.Time  `json:"first_clock_in"`
+	LastClockOut    *time.Time `json:"last_clock_out,omitempty"`
+	Sessions        int        `json:"sessions"`
+	WorkedMinutes   int        `json:"worked_minutes"`
+	MissingClockOut bool       `json:"missing_clock_out"`
+}
+
+type TimesheetResponse struct {
+	EmployeeId         uint              `json:"employee_id"`
+	Month              string            `json:"month"`
+	Days               []DailyAttendance `json:"days"`
+	TotalWorkedMinutes int               `json:"total_worked_minutes"`
+	MissingClockOuts   int               `json:"missing_clock_outs"`
+}
@@ -0,0 +1,188 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrInvalidWeekday = errors.New("working days must be weekday names like mon, tue, wed")
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday,
+}
+
+// ParseWeekdays turns names like "mon" or "Monday" into the comma separated
+// form stored in WorkCalendar.WorkingDays, ordered from Sunday.
+func ParseWeekdays(names []string) (string, error) {
+	var set [7]bool
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		day, ok := weekdayNames[name]
+		if !ok {
+			return "", ErrInvalidWeekday
+		}
+
+		set[day] = true
+	}
+
+	var days []string
+	for day, ok := range set {
+		if ok {
+			days = append(days, strings.ToLower(time.Weekday(day).String()[:3]))
+		}
+	}
+
+	if len(days) == 0 {
+		return "", ErrInvalidWeekday
+	}
+
+	return strings.Join(days, ","), nil
+}
+
+// WorkCalendar defines the working week and public holidays of a country or
+// region. Employees without a calendar work Monday to Friday.
+type WorkCalendar struct {
+	ID          uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	Name        string     `gorm:"column:name;uniqueIndex"`
+	Country     string     `gorm:"column:country;size:2"`
+	Region      string     `gorm:"column:region"`
+	WorkingDays string     `gorm:"column:working_days"`
+	Holidays    []Holiday  `gorm:"foreignKey:CalendarID"`
+	CreatedAt   *time.Time `gorm:"column:created_at"`
+	UpdatedAt   *time.Time `gorm:"column:updated_at"`
+}
+
+type Holiday struct {
+	ID         uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	CalendarID uint       `gorm:"column:calendar_id;uniqueIndex:idx_holiday_calendar_date"`
+	Date       time.Time  `gorm:"column:date;type:date;uniqueIndex:idx_holiday_calendar_date"`
+	Name       string     `gorm:"column:name"`
+	CreatedAt  *time.Time `gorm:"column:created_at"`
+}
+
+func (c WorkCalendar) worksOn(day time.Weekday) bool {
+	name := strings.ToLower(day.String()[:3])
+	for _, d := range strings.Split(c.WorkingDays, ",") {
+		if d == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HolidayOn returns the holiday on date, Holidays must be loaded.
+func (c WorkCalendar) HolidayOn(date time.Time) (Holiday, bool) {
+	for _, h := range c.Holidays {
+		if sameDay(h.Date, date) {
+			return h, true
+		}
+	}
+
+	return Holiday{}, false
+}
+
+func (c WorkCalendar) IsWorkingDay(date time.Time) bool {
+	if !c.worksOn(date.Weekday()) {
+		return false
+	}
+
+	_, holiday := c.HolidayOn(date)
+	return !holiday
+}
+
+// WorkingDaysBetween counts the working days from start to end, both
+// inclusive.
+func (c WorkCalendar) WorkingDaysBetween(start, end time.Time) int {
+	var workingDays [7]bool
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		workingDays[day] = c.worksOn(day)
+	}
+
+	holidays := make(map[calendarDay]struct{}, len(c.Holidays))
+	for _, h := range c.Holidays {
+		holidays[dayOf(h.Date)] = struct{}{}
+	}
+
+	days := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if !workingDays[d.Weekday()] {
+			continue
+		}
+
+		if _, holiday := holidays[dayOf(d)]; !holiday {
+			days++
+		}
+	}
+
+	return days
+}
+
+type calendarDay struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dayOf(t time.Time) calendarDay {
+	y, m, d := t.Date()
+	return calendarDay{year: y, month: m, day: d}
+}
+
+func sameDay(a, b time.Time) bool {
+	return dayOf(a) == dayOf(b)
+}
+
+type WorkCalendarRequest struct {
+	Name        string   `json:"name"`
+	Country     string   `json:"country"`
+	Region      string   `json:"region"`
+	WorkingDays []string `json:"working_days"`
+}
+
+type HolidayRequest struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+type CalendarAssignmentRequest struct {
+	CalendarId *uint `json:"calendar_id"`
+}
+
+type WorkCalendarResponse struct {
+	Id          uint              `json:"id"`
+	Name        string            `json:"name"`
+	Country     string            `json:"country,omitempty"`
+	Region      string            `json:"region,omitempty"`
+	WorkingDays []string          `json:"working_days"`
+	Holidays    []HolidayResponse `json:"holidays,omitempty"`
+}
+
+type HolidayResponse struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+type HolidayImportResponse struct {
+	Imported int `json:"imported"`
+}
+
+type WorkingDaysResponse struct {
+	From        time.Time `json:"from"`
+	To          time.Time `json:"to"`
+	WorkingDays int       `json:"working_days"`
+}
+
+type DateCheckResponse struct {
+	Date       time.Time `json:"date"`
+	CalendarId uint      `json:"calendar_id"`
+	WorkingDay bool      `json:"working_day"`
+	Holiday    string    `json:"holiday,omitempty"`
+}
@@ -0,0 +1,110 @@
+package domain
+
+import "time"
+
+const (
+	// PermissionChecklistManage lets HR edit the templates and assign tasks.
+	PermissionChecklistManage = "checklists.manage"
+	// PermissionChecklistWrite lets employees complete tasks, their API key
+	// has to belong to them.
+	PermissionChecklistWrite = "checklists.write"
+)
+
+const (
+	ChecklistOnboarding  = "onboarding"
+	ChecklistOffboarding = "offboarding"
+)
+
+func IsValidChecklistKind(kind string) bool {
+	return kind == ChecklistOnboarding || kind == ChecklistOffboarding
+}
+
+// ChecklistItem is one entry of the onboarding or offboarding template. Tasks
+// are due DueDays after the hire date for onboarding and after the
+// termination date for offboarding, negative values are due before.
+type ChecklistItem struct {
+	ID          uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	Kind        string     `gorm:"column:kind;index"`
+	Title       string     `gorm:"column:title"`
+	Description string     `gorm:"column:description"`
+	DueDays     int        `gorm:"column:due_days"`
+	AssigneeID  *uint      `gorm:"column:assignee_id"`
+	CreatedAt   *time.Time `gorm:"column:created_at"`
+	UpdatedAt   *time.Time `gorm:"column:updated_at"`
+}
+
+// NewTask instantiates the item for an employee, baseDate is the hire or
+// termination date.
+func (i ChecklistItem) NewTask(employeeId uint, baseDate time.Time) EmployeeTask {
+	itemId := i.ID
+	return EmployeeTask{
+		EmployeeID:  employeeId,
+		ItemID:      &itemId,
+		Kind:        i.Kind,
+		Title:       i.Title,
+		Description: i.Description,
+		DueDate:     baseDate.AddDate(0, 0, i.DueDays),
+		AssigneeID:  i.AssigneeID,
+	}
+}
+
+type EmployeeTask struct {
+	ID            uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID    uint       `gorm:"column:employee_id;index"`
+	ItemID        *uint      `gorm:"column:item_id"`
+	Kind          string     `gorm:"column:kind;index"`
+	Title         string     `gorm:"column:title"`
+	Description   string     `gorm:"column:description"`
+	DueDate       time.Time  `gorm:"column:due_date;type:date"`
+	AssigneeID    *uint      `gorm:"column:assignee_id;index"`
+	CompletedAt   *time.Time `gorm:"column:completed_at"`
+	CompletedByID *uint      `gorm:"column:completed_by_id"`
+	CreatedAt     *time.Time `gorm:"column:created_at"`
+	UpdatedAt     *time.Time `gorm:"column:updated_at"`
+}
+
+func (t EmployeeTask) Overdue(today time.Time) bool {
+	return t.CompletedAt == nil && t.DueDate.Before(today)
+}
+
+// TaskFilter narrows task listings, zero values match everything.
+type TaskFilter struct {
+	EmployeeId uint
+	Kind       string
+	OpenOnly   bool
+}
+
+type ChecklistItemRequest struct {
+	Kind        string `json:"kind"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	DueDays     int    `json:"due_days"`
+	AssigneeId  *uint  `json:"assignee_id"`
+}
+
+type ChecklistItemResponse struct {
+	Id          uint   `json:"id"`
+	Kind        string `json:"kind"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	DueDays     int    `json:"due_days"`
+	AssigneeId  *uint  `json:"assignee_id,omitempty"`
+}
+
+type TaskAssignmentRequest struct {
+	AssigneeId *uint `json:"assignee_id"`
+}
+
+type TaskResponse struct {
+	Id            uint       `json:"id"`
+	EmployeeId    uint       `json:"employee_id"`
+	Kind          string     `json:"kind"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description,omitempty"`
+	DueDate       time.Time  `json:"due_date"`
+	AssigneeId    *uint      `json:"assignee_id,omitempty"`
+	Completed     bool       `json:"completed"`
+	Overdue       bool       `json:"overdue"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CompletedById *uint      `json:"completed_by_id,omitempty"`
+}
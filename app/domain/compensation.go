@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+const (
+	PermissionCompensationRead  = "compensation.read"
+	PermissionCompensationWrite = "compensation.write"
+)
+
+const (
+	PayFrequencyHourly      = "hourly"
+	PayFrequencyWeekly      = "weekly"
+	PayFrequencyBiweekly    = "biweekly"
+	PayFrequencySemiMonthly = "semi_monthly"
+	PayFrequencyMonthly     = "monthly"
+	PayFrequencyAnnual      = "annual"
+)
+
+var payFrequencies = map[string]bool{
+	PayFrequencyHourly:      true,
+	PayFrequencyWeekly:      true,
+	PayFrequencyBiweekly:    true,
+	PayFrequencySemiMonthly: true,
+	PayFrequencyMonthly:     true,
+	PayFrequencyAnnual:      true,
+}
+
+func IsValidPayFrequency(frequency string) bool {
+	return payFrequencies[frequency]
+}
+
+// Compensation is one entry of the pay history of an employee. Records are
+// never updated, a raise is a new record with a later effective date.
+type Compensation struct {
+	ID            uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID    uint       `gorm:"column:employee_id;index;uniqueIndex:idx_compensation_employee_effective"`
+	BaseSalary    string     `gorm:"column:base_salary;type:numeric(18,2)"`
+	Currency      string     `gorm:"column:currency;size:3"`
+	PayFrequency  string     `gorm:"column:pay_frequency"`
+	EffectiveDate time.Time  `gorm:"column:effective_date;type:date;uniqueIndex:idx_compensation_employee_effective"`
+	Reason        string     `gorm:"column:reason"`
+	CreatedBy     string     `gorm:"column:created_by"`
+	CreatedAt     *time.Time `gorm:"column:created_at"`
+}
+
+type CompensationRequest struct {
+	BaseSalary    string `json:"base_salary"`
+	Currency      string `json:"currency"`
+	PayFrequency  string `json:"pay_frequency"`
+	EffectiveDate string `json:"effective_date"`
+	Reason        string `json:"reason"`
+}
+
+type CompensationResponse struct {
+	Id            uint       `json:"id"`
+	EmployeeId    uint       `json:"employee_id"`
+	BaseSalary    string     `json:"base_salary"`
+	Currency      string     `json:"currency"`
+	PayFrequency  string     `json:"pay_frequency"`
+	EffectiveDate time.Time  `json:"effective_date"`
+	Reason        string     `json:"reason,omitempty"`
+	ChangePercent *string    `json:"change_percent,omitempty"`
+	CreatedBy     string     `json:"created_by,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,117 @@
+package domain
+
+import "time"
+
+// Contact details are personal data, reading them needs a permission the
+// employee profile does not.
+const (
+	PermissionContactRead  = "contacts.read"
+	PermissionContactWrite = "contacts.write"
+)
+
+const (
+	PhoneTypeMobile = "mobile"
+	PhoneTypeHome   = "home"
+	PhoneTypeWork   = "work"
+
+	AddressTypeHome    = "home"
+	AddressTypeMailing = "mailing"
+)
+
+func IsValidPhoneType(phoneType string) bool {
+	return phoneType == PhoneTypeMobile || phoneType == PhoneTypeHome || phoneType == PhoneTypeWork
+}
+
+func IsValidAddressType(addressType string) bool {
+	return addressType == AddressTypeHome || addressType == AddressTypeMailing
+}
+
+type Phone struct {
+	ID         uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID uint       `gorm:"column:employee_id;index"`
+	Type       string     `gorm:"column:type"`
+	Number     string     `gorm:"column:number;size:16"`
+	CreatedAt  *time.Time `gorm:"column:created_at"`
+	UpdatedAt  *time.Time `gorm:"column:updated_at"`
+}
+
+// Address is unique per employee and type, an employee has at most one home
+// and one mailing address.
+type Address struct {
+	ID         uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID uint       `gorm:"column:employee_id;uniqueIndex:idx_address_employee_type"`
+	Type       string     `gorm:"column:type;uniqueIndex:idx_address_employee_type"`
+	Line1      string     `gorm:"column:line1"`
+	Line2      string     `gorm:"column:line2"`
+	City       string     `gorm:"column:city"`
+	Region     string     `gorm:"column:region"`
+	PostalCode string     `gorm:"column:postal_code"`
+	Country    string     `gorm:"column:country;size:2"`
+	CreatedAt  *time.Time `gorm:"column:created_at"`
+	UpdatedAt  *time.Time `gorm:"column:updated_at"`
+}
+
+type EmergencyContact struct {
+	ID           uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID   uint       `gorm:"column:employee_id;index"`
+	Name         string     `gorm:"column:name"`
+	Relationship string     `gorm:"column:relationship"`
+	Phone        string     `gorm:"column:phone;size:16"`
+	Email        string     `gorm:"column:email"`
+	CreatedAt    *time.Time `gorm:"column:created_at"`
+	UpdatedAt    *time.Time `gorm:"column:updated_at"`
+}
+
+type PhoneRequest struct {
+	Type   string `json:"type"`
+	Number string `json:"number"`
+}
+
+type AddressRequest struct {
+	Type       string `json:"type"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2"`
+	City       string `json:"city"`
+	Region     string `json:"region"`
+	PostalCode string `json:"postal_code"`
+	Country    string `json:"country"`
+}
+
+type EmergencyContactRequest struct {
+	Name         string `json:"name"`
+	Relationship string `json:"relationship"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`
+}
+
+type PhoneResponse struct {
+	Id     uint   `json:"id"`
+	Type   string `json:"type"`
+	Number string `json:"number"`
+}
+
+type AddressResponse struct {
+	Id         uint   `json:"id"`
+	Type       string `json:"type"`
+	Line1      string `json:"line1"`
+	Line2      string `json:"line2,omitempty"`
+	City       string `json:"city"`
+	Region     string `json:"region,omitempty"`
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country"`
+}
+
+type EmergencyContactResponse struct {
+	Id           uint   `json:"id"`
+	Name         string `json:"name"`
+	Relationship string `json:"relationship"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email,omitempty"`
+}
+
+type ContactsResponse struct {
+	EmployeeId        uint                       `json:"employee_id"`
+	Phones            []PhoneResponse            `json:"phones"`
+	Addresses         []AddressResponse          `json:"addresses"`
+	EmergencyContacts []EmergencyContactResponse `json:"emergency_contacts"`
+}
@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+const (
+	CustomFieldString = "string"
+	CustomFieldNumber = "number"
+	CustomFieldDate   = "date"
+	CustomFieldEnum   = "enum"
+	CustomFieldBool   = "bool"
+)
+
+// CustomFieldOrderPrefix marks an orderBy value as a custom field, e.g.
+// cf.cost_center.
+const CustomFieldOrderPrefix = "cf."
+
+// PermissionCustomFieldManage lets admins define and delete custom fields.
+const PermissionCustomFieldManage = "custom_fields.manage"
+
+var ErrInvalidCustomFieldValue = errors.New("invalid custom field value")
+
+func IsValidCustomFieldType(fieldType string) bool {
+	switch fieldType {
+	case CustomFieldString, CustomFieldNumber, CustomFieldDate, CustomFieldEnum, CustomFieldBool:
+		return true
+	}
+
+	return false
+}
+
+// CustomFieldValues holds the custom field values of an employee keyed by
+// field name, stored as JSONB.
+type CustomFieldValues map[string]interface{}
+
+func (v CustomFieldValues) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+func (v *CustomFieldValues) Scan(src interface{}) error {
+	return scanJSON(src, v)
+}
+
+// StringList is a list of strings stored as JSONB.
+type StringList []string
+
+func (l StringList) Value() (driver.Value, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(l)
+	return string(b), err
+}
+
+func (l *StringList) Scan(src interface{}) error {
+	return scanJSON(src, l)
+}
+
+func scanJSON(src interface{}, dest interface{}) error {
+	switch s := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(s, dest)
+	case string:
+		return json.Unmarshal([]byte(s), dest)
+	}
+
+	return fmt.Errorf("cannot scan %T into %T", src, dest)
+}
+
+// CustomField is an admin defined employee attribute. Pattern only applies to
+// string fields and Options only to enum fields.
+type CustomField struct {
+	ID        uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	Name      string     `gorm:"column:name;uniqueIndex"`
+	Label     string     `gorm:"column:label"`
+	Type      string     `gorm:"column:type"`
+	Required  bool       `gorm:"column:required"`
+	Pattern   string     `gorm:"column:pattern"`
+	Options   StringList `gorm:"column:options;type:jsonb"`
+	CreatedAt *time.Time `gorm:"column:created_at"`
+	UpdatedAt *time.Time `gorm:"column:updated_at"`
+
+	pattern *regexp.Regexp
+}
+
+// CompilePattern compiles Pattern once for the values the field normalizes
+// afterwards.
+func (f *CustomField) CompilePattern() error {
+	if f.Pattern == "" {
+		return nil
+	}
+
+	pattern, err := regexp.Compile(f.Pattern)
+	if err != nil {
+		return err
+	}
+
+	f.pattern = pattern
+	return nil
+}
+
+// Normalize checks value against the field and returns it in the form it is
+// stored in, numbers as float64 and dates as YYYY-MM-DD strings. The pattern
+// is compiled here when CompilePattern was not called.
+func (f CustomField) Normalize(value interface{}) (interface{}, error) {
+	invalid := fmt.Errorf("%w for %s, expected %s", ErrInvalidCustomFieldValue, f.Name, f.Type)
+
+	switch f.Type {
+	case CustomFieldNumber:
+		switch n := value.(type) {
+		case float64:
+			return n, nil
+		case int:
+			return float64(n), nil
+		}
+
+		return nil, invalid
+	case CustomFieldBool:
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+
+		return nil, invalid
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return nil, invalid
+	}
+
+	switch f.Type {
+	case CustomFieldDate:
+		if _, err := time.Parse("2006-01-02", s); err != nil {
+			return nil, invalid
+		}
+	case CustomFieldEnum:
+		for _, option := range f.Options {
+			if s == option {
+				return s, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w for %s, expected one of %v", ErrInvalidCustomFieldValue, f.Name, []string(f.Options))
+	case CustomFieldString:
+		if f.Pattern != "" {
+			pattern := f.pattern
+			if pattern == nil {
+				pattern, _ = regexp.Compile(f.Pattern)
+			}
+
+			if pattern == nil || !pattern.MatchString(s) {
+				return nil, fmt.Errorf("%w for %s, does not match %s", ErrInvalidCustomFieldValue, f.Name, f.Pattern)
+			}
+		}
+	}
+
+	return s, nil
+}
+
+// ParseQuery converts a query string value to the type of the field.
+func (f CustomField) ParseQuery(raw string) (interface{}, error) {
+	switch f.Type {
+	case CustomFieldNumber:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s, expected %s", ErrInvalidCustomFieldValue, f.Name, f.Type)
+		}
+
+		return n, nil
+	case CustomFieldBool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w for %s, expected %s", ErrInvalidCustomFieldValue, f.Name, f.Type)
+		}
+
+		return b, nil
+	}
+
+	return f.Normalize(raw)
+}
+
+type CustomFieldRequest struct {
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Pattern  string   `json:"pattern"`
+	Options  []string `json:"options"`
+}
+
+type CustomFieldResponse struct {
+	Id       uint     `json:"id"`
+	Name     string   `json:"name"`
+	Label    string   `json:"label"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required"`
+	Pattern  string   `json:"pattern,omitempty"`
+	Options  []string `json:"options,omitempty"`
+}
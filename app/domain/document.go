@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// Documents such as contracts and identity cards are personal data, like
+// contacts they need their own permissions.
+const (
+	PermissionDocumentRead  = "documents.read"
+	PermissionDocumentWrite = "documents.write"
+)
+
+const (
+	DocumentContract    = "contract"
+	DocumentIdentity    = "identity"
+	DocumentCertificate = "certificate"
+	DocumentOther       = "other"
+)
+
+func IsValidDocumentCategory(category string) bool {
+	switch category {
+	case DocumentContract, DocumentIdentity, DocumentCertificate, DocumentOther:
+		return true
+	}
+
+	return false
+}
+
+// Document is the metadata of a file kept in storage under StorageKey.
+// Checksum is the hex encoded SHA-256 of the content.
+type Document struct {
+	ID          uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID  uint       `gorm:"column:employee_id;index"`
+	Category    string     `gorm:"column:category"`
+	FileName    string     `gorm:"column:file_name"`
+	ContentType string     `gorm:"column:content_type"`
+	Size        int64      `gorm:"column:size"`
+	Checksum    string     `gorm:"column:checksum;size:64"`
+	StorageKey  string     `gorm:"column:storage_key;uniqueIndex"`
+	CreatedAt   *time.Time `gorm:"column:created_at"`
+	UpdatedAt   *time.Time `gorm:"column:updated_at"`
+}
+
+// DocumentRequest holds the form fields sent along with the file.
+type DocumentRequest struct {
+	Category string
+	FileName string
+}
+
+type DocumentResponse struct {
+	Id          uint       `json:"id"`
+	EmployeeId  uint       `json:"employee_id"`
+	Category    string     `json:"category"`
+	FileName    string     `json:"file_name"`
+	ContentType string     `json:"content_type"`
+	Size        int64      `json:"size"`
+	Checksum    string     `json:"checksum"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
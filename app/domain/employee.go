@@ -6,37 +6,135 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	StatusPendingStart = "pending_start"
+	StatusActive       = "active"
+	StatusOnLeave      = "on_leave"
+	StatusTerminated   = "terminated"
+)
+
+// CurrentEmploymentStatuses are the statuses of the people who are employed
+// or about to start. Lists of employees show them unless other statuses are
+// asked for, terminated employees are only kept for the records.
+var CurrentEmploymentStatuses = []string{StatusPendingStart, StatusActive, StatusOnLeave}
+
+// EmploymentStatuses lists every status.
+var EmploymentStatuses = []string{StatusPendingStart, StatusActive, StatusOnLeave, StatusTerminated}
+
+// employmentTransitions lists the statuses an employee can move to from each
+// status.
+var employmentTransitions = map[string][]string{
+	StatusPendingStart: {StatusActive, StatusTerminated},
+	StatusActive:       {StatusOnLeave, StatusTerminated},
+	StatusOnLeave:      {StatusActive, StatusTerminated},
+	StatusTerminated:   {StatusActive, StatusPendingStart},
+}
+
+func IsValidEmploymentStatus(status string) bool {
+	_, ok := employmentTransitions[status]
+	return ok
+}
+
+func CanTransitionEmploymentStatus(from, to string) bool {
+	for _, s := range employmentTransitions[from] {
+		if s == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HireDatePolicy bounds the hire dates of new, updated and rehired
+// employees.
+type HireDatePolicy struct {
+	MinDate       time.Time
+	MaxFutureDays int
+}
+
 type Employee struct {
-	ID        uint         		 `gorm:"column:id;autoIncrement;primaryKey"`
-	FirstName string       		 `gorm:"column:first_name"`
-	LastName  string       		 `gorm:"column:last_name"`
-	Email     string       		 `gorm:"column:email;index"`
-	HireDate  time.Time   		 `gorm:"column:hire_date;type:date;index"`
-	CreatedAt *time.Time   		 `gorm:"column:created_at"`
-	UpdatedAt *time.Time   		 `gorm:"column:updated_at"`
-	DeletedAt *gorm.DeletedAt    `gorm:"column:deleted_at;index"`
+	ID                uint              `gorm:"column:id;autoIncrement;primaryKey"`
+	FirstName         string            `gorm:"column:first_name"`
+	LastName          string            `gorm:"column:last_name"`
+	PreferredName     string            `gorm:"column:preferred_name"`
+	Pronouns          string            `gorm:"column:pronouns;size:32"`
+	NameLocale        string            `gorm:"column:name_locale;size:35"`
+	Email             string            `gorm:"column:email;index"`
+	HireDate          time.Time         `gorm:"column:hire_date;type:date;index"`
+	Status            string            `gorm:"column:status;not null;default:active;index"`
+	TerminationDate   *time.Time        `gorm:"column:termination_date;type:date"`
+	TerminationReason string            `gorm:"column:termination_reason"`
+	ManagerID         *uint             `gorm:"column:manager_id;index"`
+	CalendarID        *uint             `gorm:"column:calendar_id;index"`
+	CustomFields      CustomFieldValues `gorm:"column:custom_fields;type:jsonb"`
+	PhotoVersion      string            `gorm:"column:photo_version;size:16"`
+	Jobs              []JobAssignment   `gorm:"foreignKey:EmployeeID"`
+	CreatedAt         *time.Time        `gorm:"column:created_at"`
+	UpdatedAt         *time.Time        `gorm:"column:updated_at"`
+	DeletedAt         *gorm.DeletedAt   `gorm:"column:deleted_at;index"`
 }
 
+// EmployeeRequest creates or updates an employee. On update a missing
+// custom_fields, preferred_name, pronouns or name_locale keeps the stored
+// value, otherwise it is replaced.
 type EmployeeRequest struct {
-	FirstName string       		 `json:"first_name"`
-	LastName  string       		 `json:"last_name"`
-	Email     string       		 `json:"email"`
-	HireDate  string 		     `json:"hire_date"`
+	FirstName     string                 `json:"first_name"`
+	LastName      string                 `json:"last_name"`
+	PreferredName *string                `json:"preferred_name"`
+	Pronouns      *string                `json:"pronouns"`
+	NameLocale    *string                `json:"name_locale"`
+	Email         string                 `json:"email"`
+	HireDate      string                 `json:"hire_date"`
+	CustomFields  map[string]interface{} `json:"custom_fields"`
+}
+
+type TerminationRequest struct {
+	TerminationDate string `json:"termination_date"`
+	Reason          string `json:"reason"`
+}
+
+type RehireRequest struct {
+	HireDate string `json:"hire_date"`
+}
+
+// ManagerRequest sets the manager of an employee, a null manager_id removes
+// the manager.
+type ManagerRequest struct {
+	ManagerId *uint `json:"manager_id"`
+}
+
+// EmployeeFilter narrows FindAll. CustomFields holds exact values keyed by
+// field name.
+type EmployeeFilter struct {
+	Statuses     []string
+	CustomFields map[string]interface{}
 }
 
 type EmployeeResponse struct {
-	Id        uint  			 `json:"id"`
-	FirstName string       		 `json:"first_name"`
-	LastName  string       		 `json:"last_name"`
-	Email     string       		 `json:"email"`
-	HireDate  time.Time   		 `json:"hire_date"`
-	CreatedAt *time.Time   		 `json:"created_at,omitempty"`
-	UpdatedAt *time.Time   		 `json:"updated_at,omitempty"`
+	Id                uint                   `json:"id"`
+	FirstName         string                 `json:"first_name"`
+	LastName          string                 `json:"last_name"`
+	PreferredName     string                 `json:"preferred_name,omitempty"`
+	Pronouns          string                 `json:"pronouns,omitempty"`
+	NameLocale        string                 `json:"name_locale,omitempty"`
+	DisplayName       string                 `json:"display_name"`
+	Email             string                 `json:"email"`
+	HireDate          time.Time              `json:"hire_date"`
+	Status            string                 `json:"status"`
+	TerminationDate   *time.Time             `json:"termination_date,omitempty"`
+	TerminationReason string                 `json:"termination_reason,omitempty"`
+	ManagerId         *uint                  `json:"manager_id,omitempty"`
+	CalendarId        *uint                  `json:"calendar_id,omitempty"`
+	CurrentJob        *JobAssignmentResponse `json:"current_job,omitempty"`
+	CustomFields      map[string]interface{} `json:"custom_fields,omitempty"`
+	PhotoUrl          string                 `json:"photo_url,omitempty"`
+	CreatedAt         *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time             `json:"updated_at,omitempty"`
 }
 
 type PaginationResponse struct {
-	PageNum 	int			`json:"page_number"`
-	PageSize 	int			`json:"page_size"`
-	TotalPage	int64		`json:"total_page"`	
-	Data 		interface{}	`json:"data"`
+	PageNum   int         `json:"page_number"`
+	PageSize  int         `json:"page_size"`
+	TotalPage int64       `json:"total_page"`
+	Data      interface{} `json:"data"`
 }
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+const (
+	EventEmployeeCreated = "employee.created"
+	EventEmployeeUpdated = "employee.updated"
+	EventEmployeeDeleted = "employee.deleted"
+)
+
+var EventTypes = []string{EventEmployeeCreated, EventEmployeeUpdated, EventEmployeeDeleted}
+
+func IsValidEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OutboxEvent is a domain event written in the same transaction as the change
+// it describes. The dispatcher delivers it afterwards, events of the same
+// employee in id order.
+type OutboxEvent struct {
+	ID            uint64     `gorm:"column:id;autoIncrement;primaryKey;index:idx_outbox_events_pending,priority:2"`
+	Type          string     `gorm:"column:type;size:64;not null"`
+	EmployeeID    uint       `gorm:"column:employee_id;not null;index:idx_outbox_events_pending,priority:1,where:dispatched_at IS NULL AND failed_at IS NULL"`
+	Payload       string     `gorm:"column:payload;type:jsonb;not null"`
+	Attempts      int        `gorm:"column:attempts;not null;default:0"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;not null"`
+	LastError     string     `gorm:"column:last_error"`
+	DispatchedAt  *time.Time `gorm:"column:dispatched_at;index"`
+	FailedAt      *time.Time `gorm:"column:failed_at"`
+	CreatedAt     time.Time  `gorm:"column:created_at"`
+}
+
+// OutboxSnapshot is the outbox as one statement sees it. LatestId is the
+// highest committed event id. Transactions with an id below Xmin have
+// finished, transactions that start later get an id of at least Xmax.
+type OutboxSnapshot struct {
+	LatestId uint64
+	Xmin     uint64
+	Xmax     uint64
+}
+
+// Event is what sinks receive. Deliveries are at least once, consumers
+// should ignore ids they have already seen.
+type Event struct {
+	Id         string          `json:"id"`
+	Type       string          `json:"type"`
+	EmployeeId uint            `json:"employee_id"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func (o OutboxEvent) Event() Event {
+	return Event{
+		Id:         strconv.FormatUint(o.ID, 10),
+		Type:       o.Type,
+		EmployeeId: o.EmployeeID,
+		OccurredAt: o.CreatedAt,
+		Data:       json.RawMessage(o.Payload),
+	}
+}
+
+// EmployeeEventData is the state of the employee after the change, or before
+// it for deletions.
+type EmployeeEventData struct {
+	Id              uint       `json:"id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	PreferredName   string     `json:"preferred_name,omitempty"`
+	DisplayName     string     `json:"display_name"`
+	Email           string     `json:"email"`
+	HireDate        string     `json:"hire_date"`
+	Status          string     `json:"status"`
+	TerminationDate string     `json:"termination_date,omitempty"`
+	ManagerId       *uint      `json:"manager_id,omitempty"`
+	Department      string     `json:"department,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// NewEmployeeEvent builds the outbox event of eventType for e. Jobs should
+// only hold the assignments covering today, the latest one gives the
+// department.
+func NewEmployeeEvent(eventType string, e Employee) (OutboxEvent, error) {
+	data := EmployeeEventData{
+		Id:            e.ID,
+		FirstName:     e.FirstName,
+		LastName:      e.LastName,
+		PreferredName: e.PreferredName,
+		DisplayName:   e.DisplayName(),
+		Email:         e.Email,
+		HireDate:      e.HireDate.Format("2006-01-02"),
+		Status:        e.Status,
+		ManagerId:     e.ManagerID,
+		UpdatedAt:     e.UpdatedAt,
+	}
+
+	if e.TerminationDate != nil {
+		data.TerminationDate = e.TerminationDate.Format("2006-01-02")
+	}
+
+	var current *JobAssignment
+	for i := range e.Jobs {
+		if current == nil || e.Jobs[i].StartDate.After(current.StartDate) {
+			current = &e.Jobs[i]
+		}
+	}
+
+	if current != nil {
+		data.Department = current.Department
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return OutboxEvent{}, err
+	}
+
+	return OutboxEvent{
+		Type:       eventType,
+		EmployeeID: e.ID,
+		Payload:    string(payload),
+	}, nil
+}
@@ -0,0 +1,134 @@
+package domain
+
+import "time"
+
+// PermissionLeaveDecide lets managers approve and reject the leave requests
+// of their reports, their API key has to belong to them.
+const PermissionLeaveDecide = "leave.decide"
+
+const (
+	LeaveStatusPending  = "pending"
+	LeaveStatusApproved = "approved"
+	LeaveStatusRejected = "rejected"
+)
+
+// LeaveType describes a kind of time off. Employees are entitled to
+// AnnualDays each calendar year, prorated by month in the year they are
+// hired, and up to CarryOverDays unused days move to the next year.
+type LeaveType struct {
+	ID            uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	Code          string     `gorm:"column:code;uniqueIndex"`
+	Name          string     `gorm:"column:name"`
+	AnnualDays    int        `gorm:"column:annual_days"`
+	CarryOverDays int        `gorm:"column:carry_over_days"`
+	CreatedAt     *time.Time `gorm:"column:created_at"`
+	UpdatedAt     *time.Time `gorm:"column:updated_at"`
+}
+
+// EntitlementFor returns the days accrued in year by an employee hired on
+// hireDate.
+func (t LeaveType) EntitlementFor(hireDate time.Time, year int) int {
+	switch {
+	case hireDate.Year() > year:
+		return 0
+	case hireDate.Year() < year:
+		return t.AnnualDays
+	}
+
+	months := 12 - int(hireDate.Month()) + 1
+	return t.AnnualDays * months / 12
+}
+
+type LeaveTypeRequest struct {
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	AnnualDays    int    `json:"annual_days"`
+	CarryOverDays int    `json:"carry_over_days"`
+}
+
+type LeaveTypeResponse struct {
+	Id            uint   `json:"id"`
+	Code          string `json:"code"`
+	Name          string `json:"name"`
+	AnnualDays    int    `json:"annual_days"`
+	CarryOverDays int    `json:"carry_over_days"`
+}
+
+// LeaveBalance holds the days of one leave type an employee has in a year.
+// Used only counts approved leave.
+type LeaveBalance struct {
+	ID          uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID  uint       `gorm:"column:employee_id;uniqueIndex:idx_leave_balance"`
+	LeaveTypeID uint       `gorm:"column:leave_type_id;uniqueIndex:idx_leave_balance"`
+	Year        int        `gorm:"column:year;uniqueIndex:idx_leave_balance"`
+	Entitled    int        `gorm:"column:entitled"`
+	CarriedOver int        `gorm:"column:carried_over"`
+	Used        int        `gorm:"column:used"`
+	CreatedAt   *time.Time `gorm:"column:created_at"`
+	UpdatedAt   *time.Time `gorm:"column:updated_at"`
+}
+
+// Remaining returns the days not used by approved leave.
+func (b LeaveBalance) Remaining() int {
+	return b.Entitled + b.CarriedOver - b.Used
+}
+
+type LeaveBalanceResponse struct {
+	LeaveTypeId   uint   `json:"leave_type_id"`
+	LeaveTypeCode string `json:"leave_type_code"`
+	Year          int    `json:"year"`
+	Entitled      int    `json:"entitled"`
+	CarriedOver   int    `json:"carried_over"`
+	Used          int    `json:"used"`
+	Pending       int    `json:"pending"`
+	Available     int    `json:"available"`
+}
+
+// Leave is a time off request of an employee, Days counts the working days
+// between StartDate and EndDate.
+type Leave struct {
+	ID           uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID   uint       `gorm:"column:employee_id;index"`
+	LeaveTypeID  uint       `gorm:"column:leave_type_id;index"`
+	StartDate    time.Time  `gorm:"column:start_date;type:date"`
+	EndDate      time.Time  `gorm:"column:end_date;type:date"`
+	Days         int        `gorm:"column:days"`
+	Reason       string     `gorm:"column:reason"`
+	Status       string     `gorm:"column:status;not null;default:pending;index"`
+	ApproverID   *uint      `gorm:"column:approver_id"`
+	DecisionNote string     `gorm:"column:decision_note"`
+	DecidedAt    *time.Time `gorm:"column:decided_at"`
+	CreatedAt    *time.Time `gorm:"column:created_at"`
+	UpdatedAt    *time.Time `gorm:"column:updated_at"`
+}
+
+// Overlaps reports whether the leave shares a day with start to end.
+func (l Leave) Overlaps(start, end time.Time) bool {
+	return !l.StartDate.After(end) && !start.After(l.EndDate)
+}
+
+type LeaveRequest struct {
+	LeaveTypeID uint   `json:"leave_type_id"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	Reason      string `json:"reason"`
+}
+
+type LeaveDecisionRequest struct {
+	Note string `json:"note"`
+}
+
+type LeaveResponse struct {
+	Id           uint       `json:"id"`
+	EmployeeId   uint       `json:"employee_id"`
+	LeaveTypeId  uint       `json:"leave_type_id"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      time.Time  `json:"end_date"`
+	Days         int        `json:"days"`
+	Reason       string     `json:"reason,omitempty"`
+	Status       string     `json:"status"`
+	ApproverId   *uint      `json:"approver_id,omitempty"`
+	DecisionNote string     `json:"decision_note,omitempty"`
+	DecidedAt    *time.Time `json:"decided_at,omitempty"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// familyNameFirst lists the languages that write the family name before the
+// given name.
+var familyNameFirst = map[string]bool{
+	"hu": true,
+	"ja": true,
+	"ko": true,
+	"mn": true,
+	"vi": true,
+	"zh": true,
+}
+
+// DisplayName is the preferred name, or the first name, together with the
+// last name in the order of the name locale.
+func (e Employee) DisplayName() string {
+	given := e.FirstName
+	if e.PreferredName != "" {
+		given = e.PreferredName
+	}
+
+	return FormatName(given, e.LastName, e.NameLocale)
+}
+
+// FormatName orders a given and family name for a BCP 47 locale. Names in
+// Chinese, Japanese and Korean scripts are written without a space.
+func FormatName(given, family, locale string) string {
+	if family == "" {
+		return given
+	}
+
+	if !isFamilyNameFirst(locale) {
+		return given + " " + family
+	}
+
+	last, _ := utf8.DecodeLastRuneInString(family)
+	first, _ := utf8.DecodeRuneInString(given)
+	if isCJK(last) && isCJK(first) {
+		return family + given
+	}
+
+	return family + " " + given
+}
+
+func isFamilyNameFirst(locale string) bool {
+	if locale == "" {
+		return false
+	}
+
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return false
+	}
+
+	base, _ := tag.Base()
+	return familyNameFirst[base.String()]
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,32 @@
+package domain
+
+// PermissionPhotoWrite lets HR upload and replace employee photos, reading
+// them needs no key so photo URLs work in image tags.
+const PermissionPhotoWrite = "photos.write"
+
+// Uploaded photos are re-encoded into square JPEG thumbnails, the original is
+// not kept.
+const (
+	PhotoSizeSmall = "small"
+	PhotoSizeLarge = "large"
+
+	PhotoMinDimension = 128
+	PhotoMaxDimension = 4096
+)
+
+// PhotoSizes maps each thumbnail size to its width and height in pixels.
+var PhotoSizes = map[string]int{
+	PhotoSizeSmall: 128,
+	PhotoSizeLarge: 512,
+}
+
+func IsValidPhotoSize(size string) bool {
+	_, ok := PhotoSizes[size]
+	return ok
+}
+
+type PhotoResponse struct {
+	EmployeeId uint   `json:"employee_id"`
+	Version    string `json:"version"`
+	Url        string `json:"url"`
+}
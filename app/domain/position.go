@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Position struct {
+	ID          uint            `gorm:"column:id;autoIncrement;primaryKey"`
+	Title       string          `gorm:"column:title;index"`
+	Department  string          `gorm:"column:department"`
+	Grade       string          `gorm:"column:grade"`
+	Description string          `gorm:"column:description"`
+	CreatedAt   *time.Time      `gorm:"column:created_at"`
+	UpdatedAt   *time.Time      `gorm:"column:updated_at"`
+	DeletedAt   *gorm.DeletedAt `gorm:"column:deleted_at;index"`
+}
+
+type PositionRequest struct {
+	Title       string `json:"title"`
+	Department  string `json:"department"`
+	Grade       string `json:"grade"`
+	Description string `json:"description"`
+}
+
+type PositionResponse struct {
+	Id          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Department  string     `json:"department"`
+	Grade       string     `json:"grade"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// JobAssignment is one entry of the job history of an employee. Title,
+// department and grade are copied from the position when the assignment is
+// made, so later changes to the catalogue do not rewrite the history. An
+// open assignment has no end date.
+type JobAssignment struct {
+	ID         uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	EmployeeID uint       `gorm:"column:employee_id;index"`
+	PositionID uint       `gorm:"column:position_id;index"`
+	Title      string     `gorm:"column:title"`
+	Department string     `gorm:"column:department"`
+	Grade      string     `gorm:"column:grade"`
+	StartDate  time.Time  `gorm:"column:start_date;type:date;index"`
+	EndDate    *time.Time `gorm:"column:end_date;type:date;index"`
+	CreatedAt  *time.Time `gorm:"column:created_at"`
+}
+
+type JobAssignmentRequest struct {
+	PositionID uint   `json:"position_id"`
+	Department string `json:"department"`
+	Grade      string `json:"grade"`
+	StartDate  string `json:"start_date"`
+}
+
+type JobAssignmentResponse struct {
+	Id         uint       `json:"id"`
+	PositionId uint       `json:"position_id"`
+	Title      string     `json:"title"`
+	Department string     `json:"department"`
+	Grade      string     `json:"grade"`
+	StartDate  time.Time  `json:"start_date"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+}
+
+// ActiveOn reports whether the assignment covers the given date.
+func (a JobAssignment) ActiveOn(date time.Time) bool {
+	if date.Before(a.StartDate) {
+		return false
+	}
+
+	return a.EndDate == nil || !date.After(*a.EndDate)
+}
@@ -0,0 +1,105 @@
+package domain
+
+import "time"
+
+const (
+	// PermissionReviewRead lets HR list review cycles and the reviews of
+	// employees.
+	PermissionReviewRead = "reviews.read"
+	// PermissionReviewManage lets HR create review cycles and start reviews.
+	PermissionReviewManage = "reviews.manage"
+	// PermissionReviewWrite lets employees and reviewers act on a review,
+	// their API key has to belong to them.
+	PermissionReviewWrite = "reviews.write"
+)
+
+const (
+	ReviewStatusDraft        = "draft"
+	ReviewStatusSubmitted    = "submitted"
+	ReviewStatusAcknowledged = "acknowledged"
+)
+
+// ReviewCycle is a review round such as "2024 Annual Review". Ratings given
+// in the cycle must be within RatingMin and RatingMax.
+type ReviewCycle struct {
+	ID               uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	Name             string     `gorm:"column:name;uniqueIndex"`
+	PeriodStart      time.Time  `gorm:"column:period_start;type:date"`
+	PeriodEnd        time.Time  `gorm:"column:period_end;type:date"`
+	SelfReviewDue    time.Time  `gorm:"column:self_review_due;type:date"`
+	ManagerReviewDue time.Time  `gorm:"column:manager_review_due;type:date"`
+	RatingMin        int        `gorm:"column:rating_min"`
+	RatingMax        int        `gorm:"column:rating_max"`
+	CreatedAt        *time.Time `gorm:"column:created_at"`
+	UpdatedAt        *time.Time `gorm:"column:updated_at"`
+}
+
+func (c ReviewCycle) ValidRating(rating int) bool {
+	return rating >= c.RatingMin && rating <= c.RatingMax
+}
+
+// Review is the review of one employee in a cycle. The employee writes the
+// self assessment while it is a draft, the reviewer writes the manager
+// assessment and submits it, then the employee acknowledges it.
+type Review struct {
+	ID                uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	CycleID           uint       `gorm:"column:cycle_id;uniqueIndex:idx_review_cycle_employee"`
+	EmployeeID        uint       `gorm:"column:employee_id;uniqueIndex:idx_review_cycle_employee;index"`
+	ReviewerID        uint       `gorm:"column:reviewer_id;index"`
+	SelfAssessment    string     `gorm:"column:self_assessment"`
+	SelfRating        *int       `gorm:"column:self_rating"`
+	ManagerAssessment string     `gorm:"column:manager_assessment"`
+	ManagerRating     *int       `gorm:"column:manager_rating"`
+	Status            string     `gorm:"column:status;not null;default:draft;index"`
+	SubmittedAt       *time.Time `gorm:"column:submitted_at"`
+	AcknowledgedAt    *time.Time `gorm:"column:acknowledged_at"`
+	CreatedAt         *time.Time `gorm:"column:created_at"`
+	UpdatedAt         *time.Time `gorm:"column:updated_at"`
+}
+
+type ReviewCycleRequest struct {
+	Name             string `json:"name"`
+	PeriodStart      string `json:"period_start"`
+	PeriodEnd        string `json:"period_end"`
+	SelfReviewDue    string `json:"self_review_due"`
+	ManagerReviewDue string `json:"manager_review_due"`
+	RatingMin        int    `json:"rating_min"`
+	RatingMax        int    `json:"rating_max"`
+}
+
+type ReviewCycleResponse struct {
+	Id               uint      `json:"id"`
+	Name             string    `json:"name"`
+	PeriodStart      time.Time `json:"period_start"`
+	PeriodEnd        time.Time `json:"period_end"`
+	SelfReviewDue    time.Time `json:"self_review_due"`
+	ManagerReviewDue time.Time `json:"manager_review_due"`
+	RatingMin        int       `json:"rating_min"`
+	RatingMax        int       `json:"rating_max"`
+}
+
+// StartReviewRequest opens a review, the reviewer defaults to the manager of
+// the employee.
+type StartReviewRequest struct {
+	CycleId    uint  `json:"cycle_id"`
+	ReviewerId *uint `json:"reviewer_id"`
+}
+
+type AssessmentRequest struct {
+	Assessment string `json:"assessment"`
+	Rating     *int   `json:"rating"`
+}
+
+type ReviewResponse struct {
+	Id                uint       `json:"id"`
+	CycleId           uint       `json:"cycle_id"`
+	EmployeeId        uint       `json:"employee_id"`
+	ReviewerId        uint       `json:"reviewer_id"`
+	SelfAssessment    string     `json:"self_assessment,omitempty"`
+	SelfRating        *int       `json:"self_rating,omitempty"`
+	ManagerAssessment string     `json:"manager_assessment,omitempty"`
+	ManagerRating     *int       `json:"manager_rating,omitempty"`
+	Status            string     `json:"status"`
+	SubmittedAt       *time.Time `json:"submitted_at,omitempty"`
+	AcknowledgedAt    *time.Time `json:"acknowledged_at,omitempty"`
+}
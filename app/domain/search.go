@@ -0,0 +1,36 @@
+package domain
+
+// Search expressions over employees. The migration indexes these exact
+// expressions, queries must use them unchanged for the indexes to apply.
+const (
+	EmployeeSearchText     = "(first_name || ' ' || coalesce(preferred_name, '') || ' ' || last_name || ' ' || email)"
+	EmployeeSearchDocument = "to_tsvector('simple', first_name || ' ' || coalesce(preferred_name, '') || ' ' || last_name || ' ' || translate(email, '@.+_-', '     '))"
+)
+
+// EmployeeSearchMatch is a row of the search query.
+type EmployeeSearchMatch struct {
+	ID             uint    `gorm:"column:id"`
+	FirstName      string  `gorm:"column:first_name"`
+	LastName       string  `gorm:"column:last_name"`
+	PreferredName  string  `gorm:"column:preferred_name"`
+	NameLocale     string  `gorm:"column:name_locale"`
+	Email          string  `gorm:"column:email"`
+	Status         string  `gorm:"column:status"`
+	PhotoVersion   string  `gorm:"column:photo_version"`
+	NameHighlight  string  `gorm:"column:name_highlight"`
+	EmailHighlight string  `gorm:"column:email_highlight"`
+	Score          float64 `gorm:"column:score"`
+}
+
+type EmployeeSearchResult struct {
+	Id             uint    `json:"id"`
+	FirstName      string  `json:"first_name"`
+	LastName       string  `json:"last_name"`
+	DisplayName    string  `json:"display_name"`
+	Email          string  `json:"email"`
+	Status         string  `json:"status"`
+	PhotoUrl       string  `json:"photo_url,omitempty"`
+	NameHighlight  string  `json:"name_highlight"`
+	EmailHighlight string  `json:"email_highlight"`
+	Score          float64 `json:"score"`
+}
@@ -0,0 +1,85 @@
+package domain
+
+import "time"
+
+// Webhooks send employee data to any URL, only admins may manage them.
+const PermissionWebhookManage = "webhooks.manage"
+
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// Webhook is a subscription of URL to the events in EventTypes. Payloads are
+// signed with Secret. A webhook is disabled after too many failed deliveries
+// in a row.
+type Webhook struct {
+	ID                  uint       `gorm:"column:id;autoIncrement;primaryKey"`
+	Url                 string     `gorm:"column:url;not null"`
+	EventTypes          StringList `gorm:"column:event_types;type:jsonb;not null"`
+	Secret              string     `gorm:"column:secret;not null"`
+	Active              bool       `gorm:"column:active;not null"`
+	ConsecutiveFailures int        `gorm:"column:consecutive_failures;not null;default:0"`
+	DisabledAt          *time.Time `gorm:"column:disabled_at"`
+	CreatedAt           *time.Time `gorm:"column:created_at"`
+	UpdatedAt           *time.Time `gorm:"column:updated_at"`
+}
+
+// WebhookDelivery is one event sent to one webhook, it keeps the outcome of
+// the latest attempt. Payload is the exact body that is signed and sent.
+type WebhookDelivery struct {
+	ID            uint64     `gorm:"column:id;autoIncrement;primaryKey;index:idx_webhook_deliveries_pending,priority:3"`
+	WebhookID     uint       `gorm:"column:webhook_id;not null;index:idx_webhook_deliveries_pending,priority:1,where:status = 'pending';uniqueIndex:idx_webhook_deliveries_event,priority:1,where:NOT redelivery"`
+	EventId       string     `gorm:"column:event_id;not null;uniqueIndex:idx_webhook_deliveries_event,priority:2"`
+	EventType     string     `gorm:"column:event_type;size:64;not null"`
+	EmployeeID    uint       `gorm:"column:employee_id;not null;index:idx_webhook_deliveries_pending,priority:2"`
+	Payload       string     `gorm:"column:payload;type:jsonb;not null"`
+	Redelivery    bool       `gorm:"column:redelivery;not null;default:false"`
+	Status        string     `gorm:"column:status;size:16;not null"`
+	Attempts      int        `gorm:"column:attempts;not null;default:0"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;not null"`
+	ResponseCode  int        `gorm:"column:response_code"`
+	LastError     string     `gorm:"column:last_error"`
+	DeliveredAt   *time.Time `gorm:"column:delivered_at"`
+	CreatedAt     *time.Time `gorm:"column:created_at"`
+	UpdatedAt     *time.Time `gorm:"column:updated_at"`
+}
+
+// WebhookRequest creates or updates a webhook. An empty secret is generated
+// on create and kept on update. Setting active re-enables a disabled webhook.
+type WebhookRequest struct {
+	Url        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+	Secret     string   `json:"secret"`
+	Active     *bool    `json:"active"`
+}
+
+// WebhookResponse only carries the secret when the webhook is created.
+type WebhookResponse struct {
+	Id                  uint       `json:"id"`
+	Url                 string     `json:"url"`
+	EventTypes          []string   `json:"event_types"`
+	Secret              string     `json:"secret,omitempty"`
+	Active              bool       `json:"active"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	DisabledAt          *time.Time `json:"disabled_at,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+type WebhookDeliveryResponse struct {
+	Id            uint64     `json:"id"`
+	WebhookId     uint       `json:"webhook_id"`
+	EventId       string     `json:"event_id"`
+	EventType     string     `json:"event_type"`
+	EmployeeId    uint       `json:"employee_id"`
+	Redelivery    bool       `json:"redelivery"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	ResponseCode  int        `json:"response_code,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+	DeliveredAt   *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type AttendanceHandler struct {
+	attendanceUsecase usecases.AttendanceUsecase
+}
+
+func NewAttendanceHandler(uc usecases.AttendanceUsecase) *AttendanceHandler {
+	return &AttendanceHandler{
+		attendanceUsecase: uc,
+	}
+}
+
+func (h *AttendanceHandler) ClockIn(ctx *fiber.Ctx) error {
+	return h.clock(ctx, "clock in", h.attendanceUsecase.ClockIn)
+}
+
+func (h *AttendanceHandler) ClockOut(ctx *fiber.Ctx) error {
+	return h.clock(ctx, "clock out", h.attendanceUsecase.ClockOut)
+}
+
+func (h *AttendanceHandler) clock(ctx *fiber.Ctx, action string, clock func(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error)) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.AttendanceRequest
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(&request); err != nil {
+			logger.Log.Error(err, "failed to parse body request")
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+	}
+
+	if err := utils.ValidateAndSanitizeAttendanceRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := clock(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to "+action)
+
+		if errors.Is(err, usecases.ErrEmployeeTerminated) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrAlreadyClockedIn) ||
+			errors.Is(err, usecases.ErrNotClockedIn) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully %s for employee id %d", action, employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+// FindTimesheet returns the monthly timesheet as JSON, or as a CSV file when
+// format=csv is set or the client only accepts text/csv.
+func (h *AttendanceHandler) FindTimesheet(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	month := ctx.Query("month", utils.Today().Format("2006-01"))
+
+	res, err := h.attendanceUsecase.GetTimesheet(employeeId, month)
+	if err != nil {
+		logger.Log.Error(err, "failed to get timesheet")
+
+		if errors.Is(err, usecases.ErrInvalidMonth) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	if ctx.Query("format") == "csv" || ctx.Accepts(fiber.MIMEApplicationJSON, "text/csv") == "text/csv" {
+		return writeTimesheetCSV(ctx, res)
+	}
+
+	msg := fmt.Sprintf("Successfully get timesheet for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func writeTimesheetCSV(ctx *fiber.Ctx, timesheet domain.TimesheetResponse) error {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{{"date", "first_clock_in", "last_clock_out", "sessions", "worked_minutes", "missing_clock_out"}}
+	for _, d := range timesheet.Days {
+		lastClockOut := ""
+		if d.LastClockOut != nil {
+			lastClockOut = d.LastClockOut.Format(time.RFC3339)
+		}
+
+		rows = append(rows, []string{
+			d.Date.Format("2006-01-02"),
+			d.FirstClockIn.Format(time.RFC3339),
+			lastClockOut,
+			strconv.Itoa(d.Sessions),
+			strconv.Itoa(d.WorkedMinutes),
+			strconv.FormatBool(d.MissingClockOut),
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		logger.Log.Error(err, "failed to write timesheet csv")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	filename := fmt.Sprintf("timesheet-%d-%s.csv", timesheet.EmployeeId, timesheet.Month)
+	ctx.Set(fiber.HeaderContentType, "text/csv")
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return ctx.Send(buf.Bytes())
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttendanceHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.AttendanceUsecase)
+	h := NewAttendanceHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/employees/:id/attendance/clock-in", h.ClockIn)
+	app.Post("api/employees/:id/attendance/clock-out", h.ClockOut)
+	app.Get("api/employees/:id/attendance/timesheet", h.FindTimesheet)
+
+	t.Run("Test Clock In SUCCESS without body", func(t *testing.T) {
+		uc.On("ClockIn", uint(1), domain.AttendanceRequest{}).
+			Return(domain.AttendanceResponse{Id: 1}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/attendance/clock-in", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Clock In BAD REQUEST geotag", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/attendance/clock-in", bytes.NewBufferString(`{"latitude": 100, "longitude": 10}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Clock In NOT FOUND deleted employee", func(t *testing.T) {
+		uc.On("ClockIn", uint(2), domain.AttendanceRequest{}).
+			Return(domain.AttendanceResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/2/attendance/clock-in", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Clock Out CONFLICT", func(t *testing.T) {
+		uc.On("ClockOut", uint(1), domain.AttendanceRequest{}).
+			Return(domain.AttendanceResponse{}, usecases.ErrNotClockedIn).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/attendance/clock-out", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Get Timesheet CSV", func(t *testing.T) {
+		day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+		out := day.Add(17 * time.Hour)
+		uc.On("GetTimesheet", uint(1), "2024-05").
+			Return(domain.TimesheetResponse{
+				EmployeeId: 1,
+				Month:      "2024-05",
+				Days:       []domain.DailyAttendance{{Date: day, FirstClockIn: day.Add(8 * time.Hour), LastClockOut: &out, Sessions: 1, WorkedMinutes: 540}},
+			}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/attendance/timesheet?month=2024-05&format=csv", nil), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, "date,first_clock_in,last_clock_out,sessions,worked_minutes,missing_clock_out\n"+
+			"2024-05-06,2024-05-06T08:00:00Z,2024-05-06T17:00:00Z,1,540,false\n", string(body))
+	})
+
+	t.Run("Test Get Timesheet BAD REQUEST month", func(t *testing.T) {
+		uc.On("GetTimesheet", uint(1), "2024").
+			Return(domain.TimesheetResponse{}, usecases.ErrInvalidMonth).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/attendance/timesheet?month=2024", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
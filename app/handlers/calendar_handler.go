@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/ical"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CalendarHandler struct {
+	calendarUsecase usecases.CalendarUsecase
+}
+
+func NewCalendarHandler(uc usecases.CalendarUsecase) *CalendarHandler {
+	return &CalendarHandler{
+		calendarUsecase: uc,
+	}
+}
+
+func (h *CalendarHandler) CreateCalendar(ctx *fiber.Ctx) error {
+	var request domain.WorkCalendarRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeCalendarRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.calendarUsecase.CreateCalendar(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create calendar")
+		if errors.Is(err, usecases.ErrDuplicateCalendarName) ||
+			errors.Is(err, domain.ErrInvalidWeekday) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new calendar", res)
+}
+
+func (h *CalendarHandler) FindAllCalendar(ctx *fiber.Ctx) error {
+	res, err := h.calendarUsecase.GetAllCalendar()
+	if err != nil {
+		logger.Log.Error(err, "failed to get all calendar")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all calendar data", res)
+}
+
+func (h *CalendarHandler) FindCalendarById(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.calendarUsecase.GetCalendarById(uintId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get calendar by id")
+		return calendarError(ctx, err, uintId)
+	}
+
+	msg := fmt.Sprintf("Successfully get data for calendar id %d", uintId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *CalendarHandler) AddHoliday(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.HolidayRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.calendarUsecase.AddHoliday(uintId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to add holiday")
+		return calendarError(ctx, err, uintId)
+	}
+
+	msg := fmt.Sprintf("Successfully add holiday to calendar id %d", uintId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+// ImportHolidays reads an iCalendar file from the request body, sent either
+// raw as text/calendar or as the "file" field of a multipart form.
+func (h *CalendarHandler) ImportHolidays(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	if file, err := ctx.FormFile("file"); err == nil {
+		f, err := file.Open()
+		if err != nil {
+			logger.Log.Error(err, "failed to open uploaded file")
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+		defer f.Close()
+
+		res, err := h.calendarUsecase.ImportHolidays(uintId, f)
+		return h.importResponse(ctx, uintId, res, err)
+	}
+
+	res, err := h.calendarUsecase.ImportHolidays(uintId, bytes.NewReader(ctx.Body()))
+	return h.importResponse(ctx, uintId, res, err)
+}
+
+func (h *CalendarHandler) importResponse(ctx *fiber.Ctx, id uint, res domain.HolidayImportResponse, err error) error {
+	if err != nil {
+		logger.Log.Error(err, "failed to import holidays")
+		return calendarError(ctx, err, id)
+	}
+
+	msg := fmt.Sprintf("Successfully import holidays to calendar id %d", id)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *CalendarHandler) CountWorkingDays(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.calendarUsecase.CountWorkingDays(uintId, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		logger.Log.Error(err, "failed to count working days")
+		return calendarError(ctx, err, uintId)
+	}
+
+	msg := fmt.Sprintf("Successfully count working days for calendar id %d", uintId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *CalendarHandler) AssignCalendar(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.CalendarAssignmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.calendarUsecase.AssignCalendar(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to assign calendar")
+
+		if errors.Is(err, usecases.ErrCalendarNotFound) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return employeeCalendarError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully assign calendar for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *CalendarHandler) CheckEmployeeDate(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.calendarUsecase.CheckEmployeeDate(employeeId, ctx.Query("date"))
+	if err != nil {
+		logger.Log.Error(err, "failed to check date")
+
+		if errors.Is(err, usecases.ErrInvalidDate) ||
+			errors.Is(err, usecases.ErrNoCalendar) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return employeeCalendarError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully check date for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func calendarError(ctx *fiber.Ctx, err error, id uint) error {
+	if errors.Is(err, usecases.ErrInvalidDate) ||
+		errors.Is(err, usecases.ErrToBeforeFrom) ||
+		errors.Is(err, usecases.ErrRangeTooLong) ||
+		errors.Is(err, usecases.ErrHolidayTooLong) ||
+		errors.Is(err, ical.ErrInvalidCalendar) ||
+		errors.Is(err, ical.ErrInvalidDate) {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("calendar with id %d not found", id)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
+
+func employeeCalendarError(ctx *fiber.Ctx, err error, employeeId uint) error {
+	if errors.Is(err, usecases.ErrCalendarNotFound) {
+		return utils.ResponseNotFound(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/ical"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCalendarHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.CalendarUsecase)
+	h := NewCalendarHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/calendars", h.CreateCalendar)
+	app.Post("api/calendars/:id/holidays/import", h.ImportHolidays)
+	app.Get("api/calendars/:id/working-days", h.CountWorkingDays)
+	app.Get("api/employees/:id/calendar/check", h.CheckEmployeeDate)
+
+	t.Run("Test Create Calendar SUCCESS", func(t *testing.T) {
+		uc.On("CreateCalendar", domain.WorkCalendarRequest{Name: "Indonesia", Country: "ID", WorkingDays: []string{"mon", "tue", "wed", "thu", "fri"}}).
+			Return(domain.WorkCalendarResponse{Id: 1, Name: "Indonesia"}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(domain.WorkCalendarRequest{Name: "Indonesia", Country: "id"})
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/calendars", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Import Holidays SUCCESS", func(t *testing.T) {
+		uc.On("ImportHolidays", uint(1), mock.Anything).
+			Return(domain.HolidayImportResponse{Imported: 2}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/calendars/1/holidays/import", bytes.NewBufferString("BEGIN:VCALENDAR\nEND:VCALENDAR\n"))
+		httpReq.Header.Set("content-type", "text/calendar")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Import Holidays BAD REQUEST", func(t *testing.T) {
+		uc.On("ImportHolidays", uint(1), mock.Anything).
+			Return(domain.HolidayImportResponse{}, ical.ErrInvalidCalendar).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/calendars/1/holidays/import", bytes.NewBufferString("oops"))
+		httpReq.Header.Set("content-type", "text/calendar")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Count Working Days BAD REQUEST range too long", func(t *testing.T) {
+		uc.On("CountWorkingDays", uint(9), "0001-01-01", "9999-12-31").
+			Return(domain.WorkingDaysResponse{}, usecases.ErrRangeTooLong).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/calendars/9/working-days?from=0001-01-01&to=9999-12-31", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Count Working Days NOT FOUND", func(t *testing.T) {
+		uc.On("CountWorkingDays", uint(9), "2024-05-01", "2024-05-31").
+			Return(domain.WorkingDaysResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/calendars/9/working-days?from=2024-05-01&to=2024-05-31", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Check Employee Date BAD REQUEST no calendar", func(t *testing.T) {
+		uc.On("CheckEmployeeDate", uint(1), "").
+			Return(domain.DateCheckResponse{}, usecases.ErrNoCalendar).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/calendar/check", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
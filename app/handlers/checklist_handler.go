@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ChecklistHandler struct {
+	checklistUsecase usecases.ChecklistUsecase
+}
+
+func NewChecklistHandler(uc usecases.ChecklistUsecase) *ChecklistHandler {
+	return &ChecklistHandler{
+		checklistUsecase: uc,
+	}
+}
+
+func (h *ChecklistHandler) CreateChecklistItem(ctx *fiber.Ctx) error {
+	var request domain.ChecklistItemRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeChecklistItemRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.checklistUsecase.CreateChecklistItem(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create checklist item")
+		if errors.Is(err, usecases.ErrAssigneeNotFound) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new checklist item", res)
+}
+
+func (h *ChecklistHandler) FindChecklistItems(ctx *fiber.Ctx) error {
+	kind, ok := parseChecklistKind(ctx)
+	if !ok {
+		return utils.ResponseBadRequest(ctx, utils.ErrInvalidChecklistKind.Error())
+	}
+
+	res, err := h.checklistUsecase.GetChecklistItems(kind)
+	if err != nil {
+		logger.Log.Error(err, "failed to get checklist items")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all checklist item data", res)
+}
+
+func (h *ChecklistHandler) DeleteChecklistItem(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	if err := h.checklistUsecase.DeleteChecklistItem(uintId); err != nil {
+		logger.Log.Error(err, "failed to delete checklist item")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("checklist item with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully delete checklist item with id %d", uintId)
+	return utils.ResponseOK(ctx, msg, nil)
+}
+
+func (h *ChecklistHandler) FindEmployeeTasks(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	kind, ok := parseChecklistKind(ctx)
+	if !ok {
+		return utils.ResponseBadRequest(ctx, utils.ErrInvalidChecklistKind.Error())
+	}
+
+	res, err := h.checklistUsecase.GetEmployeeTasks(employeeId, kind)
+	if err != nil {
+		logger.Log.Error(err, "failed to get tasks")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get tasks for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ChecklistHandler) FindOpenTasks(ctx *fiber.Ctx) error {
+	kind, ok := parseChecklistKind(ctx)
+	if !ok {
+		return utils.ResponseBadRequest(ctx, utils.ErrInvalidChecklistKind.Error())
+	}
+
+	res, err := h.checklistUsecase.GetOpenTasks(kind)
+	if err != nil {
+		logger.Log.Error(err, "failed to get open tasks")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all open task data", res)
+}
+
+func (h *ChecklistHandler) AssignTask(ctx *fiber.Ctx) error {
+	employeeId, taskId, err := parseTaskIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.TaskAssignmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.checklistUsecase.AssignTask(employeeId, taskId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to assign task")
+		return taskError(ctx, err, taskId)
+	}
+
+	msg := fmt.Sprintf("Successfully assign task for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ChecklistHandler) CompleteTask(ctx *fiber.Ctx) error {
+	employeeId, taskId, err := parseTaskIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	completedById, ok := middleware.Employee(ctx)
+	if !ok {
+		return utils.ResponseForbidden(ctx, middleware.ErrNoEmployee.Error())
+	}
+
+	res, err := h.checklistUsecase.CompleteTask(employeeId, taskId, completedById)
+	if err != nil {
+		logger.Log.Error(err, "failed to complete task")
+		return taskError(ctx, err, taskId)
+	}
+
+	msg := fmt.Sprintf("Successfully complete task for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+// parseChecklistKind reads the optional kind query, empty means both kinds.
+func parseChecklistKind(ctx *fiber.Ctx) (string, bool) {
+	kind := strings.ToLower(strings.TrimSpace(ctx.Query("kind")))
+	return kind, kind == "" || domain.IsValidChecklistKind(kind)
+}
+
+func parseTaskIds(ctx *fiber.Ctx) (uint, uint, error) {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	taskId, err := parseParamId(ctx, "taskId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return employeeId, taskId, nil
+}
+
+func taskError(ctx *fiber.Ctx, err error, taskId uint) error {
+	if errors.Is(err, usecases.ErrAssigneeNotFound) {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrTaskAlreadyCompleted) {
+		return utils.ResponseConflict(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("task with id %d not found", taskId)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
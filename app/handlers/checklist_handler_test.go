@@ -0,0 +1,132 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecklistHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.ChecklistUsecase)
+	h := NewChecklistHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/checklist-items", h.CreateChecklistItem)
+	app.Delete("api/checklist-items/:id", h.DeleteChecklistItem)
+	app.Get("api/tasks", h.FindOpenTasks)
+	app.Get("api/employees/:id/tasks", h.FindEmployeeTasks)
+	app.Put("api/employees/:id/tasks/:taskId/assignee", h.AssignTask)
+	app.Post("api/employees/:id/tasks/:taskId/complete", func(ctx *fiber.Ctx) error {
+		ctx.Locals(middleware.EmployeeKey, uint(3))
+		return ctx.Next()
+	}, h.CompleteTask)
+
+	t.Run("Test Create Checklist Item SUCCESS", func(t *testing.T) {
+		uc.On("CreateChecklistItem", domain.ChecklistItemRequest{Kind: domain.ChecklistOnboarding, Title: "Prepare laptop", DueDays: -3}).
+			Return(domain.ChecklistItemResponse{Id: 1}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/checklist-items", bytes.NewBufferString(`{"kind": "onboarding", "title": "Prepare laptop", "due_days": -3}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Create Checklist Item BAD REQUEST kind", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/checklist-items", bytes.NewBufferString(`{"kind": "probation", "title": "Review"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Delete Checklist Item NOT FOUND", func(t *testing.T) {
+		uc.On("DeleteChecklistItem", uint(5)).Return(repositories.ErrRecordNotFound).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/checklist-items/5", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Get Open Tasks SUCCESS", func(t *testing.T) {
+		uc.On("GetOpenTasks", domain.ChecklistOffboarding).
+			Return([]domain.TaskResponse{{Id: 1}}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/tasks?kind=offboarding", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Get Employee Tasks BAD REQUEST kind", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/tasks?kind=other", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Assign Task BAD REQUEST assignee", func(t *testing.T) {
+		assigneeId := uint(9)
+		uc.On("AssignTask", uint(1), uint(2), domain.TaskAssignmentRequest{AssigneeId: &assigneeId}).
+			Return(domain.TaskResponse{}, usecases.ErrAssigneeNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/employees/1/tasks/2/assignee", bytes.NewBufferString(`{"assignee_id": 9}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Complete Task SUCCESS", func(t *testing.T) {
+		uc.On("CompleteTask", uint(1), uint(2), uint(3)).
+			Return(domain.TaskResponse{Id: 2, Completed: true}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/tasks/2/complete", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Complete Task CONFLICT", func(t *testing.T) {
+		uc.On("CompleteTask", uint(1), uint(2), uint(3)).
+			Return(domain.TaskResponse{}, usecases.ErrTaskAlreadyCompleted).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/tasks/2/complete", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Complete Task FORBIDDEN without employee", func(t *testing.T) {
+		anonymous := fiber.New()
+		anonymous.Post("api/employees/:id/tasks/:taskId/complete", h.CompleteTask)
+
+		resp, err := anonymous.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/tasks/2/complete", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+		uc.AssertNotCalled(t, "CompleteTask", uint(1), uint(2), uint(0))
+	})
+}
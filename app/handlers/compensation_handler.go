@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CompensationHandler struct {
+	compensationUsecase usecases.CompensationUsecase
+}
+
+func NewCompensationHandler(uc usecases.CompensationUsecase) *CompensationHandler {
+	return &CompensationHandler{
+		compensationUsecase: uc,
+	}
+}
+
+func (h *CompensationHandler) AddCompensation(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.CompensationRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeCompensationRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.compensationUsecase.AddCompensation(employeeId, request, middleware.Principal(ctx))
+	if err != nil {
+		logger.Log.Error(err, "failed to add compensation")
+
+		if errors.Is(err, usecases.ErrInvalidDate) ||
+			errors.Is(err, usecases.ErrCompensationBeforeHire) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrDuplicateCompensationDate) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully add compensation for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *CompensationHandler) FindCompensationHistory(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.compensationUsecase.GetCompensationHistory(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get compensation history")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get compensation history for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *CompensationHandler) FindCurrentCompensation(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.compensationUsecase.GetCompensationOnDate(employeeId, ctx.Query("date"))
+	if err != nil {
+		logger.Log.Error(err, "failed to get compensation")
+
+		if errors.Is(err, usecases.ErrInvalidDate) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrCompensationNotFound) {
+			return utils.ResponseNotFound(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get compensation for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompensationHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.CompensationUsecase)
+	h := NewCompensationHandler(uc)
+
+	app := fiber.New()
+	principal := func(ctx *fiber.Ctx) error {
+		ctx.Locals(middleware.PrincipalKey, "payroll")
+		return ctx.Next()
+	}
+	app.Get("api/employees/:id/compensations", h.FindCompensationHistory)
+	app.Get("api/employees/:id/compensations/current", h.FindCurrentCompensation)
+	app.Post("api/employees/:id/compensations", principal, h.AddCompensation)
+
+	t.Run("Test Add Compensation SUCCESS", func(t *testing.T) {
+		req := domain.CompensationRequest{BaseSalary: "5000", Currency: "USD", PayFrequency: "monthly", EffectiveDate: "2024-01-01"}
+		uc.On("AddCompensation", uint(1), req, "payroll").
+			Return(domain.CompensationResponse{Id: 1, BaseSalary: "5000"}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/compensations", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Add Compensation CONFLICT", func(t *testing.T) {
+		req := domain.CompensationRequest{BaseSalary: "5000", Currency: "USD", PayFrequency: "monthly", EffectiveDate: "2023-01-01"}
+		uc.On("AddCompensation", uint(1), req, "payroll").
+			Return(domain.CompensationResponse{}, usecases.ErrDuplicateCompensationDate).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/compensations", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Add Compensation invalid salary BAD REQUEST", func(t *testing.T) {
+		req := domain.CompensationRequest{BaseSalary: "-1", Currency: "USD", PayFrequency: "monthly", EffectiveDate: "2024-01-01"}
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/compensations", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		uc.AssertNotCalled(t, "AddCompensation", uint(1), req, "payroll")
+	})
+
+	t.Run("Test Get Compensation History SUCCESS", func(t *testing.T) {
+		uc.On("GetCompensationHistory", uint(1)).
+			Return([]domain.CompensationResponse{{Id: 1, BaseSalary: "5000"}}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/1/compensations", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Get Current Compensation NOT FOUND", func(t *testing.T) {
+		uc.On("GetCompensationOnDate", uint(1), "2020-01-01").
+			Return(domain.CompensationResponse{}, usecases.ErrCompensationNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/1/compensations/current?date=2020-01-01", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Get Compensation History employee NOT FOUND", func(t *testing.T) {
+		uc.On("GetCompensationHistory", uint(2)).
+			Return(nil, repositories.ErrRecordNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/2/compensations", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,266 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ContactHandler struct {
+	contactUsecase usecases.ContactUsecase
+}
+
+func NewContactHandler(uc usecases.ContactUsecase) *ContactHandler {
+	return &ContactHandler{
+		contactUsecase: uc,
+	}
+}
+
+func (h *ContactHandler) FindContacts(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.contactUsecase.GetContacts(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get contacts")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully get contacts for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ContactHandler) AddPhone(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.PhoneRequest
+	if err := parsePhoneRequest(ctx, &request); err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.contactUsecase.AddPhone(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to add phone")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully add phone for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *ContactHandler) UpdatePhone(ctx *fiber.Ctx) error {
+	employeeId, contactId, err := parseContactIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.PhoneRequest
+	if err := parsePhoneRequest(ctx, &request); err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.contactUsecase.UpdatePhone(employeeId, contactId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to update phone")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully update phone for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ContactHandler) DeletePhone(ctx *fiber.Ctx) error {
+	return h.deleteContact(ctx, "phone", h.contactUsecase.DeletePhone)
+}
+
+func (h *ContactHandler) AddAddress(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.AddressRequest
+	if err := parseAddressRequest(ctx, &request); err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.contactUsecase.AddAddress(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to add address")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully add address for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *ContactHandler) UpdateAddress(ctx *fiber.Ctx) error {
+	employeeId, contactId, err := parseContactIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.AddressRequest
+	if err := parseAddressRequest(ctx, &request); err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.contactUsecase.UpdateAddress(employeeId, contactId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to update address")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully update address for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ContactHandler) DeleteAddress(ctx *fiber.Ctx) error {
+	return h.deleteContact(ctx, "address", h.contactUsecase.DeleteAddress)
+}
+
+func (h *ContactHandler) AddEmergencyContact(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.EmergencyContactRequest
+	if err := parseEmergencyContactRequest(ctx, &request); err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.contactUsecase.AddEmergencyContact(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to add emergency contact")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully add emergency contact for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *ContactHandler) UpdateEmergencyContact(ctx *fiber.Ctx) error {
+	employeeId, contactId, err := parseContactIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.EmergencyContactRequest
+	if err := parseEmergencyContactRequest(ctx, &request); err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.contactUsecase.UpdateEmergencyContact(employeeId, contactId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to update emergency contact")
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully update emergency contact for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ContactHandler) DeleteEmergencyContact(ctx *fiber.Ctx) error {
+	return h.deleteContact(ctx, "emergency contact", h.contactUsecase.DeleteEmergencyContact)
+}
+
+func (h *ContactHandler) deleteContact(ctx *fiber.Ctx, kind string, remove func(employeeId, id uint) error) error {
+	employeeId, contactId, err := parseContactIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	if err := remove(employeeId, contactId); err != nil {
+		logger.Log.Error(err, "failed to delete "+kind)
+		return contactError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully delete %s for employee id %d", kind, employeeId)
+	return utils.ResponseOK(ctx, msg, nil)
+}
+
+func parsePhoneRequest(ctx *fiber.Ctx, request *domain.PhoneRequest) error {
+	if err := ctx.BodyParser(request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return err
+	}
+
+	if err := utils.ValidateAndSanitizePhoneRequest(request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return err
+	}
+
+	return nil
+}
+
+func parseAddressRequest(ctx *fiber.Ctx, request *domain.AddressRequest) error {
+	if err := ctx.BodyParser(request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return err
+	}
+
+	if err := utils.ValidateAndSanitizeAddressRequest(request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return err
+	}
+
+	return nil
+}
+
+func parseEmergencyContactRequest(ctx *fiber.Ctx, request *domain.EmergencyContactRequest) error {
+	if err := ctx.BodyParser(request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return err
+	}
+
+	if err := utils.ValidateAndSanitizeEmergencyContactRequest(request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return err
+	}
+
+	return nil
+}
+
+func parseContactIds(ctx *fiber.Ctx) (uint, uint, error) {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	contactId, err := parseParamId(ctx, "contactId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return employeeId, contactId, nil
+}
+
+func contactError(ctx *fiber.Ctx, err error, employeeId uint) error {
+	if errors.Is(err, usecases.ErrContactNotFound) {
+		return utils.ResponseNotFound(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrDuplicateAddressType) {
+		return utils.ResponseConflict(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
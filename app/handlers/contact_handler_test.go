@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContactHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.ContactUsecase)
+	h := NewContactHandler(uc)
+
+	app := fiber.New()
+	app.Get("api/employees/:id/contacts", h.FindContacts)
+	app.Post("api/employees/:id/contacts/phones", h.AddPhone)
+	app.Post("api/employees/:id/contacts/addresses", h.AddAddress)
+	app.Put("api/employees/:id/contacts/emergency-contacts/:contactId", h.UpdateEmergencyContact)
+	app.Delete("api/employees/:id/contacts/phones/:contactId", h.DeletePhone)
+
+	t.Run("Test Get Contacts NOT FOUND", func(t *testing.T) {
+		uc.On("GetContacts", uint(2)).
+			Return(domain.ContactsResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/2/contacts", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Add Phone SUCCESS", func(t *testing.T) {
+		uc.On("AddPhone", uint(1), domain.PhoneRequest{Type: domain.PhoneTypeMobile, Number: "+6281234567890"}).
+			Return(domain.PhoneResponse{Id: 1}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/contacts/phones", bytes.NewBufferString(`{"number": "+62 812-3456-7890"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Add Phone BAD REQUEST not E.164", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/contacts/phones", bytes.NewBufferString(`{"number": "0812345"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Add Address CONFLICT", func(t *testing.T) {
+		uc.On("AddAddress", uint(1), domain.AddressRequest{Type: "home", Line1: "Jl. Sudirman 1", City: "Jakarta", Country: "ID"}).
+			Return(domain.AddressResponse{}, usecases.ErrDuplicateAddressType).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/contacts/addresses", bytes.NewBufferString(`{"type": "home", "line1": "Jl. Sudirman 1", "city": "Jakarta", "country": "id"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Update Emergency Contact NOT FOUND", func(t *testing.T) {
+		uc.On("UpdateEmergencyContact", uint(1), uint(7), domain.EmergencyContactRequest{Name: "Siti", Relationship: "mother", Phone: "+6281234567890"}).
+			Return(domain.EmergencyContactResponse{}, usecases.ErrContactNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/employees/1/contacts/emergency-contacts/7", bytes.NewBufferString(`{"name": "siti", "relationship": "Mother", "phone": "+6281234567890"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Delete Phone SUCCESS", func(t *testing.T) {
+		uc.On("DeletePhone", uint(1), uint(3)).Return(nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/employees/1/contacts/phones/3", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type CustomFieldHandler struct {
+	customFieldUsecase usecases.CustomFieldUsecase
+}
+
+func NewCustomFieldHandler(uc usecases.CustomFieldUsecase) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		customFieldUsecase: uc,
+	}
+}
+
+func (h *CustomFieldHandler) CreateCustomField(ctx *fiber.Ctx) error {
+	var request domain.CustomFieldRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeCustomFieldRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.customFieldUsecase.CreateCustomField(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create custom field")
+		if errors.Is(err, usecases.ErrDuplicateCustomField) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new custom field", res)
+}
+
+func (h *CustomFieldHandler) FindAllCustomField(ctx *fiber.Ctx) error {
+	res, err := h.customFieldUsecase.GetAllCustomField()
+	if err != nil {
+		logger.Log.Error(err, "failed to get all custom field")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all custom field data", res)
+}
+
+func (h *CustomFieldHandler) DeleteCustomField(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	if err := h.customFieldUsecase.DeleteCustomField(uintId); err != nil {
+		logger.Log.Error(err, "failed to delete custom field")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("custom field with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully delete custom field with id %d", uintId)
+	return utils.ResponseOK(ctx, msg, nil)
+}
+
+// isCustomFieldError reports errors caused by invalid custom field input.
+func isCustomFieldError(err error) bool {
+	return errors.Is(err, usecases.ErrUnknownCustomField) ||
+		errors.Is(err, usecases.ErrMissingCustomField) ||
+		errors.Is(err, domain.ErrInvalidCustomFieldValue)
+}
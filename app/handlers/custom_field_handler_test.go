@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomFieldHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.CustomFieldUsecase)
+	h := NewCustomFieldHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/custom-fields", h.CreateCustomField)
+	app.Get("api/custom-fields", h.FindAllCustomField)
+	app.Delete("api/custom-fields/:id", h.DeleteCustomField)
+
+	t.Run("Test Create Custom Field SUCCESS", func(t *testing.T) {
+		uc.On("CreateCustomField", domain.CustomFieldRequest{Name: "shirt_size", Label: "Shirt size", Type: domain.CustomFieldEnum, Options: []string{"S", "M"}}).
+			Return(domain.CustomFieldResponse{Id: 1}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/custom-fields", bytes.NewBufferString(`{"name": "Shirt_Size", "label": "Shirt size", "type": "enum", "options": ["S", "M", "M"]}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Create Custom Field BAD REQUEST type", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/custom-fields", bytes.NewBufferString(`{"name": "badge", "type": "json"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Create Custom Field CONFLICT", func(t *testing.T) {
+		uc.On("CreateCustomField", domain.CustomFieldRequest{Name: "badge", Type: domain.CustomFieldNumber}).
+			Return(domain.CustomFieldResponse{}, usecases.ErrDuplicateCustomField).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/custom-fields", bytes.NewBufferString(`{"name": "badge", "type": "number"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Get All Custom Field SUCCESS", func(t *testing.T) {
+		uc.On("GetAllCustomField").
+			Return([]domain.CustomFieldResponse{{Id: 1, Name: "badge"}}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/custom-fields", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Delete Custom Field NOT FOUND", func(t *testing.T) {
+		uc.On("DeleteCustomField", uint(9)).
+			Return(repositories.ErrRecordNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/custom-fields/9", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type DocumentHandler struct {
+	documentUsecase usecases.DocumentUsecase
+}
+
+func NewDocumentHandler(uc usecases.DocumentUsecase) *DocumentHandler {
+	return &DocumentHandler{
+		documentUsecase: uc,
+	}
+}
+
+// UploadDocument reads a multipart form with the content in the file field
+// and an optional category field sent before it. The content streams to
+// storage as it arrives.
+func (h *DocumentHandler) UploadDocument(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	file, fields, err := multipartFile(ctx)
+	if err != nil {
+		logger.Log.Error(err, "failed to read file field")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	request := domain.DocumentRequest{
+		Category: fields["category"],
+		FileName: file.FileName(),
+	}
+
+	if err := utils.ValidateAndSanitizeDocumentRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.documentUsecase.UploadDocument(employeeId, request, file, -1)
+	if err != nil {
+		logger.Log.Error(err, "failed to upload document")
+		return documentError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully upload document for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *DocumentHandler) FindDocuments(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.documentUsecase.GetDocuments(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get documents")
+		return documentError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully get documents for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+// DownloadDocument streams the content, the checksum is sent as the ETag.
+func (h *DocumentHandler) DownloadDocument(ctx *fiber.Ctx) error {
+	employeeId, documentId, err := parseDocumentIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	document, content, err := h.documentUsecase.DownloadDocument(employeeId, documentId)
+	if err != nil {
+		logger.Log.Error(err, "failed to download document")
+		return documentError(ctx, err, employeeId)
+	}
+
+	ctx.Set(fiber.HeaderContentType, document.ContentType)
+	ctx.Set(fiber.HeaderETag, fmt.Sprintf("%q", document.Checksum))
+	ctx.Attachment(document.FileName)
+	return ctx.SendStream(content, int(document.Size))
+}
+
+func (h *DocumentHandler) DeleteDocument(ctx *fiber.Ctx) error {
+	employeeId, documentId, err := parseDocumentIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	if err := h.documentUsecase.DeleteDocument(employeeId, documentId); err != nil {
+		logger.Log.Error(err, "failed to delete document")
+		return documentError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully delete document %d for employee id %d", documentId, employeeId)
+	return utils.ResponseOK(ctx, msg, nil)
+}
+
+func parseDocumentIds(ctx *fiber.Ctx) (uint, uint, error) {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	documentId, err := parseParamId(ctx, "documentId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return employeeId, documentId, nil
+}
+
+func documentError(ctx *fiber.Ctx, err error, employeeId uint) error {
+	if errors.Is(err, usecases.ErrDocumentNotFound) {
+		return utils.ResponseNotFound(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrDocumentTooLarge) {
+		return utils.ResponsePayloadTooLarge(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrUnsupportedDocumentType) {
+		return utils.ResponseUnsupportedMediaType(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
+
+const (
+	maxFormFields    = 8
+	maxFormFieldSize = 1024
+)
+
+var (
+	ErrNotMultipart      = errors.New("the body is not a multipart form")
+	ErrMissingFile       = errors.New("missing file field")
+	ErrFormFieldTooLarge = errors.New("form fields are too large")
+)
+
+// multipartFile reads a multipart form as it arrives and returns the file
+// field unread together with the fields sent before it, fields after the file
+// are ignored. The rest of the body is not read when the upload fails, so the
+// connection is closed after the response.
+func multipartFile(ctx *fiber.Ctx) (*multipart.Part, map[string]string, error) {
+	boundary := string(ctx.Request().Header.MultipartFormBoundary())
+	if boundary == "" {
+		return nil, nil, ErrNotMultipart
+	}
+
+	ctx.Set(fiber.HeaderConnection, "close")
+
+	body := ctx.Request().BodyStream()
+	if body == nil {
+		body = bytes.NewReader(ctx.Body())
+	}
+
+	reader := multipart.NewReader(body, boundary)
+	fields := make(map[string]string)
+	for len(fields) <= maxFormFields {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, nil, ErrMissingFile
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if part.FormName() == "file" {
+			return part, fields, nil
+		}
+
+		value, err := io.ReadAll(io.LimitReader(part, maxFormFieldSize+1))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(value) > maxFormFieldSize {
+			return nil, nil, ErrFormFieldTooLarge
+		}
+
+		fields[part.FormName()] = string(value)
+	}
+
+	return nil, nil, ErrFormFieldTooLarge
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newUploadRequest(t *testing.T, url, category, fileName, content string) *http.Request {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if category != "" {
+		assert.NoError(t, w.WriteField("category", category))
+	}
+
+	if fileName != "" {
+		part, err := w.CreateFormFile("file", fileName)
+		assert.NoError(t, err)
+		part.Write([]byte(content))
+	}
+	w.Close()
+
+	req := httptest.NewRequest(http.MethodPost, url, &body)
+	req.Header.Set("content-type", w.FormDataContentType())
+	return req
+}
+
+func TestDocumentHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.DocumentUsecase)
+	h := NewDocumentHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/employees/:id/documents", h.UploadDocument)
+	app.Get("api/employees/:id/documents/:documentId", h.DownloadDocument)
+	app.Delete("api/employees/:id/documents/:documentId", h.DeleteDocument)
+
+	t.Run("Test Upload Document SUCCESS", func(t *testing.T) {
+		uc.On("UploadDocument", uint(1), domain.DocumentRequest{Category: domain.DocumentContract, FileName: "contract.pdf"}, mock.MatchedBy(func(r io.Reader) bool {
+			content, err := io.ReadAll(r)
+			return err == nil && string(content) == "%PDF-1.7\n"
+		}), int64(-1)).
+			Return(domain.DocumentResponse{Id: 1}, nil).
+			Once()
+
+		resp, err := app.Test(newUploadRequest(t, "/api/employees/1/documents", "contract", "contract.pdf", "%PDF-1.7\n"), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Upload Document BAD REQUEST missing file", func(t *testing.T) {
+		resp, err := app.Test(newUploadRequest(t, "/api/employees/1/documents", "contract", "", ""), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Upload Document BAD REQUEST not multipart", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/documents", strings.NewReader(`{"file": "contract.pdf"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Upload Document UNSUPPORTED MEDIA TYPE", func(t *testing.T) {
+		uc.On("UploadDocument", uint(1), domain.DocumentRequest{Category: domain.DocumentOther, FileName: "notes.txt"}, mock.Anything, int64(-1)).
+			Return(domain.DocumentResponse{}, usecases.ErrUnsupportedDocumentType).
+			Once()
+
+		resp, err := app.Test(newUploadRequest(t, "/api/employees/1/documents", "", "notes.txt", "notes"), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+
+	t.Run("Test Download Document SUCCESS", func(t *testing.T) {
+		uc.On("DownloadDocument", uint(1), uint(2)).
+			Return(domain.DocumentResponse{Id: 2, FileName: "contract.pdf", ContentType: "application/pdf", Size: 7, Checksum: "abc"}, io.NopCloser(strings.NewReader("content")), nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/documents/2", nil), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/pdf", resp.Header.Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="contract.pdf"`, resp.Header.Get("Content-Disposition"))
+		assert.Equal(t, `"abc"`, resp.Header.Get("ETag"))
+
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, "content", string(body))
+	})
+
+	t.Run("Test Delete Document NOT FOUND", func(t *testing.T) {
+		uc.On("DeleteDocument", uint(1), uint(3)).
+			Return(usecases.ErrDocumentNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/employees/1/documents/3", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/RuhullahReza/Employee-App/app/domain"
 	"github.com/RuhullahReza/Employee-App/app/repositories"
@@ -35,6 +36,8 @@ var (
 	}
 )
 
+var ErrInvalidId = errors.New("invalid id")
+
 func NewEmployeeHandler(uc usecases.EmployeeUsecase) *EmployeeHandler {
 	return &EmployeeHandler{
 		employeeUsecase: uc,
@@ -56,7 +59,8 @@ func (h *EmployeeHandler) CreateNewEmployee(ctx *fiber.Ctx) error {
 	res, err := h.employeeUsecase.CreateEmployee(request)
 	if err != nil {
 		logger.Log.Error(err, "failed to create employee")
-		if errors.Is(err, usecases.ErrInvalidDate) || errors.Is(err, usecases.ErrDuplicateEmail) {
+		if errors.Is(err, usecases.ErrInvalidDate) || errors.Is(err, usecases.ErrDuplicateEmail) || isCustomFieldError(err) ||
+			errors.Is(err, usecases.ErrHireDateOutOfRange) {
 			return utils.ResponseBadRequest(ctx, err.Error())
 		}
 
@@ -66,6 +70,42 @@ func (h *EmployeeHandler) CreateNewEmployee(ctx *fiber.Ctx) error {
 	return utils.ResponseCreated(ctx, "Successfully create new employee", res)
 }
 
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+	maxSearchLength    = 100
+)
+
+// SearchEmployees serves typeahead lookups, q matches names and emails by
+// prefix and tolerates typos.
+func (h *EmployeeHandler) SearchEmployees(ctx *fiber.Ctx) error {
+	query := strings.TrimSpace(ctx.Query("q"))
+	if query == "" || len(query) > maxSearchLength {
+		return utils.ResponseBadRequest(ctx, fmt.Sprintf("q is required and must be at most %d characters", maxSearchLength))
+	}
+
+	limit := defaultSearchLimit
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxSearchLimit {
+			return utils.ResponseBadRequest(ctx, fmt.Sprintf("limit must be between 1 and %d", maxSearchLimit))
+		}
+	}
+
+	res, err := h.employeeUsecase.SearchEmployees(query, limit)
+	if err != nil {
+		logger.Log.Error(err, "failed to search employees")
+		if errors.Is(err, usecases.ErrInvalidSearchQuery) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully search employees", res)
+}
+
 func (h *EmployeeHandler) FindAllEmployee(ctx *fiber.Ctx) error {
 	var err error
 
@@ -91,25 +131,62 @@ func (h *EmployeeHandler) FindAllEmployee(ctx *fiber.Ctx) error {
 		}
 	}
 
-	orderBy := ctx.Query("orderBy")
-	if _, ok := validOrder[orderBy]; !ok {
-		orderBy = "created_at"
-	}
+	orderBy, sort := employeeOrder(ctx.Query("orderBy"), ctx.Query("sort"))
 
-	sort := ctx.Query("sort")
-	if _, ok := validSort[sort]; !ok {
-		sort = "DESC"
+	var filter domain.EmployeeFilter
+	if status := ctx.Query("status"); status != "" {
+		for _, s := range strings.Split(status, ",") {
+			s = strings.TrimSpace(s)
+			if !domain.IsValidEmploymentStatus(s) {
+				return utils.ResponseBadRequest(ctx, "invalid status")
+			}
+
+			filter.Statuses = append(filter.Statuses, s)
+		}
 	}
 
-	employees, err := h.employeeUsecase.GetAllEmployee(pageNum, pageSize, orderBy, sort)
+	// Custom fields are filtered with cf.<name>=<value>, the usecase converts
+	// the value to the type of the field.
+	ctx.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		name := string(key)
+		if !strings.HasPrefix(name, domain.CustomFieldOrderPrefix) {
+			return
+		}
+
+		if filter.CustomFields == nil {
+			filter.CustomFields = make(map[string]interface{})
+		}
+
+		filter.CustomFields[strings.TrimPrefix(name, domain.CustomFieldOrderPrefix)] = string(value)
+	})
+
+	employees, err := h.employeeUsecase.GetAllEmployee(pageNum, pageSize, orderBy, sort, filter)
 	if err != nil {
 		logger.Log.Error(err, "failed to get all employee")
+		if isCustomFieldError(err) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
 		return utils.ResponseInternalServerError(ctx, err.Error())
 	}
 
 	return utils.ResponseOK(ctx, "Successfully get all employee data", employees)
 }
 
+// employeeOrder falls back to the newest employees first when the order or
+// the sort is not supported.
+func employeeOrder(orderBy, sort string) (string, string) {
+	if _, ok := validOrder[orderBy]; !ok && !strings.HasPrefix(orderBy, domain.CustomFieldOrderPrefix) {
+		orderBy = "created_at"
+	}
+
+	if _, ok := validSort[sort]; !ok {
+		sort = "DESC"
+	}
+
+	return orderBy, sort
+}
+
 func (h *EmployeeHandler) FindEmployeeById(ctx *fiber.Ctx) error {
 	id := ctx.Params("id")
 
@@ -169,10 +246,15 @@ func (h *EmployeeHandler) UpdateEmployeeById(ctx *fiber.Ctx) error {
 	if err != nil {
 		logger.Log.Error(err, "failed to update employee by id")
 
-		if errors.Is(err, usecases.ErrInvalidDate) || errors.Is(err, usecases.ErrDuplicateEmail) {
+		if errors.Is(err, usecases.ErrInvalidDate) || errors.Is(err, usecases.ErrDuplicateEmail) || isCustomFieldError(err) ||
+			errors.Is(err, usecases.ErrHireDateOutOfRange) {
 			return utils.ResponseBadRequest(ctx, err.Error())
 		}
 
+		if errors.Is(err, usecases.ErrHireDateLocked) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
 		if errors.Is(err, repositories.ErrRecordNotFound) {
 			errMsg := fmt.Sprintf("employee with id %d not found", uintId)
 			return utils.ResponseNotFound(ctx, errMsg)
@@ -213,3 +295,122 @@ func (h *EmployeeHandler) DeleteEmployeeById(ctx *fiber.Ctx) error {
 	msg := fmt.Sprintf("Successfully delete data for employee id %d", uintId)
 	return utils.ResponseOK(ctx, msg, nil)
 }
+
+func (h *EmployeeHandler) ActivateEmployee(ctx *fiber.Ctx) error {
+	return h.changeEmploymentStatus(ctx, h.employeeUsecase.ActivateEmployee)
+}
+
+func (h *EmployeeHandler) PlaceEmployeeOnLeave(ctx *fiber.Ctx) error {
+	return h.changeEmploymentStatus(ctx, h.employeeUsecase.PlaceEmployeeOnLeave)
+}
+
+func (h *EmployeeHandler) ReturnEmployeeFromLeave(ctx *fiber.Ctx) error {
+	return h.changeEmploymentStatus(ctx, h.employeeUsecase.ReturnEmployeeFromLeave)
+}
+
+func (h *EmployeeHandler) TerminateEmployee(ctx *fiber.Ctx) error {
+	var request domain.TerminationRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	return h.changeEmploymentStatus(ctx, func(id uint) (domain.EmployeeResponse, error) {
+		return h.employeeUsecase.TerminateEmployee(id, request)
+	})
+}
+
+func (h *EmployeeHandler) RehireEmployee(ctx *fiber.Ctx) error {
+	var request domain.RehireRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	return h.changeEmploymentStatus(ctx, func(id uint) (domain.EmployeeResponse, error) {
+		return h.employeeUsecase.RehireEmployee(id, request)
+	})
+}
+
+func (h *EmployeeHandler) AssignManager(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.ManagerRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.employeeUsecase.AssignManager(uintId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to assign manager")
+
+		if errors.Is(err, usecases.ErrManagerNotFound) ||
+			errors.Is(err, usecases.ErrInvalidManager) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully assign manager for employee id %d", uintId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *EmployeeHandler) changeEmploymentStatus(ctx *fiber.Ctx, change func(id uint) (domain.EmployeeResponse, error)) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := change(uintId)
+	if err != nil {
+		logger.Log.Error(err, "failed to change employment status")
+
+		if errors.Is(err, usecases.ErrInvalidStatusTransition) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrInvalidDate) ||
+			errors.Is(err, usecases.ErrTerminationBeforeHire) ||
+			errors.Is(err, usecases.ErrRehireBeforeTermination) ||
+			errors.Is(err, usecases.ErrHireDateOutOfRange) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully change employment status for employee id %d", uintId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func parseId(ctx *fiber.Ctx) (uint, error) {
+	return parseParamId(ctx, "id")
+}
+
+func parseParamId(ctx *fiber.Ctx, param string) (uint, error) {
+	intId, err := strconv.Atoi(ctx.Params(param))
+	if err != nil {
+		return 0, err
+	}
+
+	if intId < 1 {
+		return 0, ErrInvalidId
+	}
+
+	return uint(intId), nil
+}
@@ -28,9 +28,45 @@ func TestEmployeeHandler(t *testing.T) {
 	app := fiber.New()
 	app.Post("api/employees", h.CreateNewEmployee)
 	app.Get("api/employees", h.FindAllEmployee)
+	app.Get("api/employees/search", h.SearchEmployees)
 	app.Get("api/employees/:id", h.FindEmployeeById)
 	app.Put("api/employees/:id", h.UpdateEmployeeById)
 	app.Delete("api/employees/:id", h.DeleteEmployeeById)
+	app.Post("api/employees/:id/place-on-leave", h.PlaceEmployeeOnLeave)
+	app.Post("api/employees/:id/terminate", h.TerminateEmployee)
+	app.Post("api/employees/:id/rehire", h.RehireEmployee)
+	app.Put("api/employees/:id/manager", h.AssignManager)
+
+	t.Run("Test Search Employees SUCCESS", func(t *testing.T) {
+		uc.On("SearchEmployees", "jo sm", 5).
+			Return([]domain.EmployeeSearchResult{{Id: 1}}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/search?q=jo%20sm&limit=5", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Search Employees BAD REQUEST", func(t *testing.T) {
+		for _, query := range []string{"", "q=%20", "q=jo&limit=0", "q=jo&limit=51", "q=jo&limit=x"} {
+			resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/search?"+query, nil), 2)
+			assert.NoError(t, err)
+
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+		}
+	})
+
+	t.Run("Test Search Employees invalid query", func(t *testing.T) {
+		uc.On("SearchEmployees", "&&", 10).
+			Return(nil, usecases.ErrInvalidSearchQuery).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/search?q=%26%26", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
 
 	t.Run("Test Create Employee SUCCESS", func(t *testing.T) {
 		req := domain.EmployeeRequest{
@@ -151,7 +187,7 @@ func TestEmployeeHandler(t *testing.T) {
 			Data:      []domain.EmployeeResponse{},
 		}
 
-		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC").
+		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC", domain.EmployeeFilter{}).
 			Return(response, nil).
 			Once()
 
@@ -165,7 +201,7 @@ func TestEmployeeHandler(t *testing.T) {
 	t.Run("Test Get All Employee INTERNAL ERROR", func(t *testing.T) {
 		response := domain.PaginationResponse{}
 
-		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC").
+		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC", domain.EmployeeFilter{}).
 			Return(response, errors.New("error")).
 			Once()
 
@@ -176,6 +212,53 @@ func TestEmployeeHandler(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 	})
 
+	t.Run("Test Get All Employee filter by status SUCCESS", func(t *testing.T) {
+		filter := domain.EmployeeFilter{Statuses: []string{domain.StatusActive, domain.StatusOnLeave}}
+		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC", filter).
+			Return(domain.PaginationResponse{}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees?status=active,on_leave", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Get All Employee filter and order by custom field SUCCESS", func(t *testing.T) {
+		filter := domain.EmployeeFilter{CustomFields: map[string]interface{}{"shirt_size": "L"}}
+		uc.On("GetAllEmployee", 1, 20, "cf.badge", "ASC", filter).
+			Return(domain.PaginationResponse{}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees?cf.shirt_size=L&orderBy=cf.badge&sort=ASC", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Get All Employee BAD REQUEST unknown custom field", func(t *testing.T) {
+		filter := domain.EmployeeFilter{CustomFields: map[string]interface{}{"team": "core"}}
+		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC", filter).
+			Return(domain.PaginationResponse{}, usecases.ErrUnknownCustomField).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees?cf.team=core", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Get All Employee BAD REQUEST invalid status", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees?status=retired", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
 	t.Run("Test Get All Employee BAD REQUEST invalid page size", func(t *testing.T) {
 		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees?pageSize=abc", nil)
 		resp, err := app.Test(httpReq, 2)
@@ -469,4 +552,111 @@ func TestEmployeeHandler(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 	})
+
+	t.Run("Test Terminate Employee SUCCESS", func(t *testing.T) {
+		req := domain.TerminationRequest{TerminationDate: "2024-06-30", Reason: "resigned"}
+		uc.On("TerminateEmployee", uint(1), req).
+			Return(domain.EmployeeResponse{Id: 1, Status: domain.StatusTerminated}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/terminate", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Terminate Employee CONFLICT invalid transition", func(t *testing.T) {
+		req := domain.TerminationRequest{TerminationDate: "2024-06-30"}
+		uc.On("TerminateEmployee", uint(1), req).
+			Return(domain.EmployeeResponse{}, usecases.ErrInvalidStatusTransition).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/terminate", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Rehire Employee BAD REQUEST rehire before termination", func(t *testing.T) {
+		req := domain.RehireRequest{HireDate: "2024-01-01"}
+		uc.On("RehireEmployee", uint(1), req).
+			Return(domain.EmployeeResponse{}, usecases.ErrRehireBeforeTermination).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/rehire", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Place Employee On Leave NOT FOUND", func(t *testing.T) {
+		uc.On("PlaceEmployeeOnLeave", uint(1)).
+			Return(domain.EmployeeResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/place-on-leave", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Place Employee On Leave BAD REQUEST invalid id", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/0/place-on-leave", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Assign Manager SUCCESS", func(t *testing.T) {
+		managerId := uint(2)
+		req := domain.ManagerRequest{ManagerId: &managerId}
+		uc.On("AssignManager", uint(1), req).
+			Return(domain.EmployeeResponse{Id: 1, ManagerId: &managerId}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/employees/1/manager", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Assign Manager BAD REQUEST cycle", func(t *testing.T) {
+		managerId := uint(3)
+		req := domain.ManagerRequest{ManagerId: &managerId}
+		uc.On("AssignManager", uint(1), req).
+			Return(domain.EmployeeResponse{}, usecases.ErrInvalidManager).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/employees/1/manager", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
 }
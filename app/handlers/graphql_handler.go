@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+const (
+	defaultPageSize = 20
+
+	// maxPageSize bounds employee pages so the cost of a page cannot grow
+	// past what the complexity limit expects.
+	maxPageSize = 100
+
+	// directReportsEstimate is the number of direct reports a manager is
+	// assumed to have when the cost of a query is estimated.
+	directReportsEstimate = 10
+)
+
+var (
+	ErrQueryRequired   = errors.New("query is required")
+	ErrQueryTooDeep    = errors.New("query is too deep")
+	ErrQueryTooComplex = errors.New("query is too complex")
+)
+
+type GraphQLHandler struct {
+	employeeUsecase usecases.EmployeeUsecase
+	schema          graphql.Schema
+	maxDepth        int
+	maxComplexity   int
+}
+
+func NewGraphQLHandler(uc usecases.EmployeeUsecase, maxDepth, maxComplexity int) (*GraphQLHandler, error) {
+	h := &GraphQLHandler{
+		employeeUsecase: uc,
+		maxDepth:        maxDepth,
+		maxComplexity:   maxComplexity,
+	}
+
+	schema, err := h.newSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	h.schema = schema
+	return h, nil
+}
+
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// Execute runs a GraphQL query or mutation. Documents that do not parse, are
+// invalid or exceed the depth or complexity limits are rejected with 400
+// before anything is resolved, errors while resolving are returned next to
+// the data with 200.
+func (h *GraphQLHandler) Execute(ctx *fiber.Ctx) error {
+	var request graphQLRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return graphQLBadRequest(ctx, gqlerrors.FormatErrors(err))
+	}
+
+	if strings.TrimSpace(request.Query) == "" {
+		return graphQLBadRequest(ctx, gqlerrors.FormatErrors(ErrQueryRequired))
+	}
+
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return graphQLBadRequest(ctx, gqlerrors.FormatErrors(err))
+	}
+
+	validation := graphql.ValidateDocument(&h.schema, document, nil)
+	if !validation.IsValid {
+		return graphQLBadRequest(ctx, validation.Errors)
+	}
+
+	if err := h.checkLimits(document, request.Variables); err != nil {
+		logger.Log.Info("rejected graphql query", "reason", err.Error())
+		return graphQLBadRequest(ctx, gqlerrors.FormatErrors(err))
+	}
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       withEmployeeLoaders(ctx.UserContext(), newEmployeeLoaders(h.employeeUsecase)),
+	})
+
+	return ctx.Status(fiber.StatusOK).JSON(result)
+}
+
+func graphQLBadRequest(ctx *fiber.Ctx, errs []gqlerrors.FormattedError) error {
+	return ctx.Status(fiber.StatusBadRequest).JSON(graphql.Result{Errors: errs})
+}
+
+// checkLimits measures every operation of the document, the one that runs is
+// only chosen when it executes.
+func (h *GraphQLHandler) checkLimits(document *ast.Document, variables map[string]interface{}) error {
+	cost := queryCost{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+		limit:     h.maxComplexity,
+	}
+
+	var operations []*ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch d := definition.(type) {
+		case *ast.FragmentDefinition:
+			cost.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			operations = append(operations, d)
+		}
+	}
+
+	for _, operation := range operations {
+		cost.defaults = make(map[string]ast.Value)
+		for _, v := range operation.VariableDefinitions {
+			if v.DefaultValue != nil {
+				cost.defaults[v.Variable.Name.Value] = v.DefaultValue
+			}
+		}
+
+		depth, complexity := cost.selections(operation.SelectionSet, 0)
+		if depth > h.maxDepth {
+			return fmt.Errorf("%w, the depth is %d and at most %d is allowed", ErrQueryTooDeep, depth, h.maxDepth)
+		}
+
+		if complexity > h.maxComplexity {
+			return fmt.Errorf("%w, the complexity is %d and at most %d is allowed", ErrQueryTooComplex, complexity, h.maxComplexity)
+		}
+	}
+
+	return nil
+}
+
+// queryCost measures the depth of a query and estimates the number of fields
+// it resolves. Every field costs one, list fields count their selections once
+// for every item they can return. Costs stop growing past limit so large
+// page sizes cannot overflow. Validation has already rejected fragment
+// cycles.
+type queryCost struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	defaults  map[string]ast.Value
+	limit     int
+}
+
+func (c *queryCost) selections(set *ast.SelectionSet, pageSize int) (int, int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	depth, cost := 0, 0
+	for _, selection := range set.Selections {
+		var d, n int
+		switch s := selection.(type) {
+		case *ast.Field:
+			d, n = c.field(s, pageSize)
+		case *ast.InlineFragment:
+			d, n = c.selections(s.SelectionSet, pageSize)
+		case *ast.FragmentSpread:
+			if fragment, ok := c.fragments[s.Name.Value]; ok {
+				d, n = c.selections(fragment.SelectionSet, pageSize)
+			}
+		}
+
+		if d > depth {
+			depth = d
+		}
+		cost += n
+	}
+
+	return depth, cost
+}
+
+// field counts the data of an employee page once per item of the page size,
+// introspection is bounded by the schema and not counted.
+func (c *queryCost) field(f *ast.Field, pageSize int) (int, int) {
+	if strings.HasPrefix(f.Name.Value, "__") {
+		return 0, 0
+	}
+
+	items := 1
+	switch f.Name.Value {
+	case "employees":
+		pageSize = c.intArgument(f, "pageSize", defaultPageSize)
+		// The resolver rejects other page sizes, bounding them here keeps
+		// the cost from overflowing or turning negative.
+		if pageSize < 0 {
+			pageSize = 0
+		}
+		if pageSize > c.limit {
+			pageSize = c.limit + 1
+		}
+	case "data":
+		items = pageSize
+	case "directReports":
+		items = directReportsEstimate
+	}
+
+	depth, cost := c.selections(f.SelectionSet, pageSize)
+	if cost > c.limit {
+		cost = c.limit
+	}
+
+	cost = 1 + items*cost
+	if cost > c.limit {
+		cost = c.limit + 1
+	}
+
+	return depth + 1, cost
+}
+
+func (c *queryCost) intArgument(f *ast.Field, name string, defaultValue int) int {
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != name {
+			continue
+		}
+
+		value := arg.Value
+		if v, ok := value.(*ast.Variable); ok {
+			switch n := c.variables[v.Name.Value].(type) {
+			case float64:
+				return clampInt32(int64(math.Max(-1, math.Min(n, math.MaxInt32))))
+			case int:
+				return clampInt32(int64(n))
+			}
+
+			if value, ok = c.defaults[v.Name.Value]; !ok {
+				return defaultValue
+			}
+		}
+
+		if v, ok := value.(*ast.IntValue); ok {
+			if n, err := strconv.ParseInt(v.Value, 10, 64); err == nil {
+				return clampInt32(n)
+			}
+		}
+	}
+
+	return defaultValue
+}
+
+// clampInt32 bounds n to the non negative values of a GraphQL Int.
+func clampInt32(n int64) int {
+	if n < 0 {
+		return 0
+	}
+
+	if n > math.MaxInt32 {
+		return math.MaxInt32
+	}
+
+	return int(n)
+}
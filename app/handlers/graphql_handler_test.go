@@ -0,0 +1,290 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type graphQLTestResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	logger.Init()
+
+	uc := mocks.NewEmployeeUsecase(t)
+	h, err := NewGraphQLHandler(uc, 10, 5000)
+	assert.NoError(t, err)
+
+	app := fiber.New()
+	app.Post("api/graphql", h.Execute)
+
+	post := func(t *testing.T, query string, variables map[string]interface{}) (int, graphQLTestResponse) {
+		body, _ := json.Marshal(map[string]interface{}{"query": query, "variables": variables})
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/graphql", bytes.NewBuffer(body))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, -1)
+		require.NoError(t, err)
+
+		var res graphQLTestResponse
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+		return resp.StatusCode, res
+	}
+
+	hireDate, _ := utils.ParseDateString("2024-03-03")
+	managerOne, managerTwo := uint(5), uint(6)
+	page := domain.PaginationResponse{
+		PageNum:   1,
+		PageSize:  20,
+		TotalPage: 1,
+		Data: []domain.EmployeeResponse{
+			{Id: 1, FirstName: "John", LastName: "Doe", DisplayName: "John Doe", HireDate: hireDate, ManagerId: &managerOne},
+			{Id: 2, FirstName: "Jane", LastName: "Roe", DisplayName: "Jane Roe", HireDate: hireDate, ManagerId: &managerTwo},
+			{Id: 3, FirstName: "Max", LastName: "Moe", DisplayName: "Max Moe", HireDate: hireDate, ManagerId: &managerOne},
+		},
+	}
+
+	t.Run("Test Employees SUCCESS with batched managers", func(t *testing.T) {
+		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC", domain.EmployeeFilter{}).
+			Return(page, nil).
+			Once()
+		uc.On("GetEmployeesByIds", []uint{5, 6}).
+			Return(map[uint]domain.EmployeeResponse{
+				5: {Id: 5, DisplayName: "Ann Boss"},
+				6: {Id: 6, DisplayName: "Bob Boss"},
+			}, nil).
+			Once()
+
+		status, res := post(t, `{ employees { pageNumber totalPage data { id hireDate manager { displayName } } } }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, res.Errors)
+
+		data := res.Data["employees"].(map[string]interface{})["data"].([]interface{})
+		assert.Len(t, data, 3)
+		assert.Equal(t, map[string]interface{}{
+			"id":       float64(3),
+			"hireDate": "2024-03-03",
+			"manager":  map[string]interface{}{"displayName": "Ann Boss"},
+		}, data[2])
+	})
+
+	t.Run("Test Employees SUCCESS with batched direct reports", func(t *testing.T) {
+		uc.On("GetAllEmployee", 1, 20, "created_at", "DESC", domain.EmployeeFilter{}).
+			Return(page, nil).
+			Once()
+		uc.On("GetDirectReports", []uint{1, 2, 3}).
+			Return(map[uint][]domain.EmployeeResponse{
+				1: {{Id: 7}, {Id: 8}},
+			}, nil).
+			Once()
+
+		status, res := post(t, `{ employees { data { id directReports { id } } } }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, res.Errors)
+
+		data := res.Data["employees"].(map[string]interface{})["data"].([]interface{})
+		assert.Len(t, data[0].(map[string]interface{})["directReports"], 2)
+		assert.Empty(t, data[1].(map[string]interface{})["directReports"])
+	})
+
+	t.Run("Test Employees SUCCESS with filters", func(t *testing.T) {
+		uc.On("GetAllEmployee", 2, 5, "created_at", "ASC", domain.EmployeeFilter{
+			Statuses:     []string{domain.StatusActive},
+			CustomFields: map[string]interface{}{"badge": "7"},
+		}).
+			Return(domain.PaginationResponse{PageNum: 2, PageSize: 5}, nil).
+			Once()
+
+		query := `query($status: [String!]) {
+			employees(pageNum: 2, pageSize: 5, orderBy: "salary", sort: "ASC", status: $status, customFields: [{name: "badge", value: "7"}]) {
+				pageNumber data { id }
+			}
+		}`
+		status, res := post(t, query, map[string]interface{}{"status": []string{domain.StatusActive}})
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, res.Errors)
+		assert.Equal(t, map[string]interface{}{"pageNumber": float64(2), "data": []interface{}{}}, res.Data["employees"])
+	})
+
+	t.Run("Test Employees BAD REQUEST page size", func(t *testing.T) {
+		status, res := post(t, `{ employees(pageSize: 101) { pageSize } }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, res.Errors, 1)
+		assert.Equal(t, "BAD_REQUEST", res.Errors[0].Extensions["code"])
+		assert.Equal(t, "pageSize must be at most 100", res.Errors[0].Message)
+	})
+
+	t.Run("Test Employees BAD REQUEST status", func(t *testing.T) {
+		status, res := post(t, `{ employees(status: ["hired"]) { data { id } } }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, res.Errors, 1)
+		assert.Equal(t, "BAD_REQUEST", res.Errors[0].Extensions["code"])
+	})
+
+	t.Run("Test Employees BAD REQUEST custom field", func(t *testing.T) {
+		uc.On("GetAllEmployee", 1, 20, "cf.team", "DESC", domain.EmployeeFilter{}).
+			Return(domain.PaginationResponse{}, usecases.ErrUnknownCustomField).
+			Once()
+
+		status, res := post(t, `{ employees(orderBy: "cf.team") { data { id } } }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Len(t, res.Errors, 1)
+		assert.Equal(t, "BAD_REQUEST", res.Errors[0].Extensions["code"])
+	})
+
+	t.Run("Test Employee NULL when not found", func(t *testing.T) {
+		uc.On("GetEmployeeById", uint(9)).
+			Return(domain.EmployeeResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		status, res := post(t, `{ employee(id: 9) { id } }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, res.Errors)
+		assert.Nil(t, res.Data["employee"])
+	})
+
+	t.Run("Test BAD REQUEST too deep", func(t *testing.T) {
+		query := `{ employees { data { ` + strings.Repeat("manager { ", 8) + "id" + strings.Repeat(" }", 8) + ` } } }`
+		status, res := post(t, query, nil)
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Nil(t, res.Data)
+		assert.Contains(t, res.Errors[0].Message, ErrQueryTooDeep.Error())
+	})
+
+	t.Run("Test BAD REQUEST too complex", func(t *testing.T) {
+		status, res := post(t, `{ employees(pageSize: 3000) { data { id firstName } } }`, nil)
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, res.Errors[0].Message, ErrQueryTooComplex.Error())
+	})
+
+	t.Run("Test BAD REQUEST too complex page size variable", func(t *testing.T) {
+		query := `query($size: Int = 20) { employees(pageSize: $size) { data { ...names } } } fragment names on Employee { firstName lastName }`
+		status, res := post(t, query, map[string]interface{}{"size": 3000})
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, res.Errors[0].Message, ErrQueryTooComplex.Error())
+	})
+
+	t.Run("Test BAD REQUEST too complex huge page size", func(t *testing.T) {
+		query := `query($size: Int) { employees(pageSize: $size) { data { id } } }`
+		status, res := post(t, query, map[string]interface{}{"size": 1 << 62})
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, res.Errors[0].Message, ErrQueryTooComplex.Error())
+	})
+
+	t.Run("Test BAD REQUEST too complex direct reports", func(t *testing.T) {
+		query := `{ employees(pageSize: 50) { data { directReports { directReports { id } } } } }`
+		status, res := post(t, query, nil)
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Contains(t, res.Errors[0].Message, ErrQueryTooComplex.Error())
+	})
+
+	t.Run("Test BAD REQUEST invalid query", func(t *testing.T) {
+		status, res := post(t, `{ employees { data { salary } } }`, nil)
+
+		assert.Equal(t, http.StatusBadRequest, status)
+		assert.Len(t, res.Errors, 1)
+
+		status, _ = post(t, `{ employees {`, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+
+		status, _ = post(t, ``, nil)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Test Create Employee SUCCESS", func(t *testing.T) {
+		uc.On("CreateEmployee", domain.EmployeeRequest{FirstName: "John", LastName: "Doe", Email: "john.doe@gmail.com", HireDate: "2024-03-03"}).
+			Return(domain.EmployeeResponse{Id: 1, FirstName: "John", HireDate: hireDate}, nil).
+			Once()
+
+		query := `mutation { createEmployee(input: {firstName: "John", lastName: "Doe", email: "john.doe@gmail.com", hireDate: "2024-03-03"}) { id firstName } }`
+		status, res := post(t, query, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Empty(t, res.Errors)
+		assert.Equal(t, map[string]interface{}{"id": float64(1), "firstName": "John"}, res.Data["createEmployee"])
+	})
+
+	t.Run("Test Create Employee BAD REQUEST email", func(t *testing.T) {
+		query := `mutation { createEmployee(input: {firstName: "John", email: "john.doe", hireDate: "2024-03-03"}) { id } }`
+		status, res := post(t, query, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "BAD_REQUEST", res.Errors[0].Extensions["code"])
+	})
+
+	t.Run("Test Update Employee NOT FOUND", func(t *testing.T) {
+		uc.On("UpdateEmployeeById", uint(9), domain.EmployeeRequest{FirstName: "John", Email: "john.doe@gmail.com", HireDate: "2024-03-03"}).
+			Return(domain.EmployeeResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		query := `mutation($input: EmployeeInput!) { updateEmployee(id: 9, input: $input) { id } }`
+		status, res := post(t, query, map[string]interface{}{
+			"input": map[string]interface{}{"firstName": "John", "email": "john.doe@gmail.com", "hireDate": "2024-03-03"},
+		})
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "NOT_FOUND", res.Errors[0].Extensions["code"])
+		assert.Equal(t, "employee with id 9 not found", res.Errors[0].Message)
+	})
+
+	t.Run("Test Update Employee CONFLICT", func(t *testing.T) {
+		uc.On("UpdateEmployeeById", uint(1), domain.EmployeeRequest{FirstName: "John", Email: "john.doe@gmail.com", HireDate: "2024-03-04"}).
+			Return(domain.EmployeeResponse{}, usecases.ErrHireDateLocked).
+			Once()
+
+		query := `mutation { updateEmployee(id: 1, input: {firstName: "John", email: "john.doe@gmail.com", hireDate: "2024-03-04"}) { id } }`
+		status, res := post(t, query, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "CONFLICT", res.Errors[0].Extensions["code"])
+	})
+
+	t.Run("Test Delete Employee SUCCESS", func(t *testing.T) {
+		uc.On("DeleteEmployeeById", uint(1)).
+			Return(nil).
+			Once()
+
+		status, res := post(t, `mutation { deleteEmployee(id: 1) }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, true, res.Data["deleteEmployee"])
+	})
+
+	t.Run("Test Delete Employee BAD REQUEST id", func(t *testing.T) {
+		status, res := post(t, `mutation { deleteEmployee(id: 0) }`, nil)
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "BAD_REQUEST", res.Errors[0].Extensions["code"])
+	})
+}
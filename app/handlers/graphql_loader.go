@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+)
+
+// loaderBatch holds the keys requested together and, once fetched, their
+// values.
+type loaderBatch struct {
+	keys   []uint
+	values map[uint]interface{}
+	err    error
+	done   bool
+}
+
+// batchLoader collects the keys requested while a level of a query resolves
+// and fetches them with one call when the first of their values is needed.
+// Queries resolve the values of a level after all of its fields, so the
+// managers of a whole page are fetched at once. Values are kept for the
+// request, a key is fetched at most once.
+type batchLoader struct {
+	fetch func(keys []uint) (map[uint]interface{}, error)
+
+	mu      sync.Mutex
+	pending *loaderBatch
+	batches map[uint]*loaderBatch
+}
+
+func newBatchLoader(fetch func(keys []uint) (map[uint]interface{}, error)) *batchLoader {
+	return &batchLoader{
+		fetch:   fetch,
+		batches: make(map[uint]*loaderBatch),
+	}
+}
+
+// Load queues key and returns a thunk that resolves to its value, nil when
+// there is none.
+func (l *batchLoader) Load(key uint) func() (interface{}, error) {
+	l.mu.Lock()
+	b, ok := l.batches[key]
+	if !ok {
+		if l.pending == nil {
+			l.pending = &loaderBatch{}
+		}
+
+		b = l.pending
+		b.keys = append(b.keys, key)
+		l.batches[key] = b
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if !b.done {
+			if l.pending == b {
+				l.pending = nil
+			}
+
+			b.values, b.err = l.fetch(b.keys)
+			b.done = true
+		}
+
+		if b.err != nil {
+			return nil, b.err
+		}
+
+		return b.values[key], nil
+	}
+}
+
+// employeeLoaders are the loaders of one request.
+type employeeLoaders struct {
+	employees     *batchLoader
+	directReports *batchLoader
+}
+
+func newEmployeeLoaders(uc usecases.EmployeeUsecase) *employeeLoaders {
+	return &employeeLoaders{
+		employees: newBatchLoader(func(ids []uint) (map[uint]interface{}, error) {
+			employees, err := uc.GetEmployeesByIds(ids)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[uint]interface{}, len(employees))
+			for id, e := range employees {
+				values[id] = e
+			}
+
+			return values, nil
+		}),
+		directReports: newBatchLoader(func(managerIds []uint) (map[uint]interface{}, error) {
+			reports, err := uc.GetDirectReports(managerIds)
+			if err != nil {
+				return nil, err
+			}
+
+			values := make(map[uint]interface{}, len(managerIds))
+			for _, id := range managerIds {
+				if reports[id] == nil {
+					values[id] = []domain.EmployeeResponse{}
+					continue
+				}
+
+				values[id] = reports[id]
+			}
+
+			return values, nil
+		}),
+	}
+}
+
+type loadersKey struct{}
+
+func withEmployeeLoaders(ctx context.Context, loaders *employeeLoaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, loaders)
+}
+
+func loadersFrom(ctx context.Context) *employeeLoaders {
+	return ctx.Value(loadersKey{}).(*employeeLoaders)
+}
@@ -0,0 +1,484 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Error codes set in the extensions of GraphQL errors, they name the status
+// the REST endpoints answer with.
+const (
+	codeBadRequest = "BAD_REQUEST"
+	codeNotFound   = "NOT_FOUND"
+	codeConflict   = "CONFLICT"
+	codeInternal   = "INTERNAL_SERVER_ERROR"
+)
+
+type graphQLError struct {
+	message string
+	code    string
+}
+
+func (e graphQLError) Error() string {
+	return e.message
+}
+
+func (e graphQLError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// jsonScalar passes custom field values through as they are.
+var jsonScalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "JSON",
+	Description: "Any JSON value.",
+	Serialize: func(value interface{}) interface{} {
+		return value
+	},
+	ParseValue: func(value interface{}) interface{} {
+		return value
+	},
+	ParseLiteral: parseJSONLiteral,
+})
+
+// parseJSONLiteral converts an inline value the way a JSON variable would be
+// decoded, numbers become float64.
+func parseJSONLiteral(value ast.Value) interface{} {
+	switch v := value.(type) {
+	case *ast.StringValue:
+		return v.Value
+	case *ast.BooleanValue:
+		return v.Value
+	case *ast.IntValue:
+		n, _ := strconv.ParseFloat(v.Value, 64)
+		return n
+	case *ast.FloatValue:
+		n, _ := strconv.ParseFloat(v.Value, 64)
+		return n
+	case *ast.ListValue:
+		values := make([]interface{}, 0, len(v.Values))
+		for _, item := range v.Values {
+			values = append(values, parseJSONLiteral(item))
+		}
+
+		return values
+	case *ast.ObjectValue:
+		values := make(map[string]interface{}, len(v.Fields))
+		for _, field := range v.Fields {
+			values[field.Name.Value] = parseJSONLiteral(field.Value)
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+func (h *GraphQLHandler) newSchema() (graphql.Schema, error) {
+	jobType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "JobAssignment",
+		Fields: graphql.Fields{
+			"id":         jobField(graphql.NewNonNull(graphql.Int), func(j *domain.JobAssignmentResponse) interface{} { return int(j.Id) }),
+			"positionId": jobField(graphql.NewNonNull(graphql.Int), func(j *domain.JobAssignmentResponse) interface{} { return int(j.PositionId) }),
+			"title":      jobField(graphql.NewNonNull(graphql.String), func(j *domain.JobAssignmentResponse) interface{} { return j.Title }),
+			"department": jobField(graphql.NewNonNull(graphql.String), func(j *domain.JobAssignmentResponse) interface{} { return j.Department }),
+			"grade":      jobField(graphql.NewNonNull(graphql.String), func(j *domain.JobAssignmentResponse) interface{} { return j.Grade }),
+			"startDate":  jobField(graphql.NewNonNull(graphql.String), func(j *domain.JobAssignmentResponse) interface{} { return j.StartDate.Format(utils.ISODate) }),
+			"endDate":    jobField(graphql.String, func(j *domain.JobAssignmentResponse) interface{} { return optionalDate(j.EndDate) }),
+		},
+	})
+
+	var employeeType *graphql.Object
+	employeeType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Employee",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id":                employeeField(graphql.NewNonNull(graphql.Int), func(e domain.EmployeeResponse) interface{} { return int(e.Id) }),
+				"firstName":         employeeField(graphql.NewNonNull(graphql.String), func(e domain.EmployeeResponse) interface{} { return e.FirstName }),
+				"lastName":          employeeField(graphql.NewNonNull(graphql.String), func(e domain.EmployeeResponse) interface{} { return e.LastName }),
+				"preferredName":     employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalString(e.PreferredName) }),
+				"pronouns":          employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalString(e.Pronouns) }),
+				"nameLocale":        employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalString(e.NameLocale) }),
+				"displayName":       employeeField(graphql.NewNonNull(graphql.String), func(e domain.EmployeeResponse) interface{} { return e.DisplayName }),
+				"email":             employeeField(graphql.NewNonNull(graphql.String), func(e domain.EmployeeResponse) interface{} { return e.Email }),
+				"hireDate":          employeeField(graphql.NewNonNull(graphql.String), func(e domain.EmployeeResponse) interface{} { return e.HireDate.Format(utils.ISODate) }),
+				"status":            employeeField(graphql.NewNonNull(graphql.String), func(e domain.EmployeeResponse) interface{} { return e.Status }),
+				"terminationDate":   employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalDate(e.TerminationDate) }),
+				"terminationReason": employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalString(e.TerminationReason) }),
+				"managerId":         employeeField(graphql.Int, func(e domain.EmployeeResponse) interface{} { return optionalId(e.ManagerId) }),
+				"calendarId":        employeeField(graphql.Int, func(e domain.EmployeeResponse) interface{} { return optionalId(e.CalendarId) }),
+				"customFields":      employeeField(jsonScalar, func(e domain.EmployeeResponse) interface{} { return e.CustomFields }),
+				"photoUrl":          employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalString(e.PhotoUrl) }),
+				"createdAt":         employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalTime(e.CreatedAt) }),
+				"updatedAt":         employeeField(graphql.String, func(e domain.EmployeeResponse) interface{} { return optionalTime(e.UpdatedAt) }),
+				"currentJob": &graphql.Field{
+					Type: jobType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						if job := p.Source.(domain.EmployeeResponse).CurrentJob; job != nil {
+							return job, nil
+						}
+
+						return nil, nil
+					},
+				},
+				"manager": &graphql.Field{
+					Type: employeeType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						managerId := p.Source.(domain.EmployeeResponse).ManagerId
+						if managerId == nil {
+							return nil, nil
+						}
+
+						return loadersFrom(p.Context).employees.Load(*managerId), nil
+					},
+				},
+				"directReports": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(employeeType))),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFrom(p.Context).directReports.Load(p.Source.(domain.EmployeeResponse).Id), nil
+					},
+				},
+			}
+		}),
+	})
+
+	pageType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EmployeePage",
+		Fields: graphql.Fields{
+			"pageNumber": pageField(graphql.NewNonNull(graphql.Int), func(p domain.PaginationResponse) interface{} { return p.PageNum }),
+			"pageSize":   pageField(graphql.NewNonNull(graphql.Int), func(p domain.PaginationResponse) interface{} { return p.PageSize }),
+			"totalPage":  pageField(graphql.NewNonNull(graphql.Int), func(p domain.PaginationResponse) interface{} { return int(p.TotalPage) }),
+			"data": pageField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(employeeType))), func(p domain.PaginationResponse) interface{} {
+				if p.Data == nil {
+					return []domain.EmployeeResponse{}
+				}
+
+				return p.Data
+			}),
+		},
+	})
+
+	customFieldFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "CustomFieldFilter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":  &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"value": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+		},
+	})
+
+	employeeInputType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "EmployeeInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"firstName":     &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"lastName":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"preferredName": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"pronouns":      &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"nameLocale":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"email":         &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"hireDate":      &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"customFields":  &graphql.InputObjectFieldConfig{Type: jsonScalar},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"employees": &graphql.Field{
+				Type: graphql.NewNonNull(pageType),
+				Args: graphql.FieldConfigArgument{
+					"pageNum":      &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 1},
+					"pageSize":     &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageSize},
+					"orderBy":      &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "created_at"},
+					"sort":         &graphql.ArgumentConfig{Type: graphql.String, DefaultValue: "DESC"},
+					"status":       &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+					"customFields": &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(customFieldFilterType))},
+				},
+				Resolve: h.resolveEmployees,
+			},
+			"employee": &graphql.Field{
+				Type: employeeType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: h.resolveEmployee,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"createEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(employeeInputType)},
+				},
+				Resolve: h.resolveCreateEmployee,
+			},
+			"updateEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(employeeType),
+				Args: graphql.FieldConfigArgument{
+					"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(employeeInputType)},
+				},
+				Resolve: h.resolveUpdateEmployee,
+			},
+			"deleteEmployee": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: h.resolveDeleteEmployee,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+}
+
+// resolveEmployees lists employees like FindAllEmployee, unsupported orders
+// and sorts fall back to the newest employees first.
+func (h *GraphQLHandler) resolveEmployees(p graphql.ResolveParams) (interface{}, error) {
+	pageNum, _ := p.Args["pageNum"].(int)
+	pageSize, _ := p.Args["pageSize"].(int)
+	if pageNum < 1 || pageSize < 1 {
+		return nil, graphQLError{message: "pageNum and pageSize must be at least 1", code: codeBadRequest}
+	}
+
+	if pageSize > maxPageSize {
+		return nil, graphQLError{message: fmt.Sprintf("pageSize must be at most %d", maxPageSize), code: codeBadRequest}
+	}
+
+	orderBy, _ := p.Args["orderBy"].(string)
+	sort, _ := p.Args["sort"].(string)
+	orderBy, sort = employeeOrder(orderBy, sort)
+
+	var filter domain.EmployeeFilter
+	statuses, _ := p.Args["status"].([]interface{})
+	for _, s := range statuses {
+		status := strings.TrimSpace(s.(string))
+		if !domain.IsValidEmploymentStatus(status) {
+			return nil, graphQLError{message: "invalid status", code: codeBadRequest}
+		}
+
+		filter.Statuses = append(filter.Statuses, status)
+	}
+
+	customFields, _ := p.Args["customFields"].([]interface{})
+	for _, f := range customFields {
+		field := f.(map[string]interface{})
+		if filter.CustomFields == nil {
+			filter.CustomFields = make(map[string]interface{})
+		}
+
+		filter.CustomFields[field["name"].(string)] = field["value"]
+	}
+
+	res, err := h.employeeUsecase.GetAllEmployee(pageNum, pageSize, orderBy, sort, filter)
+	if err != nil {
+		logger.Log.Error(err, "failed to get all employee")
+		if isCustomFieldError(err) {
+			return nil, graphQLError{message: err.Error(), code: codeBadRequest}
+		}
+
+		return nil, graphQLError{message: err.Error(), code: codeInternal}
+	}
+
+	return res, nil
+}
+
+// resolveEmployee returns null for an employee that does not exist.
+func (h *GraphQLHandler) resolveEmployee(p graphql.ResolveParams) (interface{}, error) {
+	id, err := idArgument(p)
+	if err != nil {
+		return nil, err
+	}
+
+	employee, err := h.employeeUsecase.GetEmployeeById(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, graphQLError{message: err.Error(), code: codeInternal}
+	}
+
+	return employee, nil
+}
+
+func (h *GraphQLHandler) resolveCreateEmployee(p graphql.ResolveParams) (interface{}, error) {
+	request := employeeRequest(p.Args["input"].(map[string]interface{}))
+	if err := utils.ValidateAndSanitizeRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return nil, graphQLError{message: err.Error(), code: codeBadRequest}
+	}
+
+	res, err := h.employeeUsecase.CreateEmployee(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create employee")
+		return nil, employeeWriteError(err, 0)
+	}
+
+	return res, nil
+}
+
+func (h *GraphQLHandler) resolveUpdateEmployee(p graphql.ResolveParams) (interface{}, error) {
+	id, err := idArgument(p)
+	if err != nil {
+		return nil, err
+	}
+
+	request := employeeRequest(p.Args["input"].(map[string]interface{}))
+	if err := utils.ValidateAndSanitizeRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return nil, graphQLError{message: err.Error(), code: codeBadRequest}
+	}
+
+	res, err := h.employeeUsecase.UpdateEmployeeById(id, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to update employee by id")
+		return nil, employeeWriteError(err, id)
+	}
+
+	return res, nil
+}
+
+func (h *GraphQLHandler) resolveDeleteEmployee(p graphql.ResolveParams) (interface{}, error) {
+	id, err := idArgument(p)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.employeeUsecase.DeleteEmployeeById(id); err != nil {
+		logger.Log.Error(err, "failed to delete employee by id")
+		return nil, employeeWriteError(err, id)
+	}
+
+	return true, nil
+}
+
+// employeeWriteError maps the errors of the employee writes to the codes of
+// the statuses the REST endpoints answer with.
+func employeeWriteError(err error, id uint) error {
+	if errors.Is(err, usecases.ErrInvalidDate) || errors.Is(err, usecases.ErrDuplicateEmail) || isCustomFieldError(err) ||
+		errors.Is(err, usecases.ErrHireDateOutOfRange) {
+		return graphQLError{message: err.Error(), code: codeBadRequest}
+	}
+
+	if errors.Is(err, usecases.ErrHireDateLocked) {
+		return graphQLError{message: err.Error(), code: codeConflict}
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return graphQLError{message: fmt.Sprintf("employee with id %d not found", id), code: codeNotFound}
+	}
+
+	return graphQLError{message: err.Error(), code: codeInternal}
+}
+
+func idArgument(p graphql.ResolveParams) (uint, error) {
+	id, _ := p.Args["id"].(int)
+	if id < 1 {
+		return 0, graphQLError{message: ErrInvalidId.Error(), code: codeBadRequest}
+	}
+
+	return uint(id), nil
+}
+
+// employeeRequest converts an EmployeeInput, name preferences left out keep
+// their value like in the REST request.
+func employeeRequest(input map[string]interface{}) domain.EmployeeRequest {
+	var request domain.EmployeeRequest
+	request.FirstName, _ = input["firstName"].(string)
+	request.LastName, _ = input["lastName"].(string)
+	request.Email, _ = input["email"].(string)
+	request.HireDate, _ = input["hireDate"].(string)
+	request.CustomFields, _ = input["customFields"].(map[string]interface{})
+
+	if v, ok := input["preferredName"].(string); ok {
+		request.PreferredName = &v
+	}
+
+	if v, ok := input["pronouns"].(string); ok {
+		request.Pronouns = &v
+	}
+
+	if v, ok := input["nameLocale"].(string); ok {
+		request.NameLocale = &v
+	}
+
+	return request
+}
+
+func employeeField(typ graphql.Output, value func(e domain.EmployeeResponse) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return value(p.Source.(domain.EmployeeResponse)), nil
+		},
+	}
+}
+
+func jobField(typ graphql.Output, value func(j *domain.JobAssignmentResponse) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return value(p.Source.(*domain.JobAssignmentResponse)), nil
+		},
+	}
+}
+
+func pageField(typ graphql.Output, value func(p domain.PaginationResponse) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return value(p.Source.(domain.PaginationResponse)), nil
+		},
+	}
+}
+
+func optionalString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+func optionalId(id *uint) interface{} {
+	if id == nil {
+		return nil
+	}
+
+	return int(*id)
+}
+
+func optionalDate(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return t.Format(utils.ISODate)
+}
+
+func optionalTime(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+
+	return t.Format(time.RFC3339)
+}
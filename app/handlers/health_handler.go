@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type HealthHandler struct {
+	ready func() bool
+}
+
+func NewHealthHandler(ready func() bool) *HealthHandler {
+	return &HealthHandler{
+		ready: ready,
+	}
+}
+
+func (h *HealthHandler) Live(ctx *fiber.Ctx) error {
+	return utils.ResponseOK(ctx, "alive", nil)
+}
+
+func (h *HealthHandler) Ready(ctx *fiber.Ctx) error {
+	if !h.ready() {
+		return utils.ResponseServiceUnavailable(ctx, "not ready")
+	}
+
+	return utils.ResponseOK(ctx, "ready", nil)
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthHandler(t *testing.T) {
+	ready := false
+	h := NewHealthHandler(func() bool { return ready })
+
+	app := fiber.New()
+	app.Get("health/live", h.Live)
+	app.Get("health/ready", h.Ready)
+
+	t.Run("Test Live SUCCESS", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodGet, "/health/live", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Ready not ready", func(t *testing.T) {
+		ready = false
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	})
+
+	t.Run("Test Ready SUCCESS", func(t *testing.T) {
+		ready = true
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/health/ready", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
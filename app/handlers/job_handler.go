@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type JobHandler struct {
+	jobUsecase usecases.JobUsecase
+}
+
+func NewJobHandler(uc usecases.JobUsecase) *JobHandler {
+	return &JobHandler{
+		jobUsecase: uc,
+	}
+}
+
+func (h *JobHandler) AssignJob(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.JobAssignmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.jobUsecase.AssignJob(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to assign job")
+
+		if errors.Is(err, usecases.ErrInvalidDate) ||
+			errors.Is(err, usecases.ErrJobStartBeforeHire) ||
+			errors.Is(err, usecases.ErrJobStartBeforeCurrentJob) ||
+			errors.Is(err, usecases.ErrEmployeeTerminated) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d or position with id %d not found", employeeId, request.PositionID)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully assign job for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *JobHandler) FindJobHistory(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.jobUsecase.GetJobHistory(employeeId, ctx.Query("date"))
+	if err != nil {
+		logger.Log.Error(err, "failed to get job history")
+
+		if errors.Is(err, usecases.ErrInvalidDate) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get job history for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.JobUsecase)
+	h := NewJobHandler(uc)
+
+	app := fiber.New()
+	app.Get("api/employees/:id/jobs", h.FindJobHistory)
+	app.Post("api/employees/:id/jobs", h.AssignJob)
+
+	t.Run("Test Assign Job SUCCESS", func(t *testing.T) {
+		req := domain.JobAssignmentRequest{PositionID: 2, StartDate: "2024-04-01"}
+		uc.On("AssignJob", uint(1), req).
+			Return(domain.JobAssignmentResponse{Id: 1, PositionId: 2}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/jobs", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Assign Job BAD REQUEST start before current job", func(t *testing.T) {
+		req := domain.JobAssignmentRequest{PositionID: 2, StartDate: "2020-04-01"}
+		uc.On("AssignJob", uint(1), req).
+			Return(domain.JobAssignmentResponse{}, usecases.ErrJobStartBeforeCurrentJob).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/jobs", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Get Job History SUCCESS", func(t *testing.T) {
+		uc.On("GetJobHistory", uint(1), "2023-01-01").
+			Return([]domain.JobAssignmentResponse{{Id: 1, Title: "Engineer"}}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/1/jobs?date=2023-01-01", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Get Job History NOT FOUND", func(t *testing.T) {
+		uc.On("GetJobHistory", uint(2), "").
+			Return(nil, repositories.ErrRecordNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/2/jobs", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LeaveHandler struct {
+	leaveUsecase usecases.LeaveUsecase
+}
+
+func NewLeaveHandler(uc usecases.LeaveUsecase) *LeaveHandler {
+	return &LeaveHandler{
+		leaveUsecase: uc,
+	}
+}
+
+func (h *LeaveHandler) CreateLeaveType(ctx *fiber.Ctx) error {
+	var request domain.LeaveTypeRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeLeaveTypeRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.leaveUsecase.CreateLeaveType(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create leave type")
+		if errors.Is(err, usecases.ErrDuplicateLeaveType) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new leave type", res)
+}
+
+func (h *LeaveHandler) FindAllLeaveType(ctx *fiber.Ctx) error {
+	res, err := h.leaveUsecase.GetAllLeaveType()
+	if err != nil {
+		logger.Log.Error(err, "failed to get all leave type")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all leave type data", res)
+}
+
+func (h *LeaveHandler) FindLeaveBalances(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	year := utils.Today().Year()
+	if ctx.Query("year") != "" {
+		year, err = strconv.Atoi(ctx.Query("year"))
+		if err != nil || year < 1 {
+			return utils.ResponseBadRequest(ctx, "invalid year")
+		}
+	}
+
+	res, err := h.leaveUsecase.GetLeaveBalances(employeeId, year)
+	if err != nil {
+		logger.Log.Error(err, "failed to get leave balances")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get leave balances for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *LeaveHandler) RequestLeave(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.LeaveRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.leaveUsecase.RequestLeave(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to request leave")
+
+		if errors.Is(err, usecases.ErrInvalidDate) ||
+			errors.Is(err, usecases.ErrLeaveEndBeforeStart) ||
+			errors.Is(err, usecases.ErrLeaveSpansYears) ||
+			errors.Is(err, usecases.ErrRangeTooLong) ||
+			errors.Is(err, usecases.ErrLeaveBeforeHire) ||
+			errors.Is(err, usecases.ErrNoWorkingDays) ||
+			errors.Is(err, usecases.ErrLeaveTypeNotFound) ||
+			errors.Is(err, usecases.ErrInsufficientLeaveBalance) ||
+			errors.Is(err, usecases.ErrEmployeeTerminated) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrLeaveOverlap) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully request leave for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *LeaveHandler) FindLeaveRequests(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.leaveUsecase.GetLeaveRequests(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get leave requests")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get leave requests for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *LeaveHandler) ApproveLeave(ctx *fiber.Ctx) error {
+	return h.decideLeave(ctx, h.leaveUsecase.ApproveLeave)
+}
+
+func (h *LeaveHandler) RejectLeave(ctx *fiber.Ctx) error {
+	return h.decideLeave(ctx, h.leaveUsecase.RejectLeave)
+}
+
+// decideLeave acts for the employee the API key belongs to, only the manager
+// of the employee on leave can decide.
+func (h *LeaveHandler) decideLeave(ctx *fiber.Ctx, decide func(id, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error)) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	approverId, ok := middleware.Employee(ctx)
+	if !ok {
+		return utils.ResponseForbidden(ctx, middleware.ErrNoEmployee.Error())
+	}
+
+	var request domain.LeaveDecisionRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := decide(uintId, approverId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to decide leave request")
+
+		if errors.Is(err, usecases.ErrNotEmployeeManager) {
+			return utils.ResponseForbidden(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrLeaveNotPending) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrInsufficientLeaveBalance) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("leave request with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully %s leave request id %d", res.Status, uintId)
+	return utils.ResponseOK(ctx, msg, res)
+}
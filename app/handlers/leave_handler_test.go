@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLeaveHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.LeaveUsecase)
+	h := NewLeaveHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/leave-types", h.CreateLeaveType)
+	app.Get("api/employees/:id/leave-balances", h.FindLeaveBalances)
+	app.Post("api/employees/:id/leave-requests", h.RequestLeave)
+	manager := func(ctx *fiber.Ctx) error {
+		ctx.Locals(middleware.EmployeeKey, uint(2))
+		return ctx.Next()
+	}
+	app.Post("api/leave-requests/:id/approve", manager, h.ApproveLeave)
+	app.Post("api/leave-requests/:id/reject", h.RejectLeave)
+
+	post := func(url string, body interface{}) int {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(body)
+
+		httpReq := httptest.NewRequest(http.MethodPost, url, &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		return resp.StatusCode
+	}
+
+	t.Run("Test Create Leave Type SUCCESS", func(t *testing.T) {
+		uc.On("CreateLeaveType", domain.LeaveTypeRequest{Code: "annual", Name: "Annual Leave", AnnualDays: 12}).
+			Return(domain.LeaveTypeResponse{Id: 1, Code: "annual"}, nil).
+			Once()
+
+		status := post("/api/leave-types", domain.LeaveTypeRequest{Code: "Annual", Name: "Annual Leave", AnnualDays: 12})
+		assert.Equal(t, http.StatusCreated, status)
+	})
+
+	t.Run("Test Create Leave Type BAD REQUEST", func(t *testing.T) {
+		status := post("/api/leave-types", domain.LeaveTypeRequest{Name: "Annual Leave"})
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("Test Get Leave Balances SUCCESS", func(t *testing.T) {
+		uc.On("GetLeaveBalances", uint(1), 2024).
+			Return([]domain.LeaveBalanceResponse{{LeaveTypeId: 1, Year: 2024, Available: 12}}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/leave-balances?year=2024", nil), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Get Leave Balances BAD REQUEST year", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/leave-balances?year=abc", nil), 2)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Request Leave SUCCESS", func(t *testing.T) {
+		req := domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-05-02", EndDate: "2024-05-08"}
+		uc.On("RequestLeave", uint(1), req).
+			Return(domain.LeaveResponse{Id: 1, Days: 5, Status: domain.LeaveStatusPending}, nil).
+			Once()
+
+		assert.Equal(t, http.StatusCreated, post("/api/employees/1/leave-requests", req))
+	})
+
+	t.Run("Test Request Leave CONFLICT overlap", func(t *testing.T) {
+		req := domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-05-02", EndDate: "2024-05-03"}
+		uc.On("RequestLeave", uint(1), req).
+			Return(domain.LeaveResponse{}, usecases.ErrLeaveOverlap).
+			Once()
+
+		assert.Equal(t, http.StatusConflict, post("/api/employees/1/leave-requests", req))
+	})
+
+	t.Run("Test Request Leave NOT FOUND deleted employee", func(t *testing.T) {
+		req := domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-05-02", EndDate: "2024-05-03"}
+		uc.On("RequestLeave", uint(2), req).
+			Return(domain.LeaveResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		assert.Equal(t, http.StatusNotFound, post("/api/employees/2/leave-requests", req))
+	})
+
+	t.Run("Test Approve Leave SUCCESS", func(t *testing.T) {
+		req := domain.LeaveDecisionRequest{Note: "enjoy"}
+		uc.On("ApproveLeave", uint(7), uint(2), req).
+			Return(domain.LeaveResponse{Id: 7, Status: domain.LeaveStatusApproved}, nil).
+			Once()
+
+		assert.Equal(t, http.StatusOK, post("/api/leave-requests/7/approve", req))
+	})
+
+	t.Run("Test Approve Leave FORBIDDEN not manager", func(t *testing.T) {
+		req := domain.LeaveDecisionRequest{}
+		uc.On("ApproveLeave", uint(8), uint(2), req).
+			Return(domain.LeaveResponse{}, usecases.ErrNotEmployeeManager).
+			Once()
+
+		assert.Equal(t, http.StatusForbidden, post("/api/leave-requests/8/approve", req))
+	})
+
+	t.Run("Test Reject Leave FORBIDDEN key without employee", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, post("/api/leave-requests/7/reject", domain.LeaveDecisionRequest{}))
+		uc.AssertNotCalled(t, "RejectLeave", uint(7), mock.Anything, mock.Anything)
+	})
+}
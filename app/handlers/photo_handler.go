@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Photo URLs in responses carry the version, those never change and are
+// cached for a year. Other requests revalidate with the ETag.
+const (
+	photoImmutableCache   = "public, max-age=31536000, immutable"
+	photoRevalidatedCache = "public, no-cache"
+)
+
+type PhotoHandler struct {
+	photoUsecase usecases.PhotoUsecase
+}
+
+func NewPhotoHandler(uc usecases.PhotoUsecase) *PhotoHandler {
+	return &PhotoHandler{
+		photoUsecase: uc,
+	}
+}
+
+// UploadPhoto reads a multipart form with the image in the file field.
+func (h *PhotoHandler) UploadPhoto(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	file, _, err := multipartFile(ctx)
+	if err != nil {
+		logger.Log.Error(err, "failed to read file field")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.photoUsecase.UploadPhoto(employeeId, file, -1)
+	if err != nil {
+		logger.Log.Error(err, "failed to upload photo")
+		return photoError(ctx, err, employeeId)
+	}
+
+	msg := fmt.Sprintf("Successfully upload photo for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *PhotoHandler) FindPhoto(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	size := ctx.Query("size", domain.PhotoSizeLarge)
+	photo, content, err := h.photoUsecase.GetPhoto(employeeId, size)
+	if err != nil {
+		logger.Log.Error(err, "failed to get photo")
+		return photoError(ctx, err, employeeId)
+	}
+
+	ctx.Set(fiber.HeaderETag, fmt.Sprintf("%q", photo.Version+"-"+size))
+	if ctx.Query("v") == photo.Version {
+		ctx.Set(fiber.HeaderCacheControl, photoImmutableCache)
+	} else {
+		ctx.Set(fiber.HeaderCacheControl, photoRevalidatedCache)
+	}
+
+	if ctx.Fresh() {
+		content.Close()
+		return ctx.SendStatus(fiber.StatusNotModified)
+	}
+
+	ctx.Set(fiber.HeaderContentType, "image/jpeg")
+	return ctx.SendStream(content)
+}
+
+func photoError(ctx *fiber.Ctx, err error, employeeId uint) error {
+	if errors.Is(err, usecases.ErrPhotoNotFound) {
+		return utils.ResponseNotFound(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrInvalidPhotoSize) {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrPhotoTooLarge) {
+		return utils.ResponsePayloadTooLarge(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrUnsupportedPhoto) {
+		return utils.ResponseUnsupportedMediaType(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrInvalidPhoto) {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPhotoHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.PhotoUsecase)
+	h := NewPhotoHandler(uc)
+
+	app := fiber.New()
+	app.Put("api/employees/:id/photo", h.UploadPhoto)
+	app.Get("api/employees/:id/photo", h.FindPhoto)
+
+	photo := domain.PhotoResponse{EmployeeId: 1, Version: "abc"}
+	content := func() io.ReadCloser {
+		return io.NopCloser(strings.NewReader("jpeg"))
+	}
+
+	t.Run("Test Upload Photo SUCCESS", func(t *testing.T) {
+		uc.On("UploadPhoto", uint(1), mock.Anything, int64(-1)).Return(photo, nil).Once()
+
+		req := newUploadRequest(t, "/api/employees/1/photo", "", "me.jpg", "jpeg")
+		req.Method = http.MethodPut
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Upload Photo UNSUPPORTED MEDIA TYPE", func(t *testing.T) {
+		uc.On("UploadPhoto", uint(1), mock.Anything, int64(-1)).Return(domain.PhotoResponse{}, usecases.ErrUnsupportedPhoto).Once()
+
+		req := newUploadRequest(t, "/api/employees/1/photo", "", "me.gif", "gif")
+		req.Method = http.MethodPut
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	})
+
+	t.Run("Test Upload Photo BAD REQUEST missing file", func(t *testing.T) {
+		req := newUploadRequest(t, "/api/employees/1/photo", "", "", "")
+		req.Method = http.MethodPut
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Find Photo SUCCESS versioned", func(t *testing.T) {
+		uc.On("GetPhoto", uint(1), domain.PhotoSizeSmall).Return(photo, content(), nil).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/photo?size=small&v=abc", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "image/jpeg", resp.Header.Get(fiber.HeaderContentType))
+		assert.Equal(t, `"abc-small"`, resp.Header.Get(fiber.HeaderETag))
+		assert.Equal(t, photoImmutableCache, resp.Header.Get(fiber.HeaderCacheControl))
+	})
+
+	t.Run("Test Find Photo NOT MODIFIED", func(t *testing.T) {
+		uc.On("GetPhoto", uint(1), domain.PhotoSizeLarge).Return(photo, content(), nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/employees/1/photo", nil)
+		req.Header.Set(fiber.HeaderIfNoneMatch, `"abc-large"`)
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+		assert.Equal(t, photoRevalidatedCache, resp.Header.Get(fiber.HeaderCacheControl))
+	})
+
+	t.Run("Test Find Photo BAD REQUEST size", func(t *testing.T) {
+		uc.On("GetPhoto", uint(1), "huge").Return(domain.PhotoResponse{}, nil, usecases.ErrInvalidPhotoSize).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/1/photo?size=huge", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Find Photo NOT FOUND", func(t *testing.T) {
+		uc.On("GetPhoto", uint(2), domain.PhotoSizeLarge).Return(domain.PhotoResponse{}, nil, repositories.ErrRecordNotFound).Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/2/photo", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
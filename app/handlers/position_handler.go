@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type PositionHandler struct {
+	positionUsecase usecases.PositionUsecase
+}
+
+func NewPositionHandler(uc usecases.PositionUsecase) *PositionHandler {
+	return &PositionHandler{
+		positionUsecase: uc,
+	}
+}
+
+func (h *PositionHandler) CreatePosition(ctx *fiber.Ctx) error {
+	var request domain.PositionRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizePositionRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.positionUsecase.CreatePosition(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create position")
+		if errors.Is(err, usecases.ErrDuplicatePositionTitle) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new position", res)
+}
+
+func (h *PositionHandler) FindAllPosition(ctx *fiber.Ctx) error {
+	positions, err := h.positionUsecase.GetAllPosition()
+	if err != nil {
+		logger.Log.Error(err, "failed to get all position")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all position data", positions)
+}
+
+func (h *PositionHandler) FindPositionById(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	position, err := h.positionUsecase.GetPositionById(uintId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get position by id")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("position with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get data for position id %d", uintId)
+	return utils.ResponseOK(ctx, msg, position)
+}
+
+func (h *PositionHandler) UpdatePositionById(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.PositionRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizePositionRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.positionUsecase.UpdatePositionById(uintId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to update position by id")
+
+		if errors.Is(err, usecases.ErrDuplicatePositionTitle) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("position with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully update data for position id %d", uintId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *PositionHandler) DeletePositionById(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	err = h.positionUsecase.DeletePositionById(uintId)
+	if err != nil {
+		logger.Log.Error(err, "failed to delete position by id")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("position with id %d not found", uintId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully delete data for position id %d", uintId)
+	return utils.ResponseOK(ctx, msg, nil)
+}
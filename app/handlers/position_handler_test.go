@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.PositionUsecase)
+	h := NewPositionHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/positions", h.CreatePosition)
+	app.Get("api/positions", h.FindAllPosition)
+	app.Get("api/positions/:id", h.FindPositionById)
+	app.Put("api/positions/:id", h.UpdatePositionById)
+	app.Delete("api/positions/:id", h.DeletePositionById)
+
+	t.Run("Test Create Position SUCCESS", func(t *testing.T) {
+		req := domain.PositionRequest{Title: "Software Engineer", Department: "Engineering"}
+		uc.On("CreatePosition", req).
+			Return(domain.PositionResponse{Id: 1, Title: req.Title}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/positions", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Create Position BAD REQUEST duplicate title", func(t *testing.T) {
+		req := domain.PositionRequest{Title: "Software Engineer"}
+		uc.On("CreatePosition", req).
+			Return(domain.PositionResponse{}, usecases.ErrDuplicatePositionTitle).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/positions", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Create Position BAD REQUEST empty title", func(t *testing.T) {
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(domain.PositionRequest{Title: " "})
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/positions", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Get All Position INTERNAL ERROR", func(t *testing.T) {
+		uc.On("GetAllPosition").
+			Return(nil, errors.New("error")).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/positions", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("Test Get Position By ID NOT FOUND", func(t *testing.T) {
+		uc.On("GetPositionById", uint(1)).
+			Return(domain.PositionResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/positions/1", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Update Position By ID SUCCESS", func(t *testing.T) {
+		req := domain.PositionRequest{Title: "Senior Software Engineer"}
+		uc.On("UpdatePositionById", uint(1), req).
+			Return(domain.PositionResponse{Id: 1, Title: req.Title}, nil).
+			Once()
+
+		var buf bytes.Buffer
+		json.NewEncoder(&buf).Encode(req)
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/positions/1", &buf)
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Delete Position By ID BAD REQUEST invalid id", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodDelete, "/api/positions/abc", nil)
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type ReviewHandler struct {
+	reviewUsecase usecases.ReviewUsecase
+}
+
+func NewReviewHandler(uc usecases.ReviewUsecase) *ReviewHandler {
+	return &ReviewHandler{
+		reviewUsecase: uc,
+	}
+}
+
+func (h *ReviewHandler) CreateReviewCycle(ctx *fiber.Ctx) error {
+	var request domain.ReviewCycleRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeReviewCycleRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.reviewUsecase.CreateReviewCycle(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create review cycle")
+
+		if errors.Is(err, usecases.ErrInvalidDate) ||
+			errors.Is(err, usecases.ErrInvalidReviewPeriod) ||
+			errors.Is(err, usecases.ErrInvalidReviewDueDates) ||
+			errors.Is(err, usecases.ErrDuplicateReviewCycle) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new review cycle", res)
+}
+
+func (h *ReviewHandler) FindAllReviewCycle(ctx *fiber.Ctx) error {
+	res, err := h.reviewUsecase.GetAllReviewCycle()
+	if err != nil {
+		logger.Log.Error(err, "failed to get all review cycle")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all review cycle data", res)
+}
+
+func (h *ReviewHandler) StartReview(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.StartReviewRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.reviewUsecase.StartReview(employeeId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to start review")
+
+		if errors.Is(err, usecases.ErrReviewCycleNotFound) ||
+			errors.Is(err, usecases.ErrNoReviewer) ||
+			errors.Is(err, usecases.ErrReviewerNotFound) ||
+			errors.Is(err, usecases.ErrInvalidReviewer) ||
+			errors.Is(err, usecases.ErrEmployeeTerminated) {
+			return utils.ResponseBadRequest(ctx, err.Error())
+		}
+
+		if errors.Is(err, usecases.ErrDuplicateReview) {
+			return utils.ResponseConflict(ctx, err.Error())
+		}
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully start review for employee id %d", employeeId)
+	return utils.ResponseCreated(ctx, msg, res)
+}
+
+func (h *ReviewHandler) FindReviews(ctx *fiber.Ctx) error {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.reviewUsecase.GetReviews(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get reviews")
+
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			errMsg := fmt.Sprintf("employee with id %d not found", employeeId)
+			return utils.ResponseNotFound(ctx, errMsg)
+		}
+
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	msg := fmt.Sprintf("Successfully get reviews for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ReviewHandler) WriteSelfAssessment(ctx *fiber.Ctx) error {
+	return h.writeAssessment(ctx, h.reviewUsecase.WriteSelfAssessment)
+}
+
+func (h *ReviewHandler) WriteManagerAssessment(ctx *fiber.Ctx) error {
+	return h.writeAssessment(ctx, h.reviewUsecase.WriteManagerAssessment)
+}
+
+func (h *ReviewHandler) writeAssessment(ctx *fiber.Ctx, write func(employeeId, reviewId, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error)) error {
+	employeeId, reviewId, err := parseReviewIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	actorId, ok := middleware.Employee(ctx)
+	if !ok {
+		return utils.ResponseForbidden(ctx, middleware.ErrNoEmployee.Error())
+	}
+
+	var request domain.AssessmentRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse body request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeAssessmentRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := write(employeeId, reviewId, actorId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to write assessment")
+		return reviewError(ctx, err, reviewId)
+	}
+
+	msg := fmt.Sprintf("Successfully write assessment for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ReviewHandler) SubmitReview(ctx *fiber.Ctx) error {
+	employeeId, reviewId, err := parseReviewIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	actorId, ok := middleware.Employee(ctx)
+	if !ok {
+		return utils.ResponseForbidden(ctx, middleware.ErrNoEmployee.Error())
+	}
+
+	res, err := h.reviewUsecase.SubmitReview(employeeId, reviewId, actorId)
+	if err != nil {
+		logger.Log.Error(err, "failed to submit review")
+		return reviewError(ctx, err, reviewId)
+	}
+
+	msg := fmt.Sprintf("Successfully submit review for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func (h *ReviewHandler) AcknowledgeReview(ctx *fiber.Ctx) error {
+	employeeId, reviewId, err := parseReviewIds(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	actorId, ok := middleware.Employee(ctx)
+	if !ok {
+		return utils.ResponseForbidden(ctx, middleware.ErrNoEmployee.Error())
+	}
+
+	res, err := h.reviewUsecase.AcknowledgeReview(employeeId, reviewId, actorId)
+	if err != nil {
+		logger.Log.Error(err, "failed to acknowledge review")
+		return reviewError(ctx, err, reviewId)
+	}
+
+	msg := fmt.Sprintf("Successfully acknowledge review for employee id %d", employeeId)
+	return utils.ResponseOK(ctx, msg, res)
+}
+
+func parseReviewIds(ctx *fiber.Ctx) (uint, uint, error) {
+	employeeId, err := parseId(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reviewId, err := parseParamId(ctx, "reviewId")
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return employeeId, reviewId, nil
+}
+
+func reviewError(ctx *fiber.Ctx, err error, reviewId uint) error {
+	if errors.Is(err, usecases.ErrNotReviewer) ||
+		errors.Is(err, usecases.ErrNotReviewee) {
+		return utils.ResponseForbidden(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrReviewNotDraft) ||
+		errors.Is(err, usecases.ErrReviewNotSubmitted) ||
+		errors.Is(err, usecases.ErrReviewOverdue) {
+		return utils.ResponseConflict(ctx, err.Error())
+	}
+
+	if errors.Is(err, usecases.ErrInvalidRating) ||
+		errors.Is(err, usecases.ErrMissingManagerAssessment) {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		errMsg := fmt.Sprintf("review with id %d not found", reviewId)
+		return utils.ResponseNotFound(ctx, errMsg)
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.ReviewUsecase)
+	h := NewReviewHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/review-cycles", h.CreateReviewCycle)
+	app.Get("api/employees/:id/reviews", h.FindReviews)
+	app.Post("api/employees/:id/reviews", h.StartReview)
+	actingAs := func(id uint) fiber.Handler {
+		return func(ctx *fiber.Ctx) error {
+			ctx.Locals(middleware.EmployeeKey, id)
+			return ctx.Next()
+		}
+	}
+	app.Put("api/employees/:id/reviews/:reviewId/self-assessment", h.WriteSelfAssessment)
+	app.Put("api/employees/:id/reviews/:reviewId/manager-assessment", actingAs(7), h.WriteManagerAssessment)
+	app.Post("api/employees/:id/reviews/:reviewId/submit", actingAs(2), h.SubmitReview)
+	app.Post("api/employees/:id/reviews/:reviewId/acknowledge", actingAs(1), h.AcknowledgeReview)
+
+	t.Run("Test Create Review Cycle SUCCESS", func(t *testing.T) {
+		uc.On("CreateReviewCycle", domain.ReviewCycleRequest{
+			Name:             "2024 Annual Review",
+			PeriodStart:      "2024-01-01",
+			PeriodEnd:        "2024-12-31",
+			SelfReviewDue:    "2025-01-15",
+			ManagerReviewDue: "2025-01-31",
+			RatingMin:        1,
+			RatingMax:        5,
+		}).Return(domain.ReviewCycleResponse{Id: 1}, nil).Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/review-cycles", bytes.NewBufferString(`{"name": "2024 Annual Review", "period_start": "2024-01-01", "period_end": "2024-12-31", "self_review_due": "2025-01-15", "manager_review_due": "2025-01-31"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Create Review Cycle BAD REQUEST scale", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/review-cycles", bytes.NewBufferString(`{"name": "Q1", "rating_min": 5, "rating_max": 1}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Start Review CONFLICT", func(t *testing.T) {
+		uc.On("StartReview", uint(1), domain.StartReviewRequest{CycleId: 3}).
+			Return(domain.ReviewResponse{}, usecases.ErrDuplicateReview).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/employees/1/reviews", bytes.NewBufferString(`{"cycle_id": 3}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Get Reviews NOT FOUND", func(t *testing.T) {
+		uc.On("GetReviews", uint(2)).
+			Return(nil, repositories.ErrRecordNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/2/reviews", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Manager Assessment FORBIDDEN", func(t *testing.T) {
+		uc.On("WriteManagerAssessment", uint(1), uint(4), uint(7), domain.AssessmentRequest{Assessment: "good"}).
+			Return(domain.ReviewResponse{}, usecases.ErrNotReviewer).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/employees/1/reviews/4/manager-assessment", bytes.NewBufferString(`{"assessment": " good "}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Test Self Assessment FORBIDDEN key without employee", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/employees/1/reviews/4/self-assessment", bytes.NewBufferString(`{"assessment": "shipped"}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	})
+
+	t.Run("Test Submit Review SUCCESS", func(t *testing.T) {
+		uc.On("SubmitReview", uint(1), uint(4), uint(2)).
+			Return(domain.ReviewResponse{Id: 4, Status: domain.ReviewStatusSubmitted}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/reviews/4/submit", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Submit Review CONFLICT overdue", func(t *testing.T) {
+		uc.On("SubmitReview", uint(1), uint(5), uint(2)).
+			Return(domain.ReviewResponse{}, usecases.ErrReviewOverdue).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/reviews/5/submit", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Acknowledge Review CONFLICT", func(t *testing.T) {
+		uc.On("AcknowledgeReview", uint(1), uint(4), uint(1)).
+			Return(domain.ReviewResponse{}, usecases.ErrReviewNotSubmitted).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/reviews/4/acknowledge", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Acknowledge Review BAD REQUEST invalid review id", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/employees/1/reviews/abc/acknowledge", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/pkg/events"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// streamRetry tells clients how long to wait before reconnecting.
+	streamRetry = 3 * time.Second
+
+	maxDepartmentLength = 100
+)
+
+type StreamHandler struct {
+	stream    *events.Stream
+	heartbeat time.Duration
+}
+
+func NewStreamHandler(stream *events.Stream, heartbeat time.Duration) *StreamHandler {
+	return &StreamHandler{
+		stream:    stream,
+		heartbeat: heartbeat,
+	}
+}
+
+// StreamEmployees pushes employee events as Server-Sent Events. Clients
+// resume with the Last-Event-ID header, or the last_event_id query parameter
+// for clients that cannot set headers.
+func (h *StreamHandler) StreamEmployees(ctx *fiber.Ctx) error {
+	filter, err := parseStreamFilter(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	var lastEventId uint64
+	if id := firstNonEmpty(ctx.Get("Last-Event-ID"), ctx.Query("last_event_id")); id != "" {
+		lastEventId, err = strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return utils.ResponseBadRequest(ctx, "invalid last event id")
+		}
+	}
+
+	sub, err := h.stream.Subscribe(filter, lastEventId)
+	if err != nil {
+		logger.Log.Error(err, "failed to subscribe to event stream")
+		return utils.ResponseServiceUnavailable(ctx, err.Error())
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	// The writer runs after the handler returned, it must not use ctx.
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+		h.writeStream(w, sub)
+	})
+
+	return nil
+}
+
+// writeStream returns when the client is gone, which shows as a failing
+// flush, or when the stream dropped the subscription.
+func (h *StreamHandler) writeStream(w *bufio.Writer, sub *events.Subscription) {
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds())
+	if err := w.Flush(); err != nil {
+		return
+	}
+
+	err := h.stream.Replay(context.Background(), sub, func(event domain.Event) error {
+		return writeEvent(w, event)
+	})
+	if errors.Is(err, events.ErrEventExpired) {
+		// The client missed events that are gone, it has to reload.
+		fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+		err = w.Flush()
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to replay events")
+		return
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-sub.Events:
+			if !ok {
+				return
+			}
+
+			if err := writeEvent(w, event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func writeEvent(w *bufio.Writer, event domain.Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+	return w.Flush()
+}
+
+// parseStreamFilter reads the comma separated types and the department
+// query parameters.
+func parseStreamFilter(ctx *fiber.Ctx) (events.StreamFilter, error) {
+	var filter events.StreamFilter
+	for _, t := range strings.Split(ctx.Query("types"), ",") {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+
+		if !domain.IsValidEventType(t) {
+			return events.StreamFilter{}, fmt.Errorf("invalid event type %q", t)
+		}
+
+		filter.Types = append(filter.Types, t)
+	}
+
+	filter.Department = strings.TrimSpace(ctx.Query("department"))
+	if len(filter.Department) > maxDepartmentLength {
+		return events.StreamFilter{}, fmt.Errorf("department must be at most %d characters", maxDepartmentLength)
+	}
+
+	return filter, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/pkg/events"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStreamHandler(t *testing.T) {
+	logger.Init()
+
+	repo := mocks.NewOutboxRepository(t)
+	stream := events.NewStream(repo, events.StreamOptions{PollInterval: time.Millisecond, BufferSize: 8, MaxClients: 8})
+	h := NewStreamHandler(stream, time.Hour)
+
+	app := fiber.New()
+	app.Get("api/employees/stream", h.StreamEmployees)
+
+	t.Run("Test Stream Employees SERVICE UNAVAILABLE before start", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/stream", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	})
+
+	repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 5, Xmin: 1, Xmax: 1}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go stream.Run(ctx)
+
+	assert.Eventually(t, func() bool {
+		sub, err := stream.Subscribe(events.StreamFilter{}, 0)
+		if err != nil {
+			return false
+		}
+
+		sub.Close()
+		return true
+	}, time.Second, time.Millisecond)
+
+	t.Run("Test Stream Employees BAD REQUEST type", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees/stream?types=employee.hired", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Stream Employees BAD REQUEST last event id", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/stream", nil)
+		httpReq.Header.Set("Last-Event-ID", "abc")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Stream Employees SUCCESS replay", func(t *testing.T) {
+		repo.On("Exists", uint64(3)).Return(true, nil).Once()
+		repo.On("FindAfter", uint64(3), uint64(5), mock.Anything).Return([]domain.OutboxEvent{
+			{ID: 4, Type: domain.EventEmployeeUpdated, EmployeeID: 7, Payload: `{"id":7}`},
+		}, nil).Once()
+
+		httpReq := httptest.NewRequest(http.MethodGet, "/api/employees/stream?types=employee.updated", nil)
+		httpReq.Header.Set("Last-Event-ID", "3")
+
+		time.AfterFunc(100*time.Millisecond, stream.Close)
+		resp, err := app.Test(httpReq, -1)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Contains(t, string(body), "retry: 3000\n\n")
+		assert.Contains(t, string(body), "id: 4\nevent: employee.updated\ndata: {\"id\":\"4\",")
+	})
+}
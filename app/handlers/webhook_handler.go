@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	defaultDeliveryLimit = 50
+	maxDeliveryLimit     = 100
+)
+
+type WebhookHandler struct {
+	webhookUsecase usecases.WebhookUsecase
+}
+
+func NewWebhookHandler(uc usecases.WebhookUsecase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUsecase: uc,
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx *fiber.Ctx) error {
+	var request domain.WebhookRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeWebhookRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.webhookUsecase.CreateWebhook(request)
+	if err != nil {
+		logger.Log.Error(err, "failed to create webhook")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully create new webhook", res)
+}
+
+func (h *WebhookHandler) FindAllWebhook(ctx *fiber.Ctx) error {
+	res, err := h.webhookUsecase.GetAllWebhook()
+	if err != nil {
+		logger.Log.Error(err, "failed to get all webhook")
+		return utils.ResponseInternalServerError(ctx, err.Error())
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get all webhook data", res)
+}
+
+func (h *WebhookHandler) FindWebhookById(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	res, err := h.webhookUsecase.GetWebhookById(uintId)
+	if err != nil {
+		logger.Log.Error(err, "failed to get webhook by id")
+		return webhookErrorResponse(ctx, err, uintId)
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get webhook data", res)
+}
+
+func (h *WebhookHandler) UpdateWebhook(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	var request domain.WebhookRequest
+	if err := ctx.BodyParser(&request); err != nil {
+		logger.Log.Error(err, "failed to parse request")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	if err := utils.ValidateAndSanitizeWebhookRequest(&request); err != nil {
+		logger.Log.Error(err, "body request validation error")
+		return utils.ResponseBadRequest(ctx, err.Error())
+	}
+
+	res, err := h.webhookUsecase.UpdateWebhook(uintId, request)
+	if err != nil {
+		logger.Log.Error(err, "failed to update webhook")
+		return webhookErrorResponse(ctx, err, uintId)
+	}
+
+	return utils.ResponseOK(ctx, "Successfully update webhook", res)
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	if err := h.webhookUsecase.DeleteWebhook(uintId); err != nil {
+		logger.Log.Error(err, "failed to delete webhook")
+		return webhookErrorResponse(ctx, err, uintId)
+	}
+
+	msg := fmt.Sprintf("Successfully delete webhook with id %d", uintId)
+	return utils.ResponseOK(ctx, msg, nil)
+}
+
+// FindDeliveries returns the delivery log of the webhook, latest first.
+func (h *WebhookHandler) FindDeliveries(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	limit := defaultDeliveryLimit
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > maxDeliveryLimit {
+			return utils.ResponseBadRequest(ctx, fmt.Sprintf("limit must be between 1 and %d", maxDeliveryLimit))
+		}
+	}
+
+	res, err := h.webhookUsecase.GetDeliveries(uintId, limit)
+	if err != nil {
+		logger.Log.Error(err, "failed to get webhook deliveries")
+		return webhookErrorResponse(ctx, err, uintId)
+	}
+
+	return utils.ResponseOK(ctx, "Successfully get webhook deliveries", res)
+}
+
+func (h *WebhookHandler) Redeliver(ctx *fiber.Ctx) error {
+	uintId, err := parseId(ctx)
+	if err != nil {
+		return utils.ResponseBadRequest(ctx, "invalid id")
+	}
+
+	deliveryId, err := strconv.ParseUint(ctx.Params("deliveryId"), 10, 64)
+	if err != nil || deliveryId < 1 {
+		return utils.ResponseBadRequest(ctx, "invalid delivery id")
+	}
+
+	res, err := h.webhookUsecase.Redeliver(uintId, deliveryId)
+	if err != nil {
+		logger.Log.Error(err, "failed to redeliver webhook delivery")
+		return webhookErrorResponse(ctx, err, uintId)
+	}
+
+	return utils.ResponseCreated(ctx, "Successfully schedule webhook redelivery", res)
+}
+
+func webhookErrorResponse(ctx *fiber.Ctx, err error, id uint) error {
+	switch {
+	case errors.Is(err, repositories.ErrRecordNotFound):
+		return utils.ResponseNotFound(ctx, fmt.Sprintf("webhook with id %d not found", id))
+	case errors.Is(err, usecases.ErrDeliveryNotFound):
+		return utils.ResponseNotFound(ctx, err.Error())
+	case errors.Is(err, usecases.ErrWebhookDisabled):
+		return utils.ResponseConflict(ctx, err.Error())
+	}
+
+	return utils.ResponseInternalServerError(ctx, err.Error())
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookHandler(t *testing.T) {
+	logger.Init()
+
+	uc := new(mocks.WebhookUsecase)
+	h := NewWebhookHandler(uc)
+
+	app := fiber.New()
+	app.Post("api/webhooks", h.CreateWebhook)
+	app.Get("api/webhooks", h.FindAllWebhook)
+	app.Get("api/webhooks/:id", h.FindWebhookById)
+	app.Put("api/webhooks/:id", h.UpdateWebhook)
+	app.Delete("api/webhooks/:id", h.DeleteWebhook)
+	app.Get("api/webhooks/:id/deliveries", h.FindDeliveries)
+	app.Post("api/webhooks/:id/deliveries/:deliveryId/redeliver", h.Redeliver)
+
+	t.Run("Test Create Webhook SUCCESS", func(t *testing.T) {
+		uc.On("CreateWebhook", domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{"employee.created"}}).
+			Return(domain.WebhookResponse{Id: 1, Secret: "generated"}, nil).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/webhooks", bytes.NewBufferString(`{"url": "https://hooks.example.com", "event_types": ["employee.created"]}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Create Webhook BAD REQUEST url", func(t *testing.T) {
+		httpReq := httptest.NewRequest(http.MethodPost, "/api/webhooks", bytes.NewBufferString(`{"url": "file:///etc/passwd", "event_types": ["employee.created"]}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Get All Webhook SUCCESS", func(t *testing.T) {
+		uc.On("GetAllWebhook").
+			Return([]domain.WebhookResponse{{Id: 1}}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/webhooks", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Update Webhook NOT FOUND", func(t *testing.T) {
+		uc.On("UpdateWebhook", uint(9), domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{"employee.deleted"}}).
+			Return(domain.WebhookResponse{}, repositories.ErrRecordNotFound).
+			Once()
+
+		httpReq := httptest.NewRequest(http.MethodPut, "/api/webhooks/9", bytes.NewBufferString(`{"url": "https://hooks.example.com", "event_types": ["employee.deleted"]}`))
+		httpReq.Header.Set("content-type", "application/json")
+		resp, err := app.Test(httpReq, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Delete Webhook SUCCESS", func(t *testing.T) {
+		uc.On("DeleteWebhook", uint(1)).
+			Return(nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodDelete, "/api/webhooks/1", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Find Deliveries SUCCESS", func(t *testing.T) {
+		uc.On("GetDeliveries", uint(1), 10).
+			Return([]domain.WebhookDeliveryResponse{{Id: 1}}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/webhooks/1/deliveries?limit=10", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("Test Find Deliveries BAD REQUEST limit", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/webhooks/1/deliveries?limit=500", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Test Redeliver SUCCESS", func(t *testing.T) {
+		uc.On("Redeliver", uint(1), uint64(3)).
+			Return(domain.WebhookDeliveryResponse{Id: 4, Redelivery: true}, nil).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/webhooks/1/deliveries/3/redeliver", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	})
+
+	t.Run("Test Redeliver CONFLICT disabled", func(t *testing.T) {
+		uc.On("Redeliver", uint(1), uint64(3)).
+			Return(domain.WebhookDeliveryResponse{}, usecases.ErrWebhookDisabled).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/webhooks/1/deliveries/3/redeliver", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("Test Redeliver NOT FOUND delivery", func(t *testing.T) {
+		uc.On("Redeliver", uint(1), uint64(9)).
+			Return(domain.WebhookDeliveryResponse{}, usecases.ErrDeliveryNotFound).
+			Once()
+
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/webhooks/1/deliveries/9/redeliver", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("Test Redeliver BAD REQUEST delivery id", func(t *testing.T) {
+		resp, err := app.Test(httptest.NewRequest(http.MethodPost, "/api/webhooks/1/deliveries/abc/redeliver", nil), 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+}
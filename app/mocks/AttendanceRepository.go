@@ -0,0 +1,110 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AttendanceRepository is an autogenerated mock type for the AttendanceRepository type
+type AttendanceRepository struct {
+	mock.Mock
+}
+
+// ClockOut provides a mock function with given fields: attendance
+func (_m *AttendanceRepository) ClockOut(attendance *domain.Attendance) error {
+	ret := _m.Called(attendance)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Attendance) error); ok {
+		r0 = rf(attendance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByEmployeeIdBetween provides a mock function with given fields: employeeId, from, to
+func (_m *AttendanceRepository) FindByEmployeeIdBetween(employeeId uint, from time.Time, to time.Time) ([]domain.Attendance, error) {
+	ret := _m.Called(employeeId, from, to)
+
+	var r0 []domain.Attendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time, time.Time) ([]domain.Attendance, error)); ok {
+		return rf(employeeId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time, time.Time) []domain.Attendance); ok {
+		r0 = rf(employeeId, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time, time.Time) error); ok {
+		r1 = rf(employeeId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindOpenByEmployeeId provides a mock function with given fields: employeeId
+func (_m *AttendanceRepository) FindOpenByEmployeeId(employeeId uint) ([]domain.Attendance, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Attendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Attendance, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Attendance); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Attendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: attendance
+func (_m *AttendanceRepository) Store(attendance *domain.Attendance) error {
+	ret := _m.Called(attendance)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Attendance) error); ok {
+		r0 = rf(attendance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAttendanceRepository creates a new instance of AttendanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttendanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttendanceRepository {
+	mock := &AttendanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
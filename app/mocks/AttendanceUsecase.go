@@ -0,0 +1,100 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttendanceUsecase is an autogenerated mock type for the AttendanceUsecase type
+type AttendanceUsecase struct {
+	mock.Mock
+}
+
+// ClockIn provides a mock function with given fields: employeeId, req
+func (_m *AttendanceUsecase) ClockIn(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.AttendanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.AttendanceRequest) (domain.AttendanceResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.AttendanceRequest) domain.AttendanceResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.AttendanceResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.AttendanceRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClockOut provides a mock function with given fields: employeeId, req
+func (_m *AttendanceUsecase) ClockOut(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.AttendanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.AttendanceRequest) (domain.AttendanceResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.AttendanceRequest) domain.AttendanceResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.AttendanceResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.AttendanceRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTimesheet provides a mock function with given fields: employeeId, month
+func (_m *AttendanceUsecase) GetTimesheet(employeeId uint, month string) (domain.TimesheetResponse, error) {
+	ret := _m.Called(employeeId, month)
+
+	var r0 domain.TimesheetResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (domain.TimesheetResponse, error)); ok {
+		return rf(employeeId, month)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) domain.TimesheetResponse); ok {
+		r0 = rf(employeeId, month)
+	} else {
+		r0 = ret.Get(0).(domain.TimesheetResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(employeeId, month)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAttendanceUsecase creates a new instance of AttendanceUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttendanceUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttendanceUsecase {
+	mock := &AttendanceUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
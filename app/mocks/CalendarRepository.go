@@ -0,0 +1,130 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CalendarRepository is an autogenerated mock type for the CalendarRepository type
+type CalendarRepository struct {
+	mock.Mock
+}
+
+// FindAll provides a mock function with given fields:
+func (_m *CalendarRepository) FindAll() ([]domain.WorkCalendar, error) {
+	ret := _m.Called()
+
+	var r0 []domain.WorkCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.WorkCalendar, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.WorkCalendar); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WorkCalendar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: id
+func (_m *CalendarRepository) FindById(id uint) (domain.WorkCalendar, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.WorkCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.WorkCalendar, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.WorkCalendar); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.WorkCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByName provides a mock function with given fields: name
+func (_m *CalendarRepository) FindByName(name string) (domain.WorkCalendar, error) {
+	ret := _m.Called(name)
+
+	var r0 domain.WorkCalendar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (domain.WorkCalendar, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) domain.WorkCalendar); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(domain.WorkCalendar)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: calendar
+func (_m *CalendarRepository) Store(calendar *domain.WorkCalendar) error {
+	ret := _m.Called(calendar)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.WorkCalendar) error); ok {
+		r0 = rf(calendar)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreHolidays provides a mock function with given fields: holidays
+func (_m *CalendarRepository) StoreHolidays(holidays []domain.Holiday) error {
+	ret := _m.Called(holidays)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]domain.Holiday) error); ok {
+		r0 = rf(holidays)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCalendarRepository creates a new instance of CalendarRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCalendarRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CalendarRepository {
+	mock := &CalendarRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
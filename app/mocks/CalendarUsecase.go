@@ -0,0 +1,224 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CalendarUsecase is an autogenerated mock type for the CalendarUsecase type
+type CalendarUsecase struct {
+	mock.Mock
+}
+
+// AddHoliday provides a mock function with given fields: calendarId, req
+func (_m *CalendarUsecase) AddHoliday(calendarId uint, req domain.HolidayRequest) (domain.HolidayResponse, error) {
+	ret := _m.Called(calendarId, req)
+
+	var r0 domain.HolidayResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.HolidayRequest) (domain.HolidayResponse, error)); ok {
+		return rf(calendarId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.HolidayRequest) domain.HolidayResponse); ok {
+		r0 = rf(calendarId, req)
+	} else {
+		r0 = ret.Get(0).(domain.HolidayResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.HolidayRequest) error); ok {
+		r1 = rf(calendarId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignCalendar provides a mock function with given fields: employeeId, req
+func (_m *CalendarUsecase) AssignCalendar(employeeId uint, req domain.CalendarAssignmentRequest) (domain.EmployeeResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.CalendarAssignmentRequest) (domain.EmployeeResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.CalendarAssignmentRequest) domain.EmployeeResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.CalendarAssignmentRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckEmployeeDate provides a mock function with given fields: employeeId, date
+func (_m *CalendarUsecase) CheckEmployeeDate(employeeId uint, date string) (domain.DateCheckResponse, error) {
+	ret := _m.Called(employeeId, date)
+
+	var r0 domain.DateCheckResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (domain.DateCheckResponse, error)); ok {
+		return rf(employeeId, date)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) domain.DateCheckResponse); ok {
+		r0 = rf(employeeId, date)
+	} else {
+		r0 = ret.Get(0).(domain.DateCheckResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(employeeId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountWorkingDays provides a mock function with given fields: calendarId, from, to
+func (_m *CalendarUsecase) CountWorkingDays(calendarId uint, from string, to string) (domain.WorkingDaysResponse, error) {
+	ret := _m.Called(calendarId, from, to)
+
+	var r0 domain.WorkingDaysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string) (domain.WorkingDaysResponse, error)); ok {
+		return rf(calendarId, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string) domain.WorkingDaysResponse); ok {
+		r0 = rf(calendarId, from, to)
+	} else {
+		r0 = ret.Get(0).(domain.WorkingDaysResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string) error); ok {
+		r1 = rf(calendarId, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCalendar provides a mock function with given fields: req
+func (_m *CalendarUsecase) CreateCalendar(req domain.WorkCalendarRequest) (domain.WorkCalendarResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.WorkCalendarResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.WorkCalendarRequest) (domain.WorkCalendarResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.WorkCalendarRequest) domain.WorkCalendarResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.WorkCalendarResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.WorkCalendarRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllCalendar provides a mock function with given fields:
+func (_m *CalendarUsecase) GetAllCalendar() ([]domain.WorkCalendarResponse, error) {
+	ret := _m.Called()
+
+	var r0 []domain.WorkCalendarResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.WorkCalendarResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.WorkCalendarResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WorkCalendarResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCalendarById provides a mock function with given fields: id
+func (_m *CalendarUsecase) GetCalendarById(id uint) (domain.WorkCalendarResponse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.WorkCalendarResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.WorkCalendarResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.WorkCalendarResponse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.WorkCalendarResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportHolidays provides a mock function with given fields: calendarId, r
+func (_m *CalendarUsecase) ImportHolidays(calendarId uint, r io.Reader) (domain.HolidayImportResponse, error) {
+	ret := _m.Called(calendarId, r)
+
+	var r0 domain.HolidayImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, io.Reader) (domain.HolidayImportResponse, error)); ok {
+		return rf(calendarId, r)
+	}
+	if rf, ok := ret.Get(0).(func(uint, io.Reader) domain.HolidayImportResponse); ok {
+		r0 = rf(calendarId, r)
+	} else {
+		r0 = ret.Get(0).(domain.HolidayImportResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, io.Reader) error); ok {
+		r1 = rf(calendarId, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCalendarUsecase creates a new instance of CalendarUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCalendarUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CalendarUsecase {
+	mock := &CalendarUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChecklistRepository is an autogenerated mock type for the ChecklistRepository type
+type ChecklistRepository struct {
+	mock.Mock
+}
+
+// DeleteItem provides a mock function with given fields: id
+func (_m *ChecklistRepository) DeleteItem(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindItems provides a mock function with given fields: kind
+func (_m *ChecklistRepository) FindItems(kind string) ([]domain.ChecklistItem, error) {
+	ret := _m.Called(kind)
+
+	var r0 []domain.ChecklistItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]domain.ChecklistItem, error)); ok {
+		return rf(kind)
+	}
+	if rf, ok := ret.Get(0).(func(string) []domain.ChecklistItem); ok {
+		r0 = rf(kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ChecklistItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTaskById provides a mock function with given fields: id
+func (_m *ChecklistRepository) FindTaskById(id uint) (domain.EmployeeTask, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.EmployeeTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.EmployeeTask, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.EmployeeTask); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeTask)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindTasks provides a mock function with given fields: filter
+func (_m *ChecklistRepository) FindTasks(filter domain.TaskFilter) ([]domain.EmployeeTask, error) {
+	ret := _m.Called(filter)
+
+	var r0 []domain.EmployeeTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.TaskFilter) ([]domain.EmployeeTask, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(domain.TaskFilter) []domain.EmployeeTask); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EmployeeTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.TaskFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoreItem provides a mock function with given fields: item
+func (_m *ChecklistRepository) StoreItem(item *domain.ChecklistItem) error {
+	ret := _m.Called(item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.ChecklistItem) error); ok {
+		r0 = rf(item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateTask provides a mock function with given fields: task
+func (_m *ChecklistRepository) UpdateTask(task *domain.EmployeeTask) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.EmployeeTask) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewChecklistRepository creates a new instance of ChecklistRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChecklistRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChecklistRepository {
+	mock := &ChecklistRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
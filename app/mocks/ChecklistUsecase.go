@@ -0,0 +1,192 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ChecklistUsecase is an autogenerated mock type for the ChecklistUsecase type
+type ChecklistUsecase struct {
+	mock.Mock
+}
+
+// AssignTask provides a mock function with given fields: employeeId, taskId, req
+func (_m *ChecklistUsecase) AssignTask(employeeId uint, taskId uint, req domain.TaskAssignmentRequest) (domain.TaskResponse, error) {
+	ret := _m.Called(employeeId, taskId, req)
+
+	var r0 domain.TaskResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.TaskAssignmentRequest) (domain.TaskResponse, error)); ok {
+		return rf(employeeId, taskId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.TaskAssignmentRequest) domain.TaskResponse); ok {
+		r0 = rf(employeeId, taskId, req)
+	} else {
+		r0 = ret.Get(0).(domain.TaskResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, domain.TaskAssignmentRequest) error); ok {
+		r1 = rf(employeeId, taskId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteTask provides a mock function with given fields: employeeId, taskId, completedById
+func (_m *ChecklistUsecase) CompleteTask(employeeId uint, taskId uint, completedById uint) (domain.TaskResponse, error) {
+	ret := _m.Called(employeeId, taskId, completedById)
+
+	var r0 domain.TaskResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) (domain.TaskResponse, error)); ok {
+		return rf(employeeId, taskId, completedById)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) domain.TaskResponse); ok {
+		r0 = rf(employeeId, taskId, completedById)
+	} else {
+		r0 = ret.Get(0).(domain.TaskResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
+		r1 = rf(employeeId, taskId, completedById)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateChecklistItem provides a mock function with given fields: req
+func (_m *ChecklistUsecase) CreateChecklistItem(req domain.ChecklistItemRequest) (domain.ChecklistItemResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.ChecklistItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.ChecklistItemRequest) (domain.ChecklistItemResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.ChecklistItemRequest) domain.ChecklistItemResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.ChecklistItemResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.ChecklistItemRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteChecklistItem provides a mock function with given fields: id
+func (_m *ChecklistUsecase) DeleteChecklistItem(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetChecklistItems provides a mock function with given fields: kind
+func (_m *ChecklistUsecase) GetChecklistItems(kind string) ([]domain.ChecklistItemResponse, error) {
+	ret := _m.Called(kind)
+
+	var r0 []domain.ChecklistItemResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]domain.ChecklistItemResponse, error)); ok {
+		return rf(kind)
+	}
+	if rf, ok := ret.Get(0).(func(string) []domain.ChecklistItemResponse); ok {
+		r0 = rf(kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ChecklistItemResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEmployeeTasks provides a mock function with given fields: employeeId, kind
+func (_m *ChecklistUsecase) GetEmployeeTasks(employeeId uint, kind string) ([]domain.TaskResponse, error) {
+	ret := _m.Called(employeeId, kind)
+
+	var r0 []domain.TaskResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) ([]domain.TaskResponse, error)); ok {
+		return rf(employeeId, kind)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) []domain.TaskResponse); ok {
+		r0 = rf(employeeId, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TaskResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(employeeId, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenTasks provides a mock function with given fields: kind
+func (_m *ChecklistUsecase) GetOpenTasks(kind string) ([]domain.TaskResponse, error) {
+	ret := _m.Called(kind)
+
+	var r0 []domain.TaskResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]domain.TaskResponse, error)); ok {
+		return rf(kind)
+	}
+	if rf, ok := ret.Get(0).(func(string) []domain.TaskResponse); ok {
+		r0 = rf(kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TaskResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewChecklistUsecase creates a new instance of ChecklistUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChecklistUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChecklistUsecase {
+	mock := &ChecklistUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
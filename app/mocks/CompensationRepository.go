@@ -0,0 +1,68 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompensationRepository is an autogenerated mock type for the CompensationRepository type
+type CompensationRepository struct {
+	mock.Mock
+}
+
+// FindByEmployeeId provides a mock function with given fields: employeeId
+func (_m *CompensationRepository) FindByEmployeeId(employeeId uint) ([]domain.Compensation, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Compensation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Compensation, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Compensation); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Compensation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: compensation
+func (_m *CompensationRepository) Store(compensation *domain.Compensation) error {
+	ret := _m.Called(compensation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Compensation) error); ok {
+		r0 = rf(compensation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCompensationRepository creates a new instance of CompensationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompensationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompensationRepository {
+	mock := &CompensationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,102 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompensationUsecase is an autogenerated mock type for the CompensationUsecase type
+type CompensationUsecase struct {
+	mock.Mock
+}
+
+// AddCompensation provides a mock function with given fields: employeeId, req, createdBy
+func (_m *CompensationUsecase) AddCompensation(employeeId uint, req domain.CompensationRequest, createdBy string) (domain.CompensationResponse, error) {
+	ret := _m.Called(employeeId, req, createdBy)
+
+	var r0 domain.CompensationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.CompensationRequest, string) (domain.CompensationResponse, error)); ok {
+		return rf(employeeId, req, createdBy)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.CompensationRequest, string) domain.CompensationResponse); ok {
+		r0 = rf(employeeId, req, createdBy)
+	} else {
+		r0 = ret.Get(0).(domain.CompensationResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.CompensationRequest, string) error); ok {
+		r1 = rf(employeeId, req, createdBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCompensationHistory provides a mock function with given fields: employeeId
+func (_m *CompensationUsecase) GetCompensationHistory(employeeId uint) ([]domain.CompensationResponse, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.CompensationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.CompensationResponse, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.CompensationResponse); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CompensationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCompensationOnDate provides a mock function with given fields: employeeId, date
+func (_m *CompensationUsecase) GetCompensationOnDate(employeeId uint, date string) (domain.CompensationResponse, error) {
+	ret := _m.Called(employeeId, date)
+
+	var r0 domain.CompensationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (domain.CompensationResponse, error)); ok {
+		return rf(employeeId, date)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) domain.CompensationResponse); ok {
+		r0 = rf(employeeId, date)
+	} else {
+		r0 = ret.Get(0).(domain.CompensationResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(employeeId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCompensationUsecase creates a new instance of CompensationUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompensationUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompensationUsecase {
+	mock := &CompensationUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,256 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ContactRepository is an autogenerated mock type for the ContactRepository type
+type ContactRepository struct {
+	mock.Mock
+}
+
+// DeleteAddress provides a mock function with given fields: employeeId, id
+func (_m *ContactRepository) DeleteAddress(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEmergencyContact provides a mock function with given fields: employeeId, id
+func (_m *ContactRepository) DeleteEmergencyContact(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePhone provides a mock function with given fields: employeeId, id
+func (_m *ContactRepository) DeletePhone(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAddressByType provides a mock function with given fields: employeeId, addressType
+func (_m *ContactRepository) FindAddressByType(employeeId uint, addressType string) (domain.Address, error) {
+	ret := _m.Called(employeeId, addressType)
+
+	var r0 domain.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) (domain.Address, error)); ok {
+		return rf(employeeId, addressType)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) domain.Address); ok {
+		r0 = rf(employeeId, addressType)
+	} else {
+		r0 = ret.Get(0).(domain.Address)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(employeeId, addressType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAddresses provides a mock function with given fields: employeeId
+func (_m *ContactRepository) FindAddresses(employeeId uint) ([]domain.Address, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Address
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Address, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Address); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Address)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindEmergencyContacts provides a mock function with given fields: employeeId
+func (_m *ContactRepository) FindEmergencyContacts(employeeId uint) ([]domain.EmergencyContact, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.EmergencyContact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.EmergencyContact, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.EmergencyContact); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EmergencyContact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPhones provides a mock function with given fields: employeeId
+func (_m *ContactRepository) FindPhones(employeeId uint) ([]domain.Phone, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Phone
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Phone, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Phone); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Phone)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoreAddress provides a mock function with given fields: address
+func (_m *ContactRepository) StoreAddress(address *domain.Address) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Address) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreEmergencyContact provides a mock function with given fields: contact
+func (_m *ContactRepository) StoreEmergencyContact(contact *domain.EmergencyContact) error {
+	ret := _m.Called(contact)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.EmergencyContact) error); ok {
+		r0 = rf(contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StorePhone provides a mock function with given fields: phone
+func (_m *ContactRepository) StorePhone(phone *domain.Phone) error {
+	ret := _m.Called(phone)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Phone) error); ok {
+		r0 = rf(phone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAddress provides a mock function with given fields: address
+func (_m *ContactRepository) UpdateAddress(address *domain.Address) error {
+	ret := _m.Called(address)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Address) error); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEmergencyContact provides a mock function with given fields: contact
+func (_m *ContactRepository) UpdateEmergencyContact(contact *domain.EmergencyContact) error {
+	ret := _m.Called(contact)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.EmergencyContact) error); ok {
+		r0 = rf(contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePhone provides a mock function with given fields: phone
+func (_m *ContactRepository) UpdatePhone(phone *domain.Phone) error {
+	ret := _m.Called(phone)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Phone) error); ok {
+		r0 = rf(phone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewContactRepository creates a new instance of ContactRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContactRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContactRepository {
+	mock := &ContactRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,238 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ContactUsecase is an autogenerated mock type for the ContactUsecase type
+type ContactUsecase struct {
+	mock.Mock
+}
+
+// AddAddress provides a mock function with given fields: employeeId, req
+func (_m *ContactUsecase) AddAddress(employeeId uint, req domain.AddressRequest) (domain.AddressResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.AddressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.AddressRequest) (domain.AddressResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.AddressRequest) domain.AddressResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.AddressResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.AddressRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddEmergencyContact provides a mock function with given fields: employeeId, req
+func (_m *ContactUsecase) AddEmergencyContact(employeeId uint, req domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.EmergencyContactResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.EmergencyContactRequest) domain.EmergencyContactResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.EmergencyContactResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.EmergencyContactRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddPhone provides a mock function with given fields: employeeId, req
+func (_m *ContactUsecase) AddPhone(employeeId uint, req domain.PhoneRequest) (domain.PhoneResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.PhoneResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.PhoneRequest) (domain.PhoneResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.PhoneRequest) domain.PhoneResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.PhoneResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.PhoneRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteAddress provides a mock function with given fields: employeeId, id
+func (_m *ContactUsecase) DeleteAddress(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEmergencyContact provides a mock function with given fields: employeeId, id
+func (_m *ContactUsecase) DeleteEmergencyContact(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeletePhone provides a mock function with given fields: employeeId, id
+func (_m *ContactUsecase) DeletePhone(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetContacts provides a mock function with given fields: employeeId
+func (_m *ContactUsecase) GetContacts(employeeId uint) (domain.ContactsResponse, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 domain.ContactsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.ContactsResponse, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.ContactsResponse); ok {
+		r0 = rf(employeeId)
+	} else {
+		r0 = ret.Get(0).(domain.ContactsResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAddress provides a mock function with given fields: employeeId, id, req
+func (_m *ContactUsecase) UpdateAddress(employeeId uint, id uint, req domain.AddressRequest) (domain.AddressResponse, error) {
+	ret := _m.Called(employeeId, id, req)
+
+	var r0 domain.AddressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.AddressRequest) (domain.AddressResponse, error)); ok {
+		return rf(employeeId, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.AddressRequest) domain.AddressResponse); ok {
+		r0 = rf(employeeId, id, req)
+	} else {
+		r0 = ret.Get(0).(domain.AddressResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, domain.AddressRequest) error); ok {
+		r1 = rf(employeeId, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEmergencyContact provides a mock function with given fields: employeeId, id, req
+func (_m *ContactUsecase) UpdateEmergencyContact(employeeId uint, id uint, req domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error) {
+	ret := _m.Called(employeeId, id, req)
+
+	var r0 domain.EmergencyContactResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error)); ok {
+		return rf(employeeId, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.EmergencyContactRequest) domain.EmergencyContactResponse); ok {
+		r0 = rf(employeeId, id, req)
+	} else {
+		r0 = ret.Get(0).(domain.EmergencyContactResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, domain.EmergencyContactRequest) error); ok {
+		r1 = rf(employeeId, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePhone provides a mock function with given fields: employeeId, id, req
+func (_m *ContactUsecase) UpdatePhone(employeeId uint, id uint, req domain.PhoneRequest) (domain.PhoneResponse, error) {
+	ret := _m.Called(employeeId, id, req)
+
+	var r0 domain.PhoneResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.PhoneRequest) (domain.PhoneResponse, error)); ok {
+		return rf(employeeId, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.PhoneRequest) domain.PhoneResponse); ok {
+		r0 = rf(employeeId, id, req)
+	} else {
+		r0 = ret.Get(0).(domain.PhoneResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, domain.PhoneRequest) error); ok {
+		r1 = rf(employeeId, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewContactUsecase creates a new instance of ContactUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContactUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContactUsecase {
+	mock := &ContactUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,106 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomFieldRepository is an autogenerated mock type for the CustomFieldRepository type
+type CustomFieldRepository struct {
+	mock.Mock
+}
+
+// DeleteById provides a mock function with given fields: id
+func (_m *CustomFieldRepository) DeleteById(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAll provides a mock function with given fields:
+func (_m *CustomFieldRepository) FindAll() ([]domain.CustomField, error) {
+	ret := _m.Called()
+
+	var r0 []domain.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.CustomField, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.CustomField); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CustomField)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByName provides a mock function with given fields: name
+func (_m *CustomFieldRepository) FindByName(name string) (domain.CustomField, error) {
+	ret := _m.Called(name)
+
+	var r0 domain.CustomField
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (domain.CustomField, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) domain.CustomField); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(domain.CustomField)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: field
+func (_m *CustomFieldRepository) Store(field *domain.CustomField) error {
+	ret := _m.Called(field)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.CustomField) error); ok {
+		r0 = rf(field)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCustomFieldRepository creates a new instance of CustomFieldRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomFieldRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomFieldRepository {
+	mock := &CustomFieldRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
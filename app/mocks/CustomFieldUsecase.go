@@ -0,0 +1,92 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomFieldUsecase is an autogenerated mock type for the CustomFieldUsecase type
+type CustomFieldUsecase struct {
+	mock.Mock
+}
+
+// CreateCustomField provides a mock function with given fields: req
+func (_m *CustomFieldUsecase) CreateCustomField(req domain.CustomFieldRequest) (domain.CustomFieldResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.CustomFieldResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.CustomFieldRequest) (domain.CustomFieldResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.CustomFieldRequest) domain.CustomFieldResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.CustomFieldResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.CustomFieldRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCustomField provides a mock function with given fields: id
+func (_m *CustomFieldUsecase) DeleteCustomField(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllCustomField provides a mock function with given fields:
+func (_m *CustomFieldUsecase) GetAllCustomField() ([]domain.CustomFieldResponse, error) {
+	ret := _m.Called()
+
+	var r0 []domain.CustomFieldResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.CustomFieldResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.CustomFieldResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.CustomFieldResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCustomFieldUsecase creates a new instance of CustomFieldUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomFieldUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomFieldUsecase {
+	mock := &CustomFieldUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
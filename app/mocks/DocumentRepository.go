@@ -0,0 +1,106 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DocumentRepository is an autogenerated mock type for the DocumentRepository type
+type DocumentRepository struct {
+	mock.Mock
+}
+
+// DeleteById provides a mock function with given fields: employeeId, id
+func (_m *DocumentRepository) DeleteById(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByEmployeeId provides a mock function with given fields: employeeId
+func (_m *DocumentRepository) FindByEmployeeId(employeeId uint) ([]domain.Document, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Document, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Document); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: employeeId, id
+func (_m *DocumentRepository) FindById(employeeId uint, id uint) (domain.Document, error) {
+	ret := _m.Called(employeeId, id)
+
+	var r0 domain.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (domain.Document, error)); ok {
+		return rf(employeeId, id)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) domain.Document); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Get(0).(domain.Document)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(employeeId, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: document
+func (_m *DocumentRepository) Store(document *domain.Document) error {
+	ret := _m.Called(document)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Document) error); ok {
+		r0 = rf(document)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewDocumentRepository creates a new instance of DocumentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDocumentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DocumentRepository {
+	mock := &DocumentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
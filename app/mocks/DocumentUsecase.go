@@ -0,0 +1,127 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DocumentUsecase is an autogenerated mock type for the DocumentUsecase type
+type DocumentUsecase struct {
+	mock.Mock
+}
+
+// DeleteDocument provides a mock function with given fields: employeeId, id
+func (_m *DocumentUsecase) DeleteDocument(employeeId uint, id uint) error {
+	ret := _m.Called(employeeId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DownloadDocument provides a mock function with given fields: employeeId, id
+func (_m *DocumentUsecase) DownloadDocument(employeeId uint, id uint) (domain.DocumentResponse, io.ReadCloser, error) {
+	ret := _m.Called(employeeId, id)
+
+	var r0 domain.DocumentResponse
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (domain.DocumentResponse, io.ReadCloser, error)); ok {
+		return rf(employeeId, id)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) domain.DocumentResponse); ok {
+		r0 = rf(employeeId, id)
+	} else {
+		r0 = ret.Get(0).(domain.DocumentResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) io.ReadCloser); ok {
+		r1 = rf(employeeId, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(uint, uint) error); ok {
+		r2 = rf(employeeId, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetDocuments provides a mock function with given fields: employeeId
+func (_m *DocumentUsecase) GetDocuments(employeeId uint) ([]domain.DocumentResponse, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.DocumentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.DocumentResponse, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.DocumentResponse); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.DocumentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UploadDocument provides a mock function with given fields: employeeId, req, content, size
+func (_m *DocumentUsecase) UploadDocument(employeeId uint, req domain.DocumentRequest, content io.Reader, size int64) (domain.DocumentResponse, error) {
+	ret := _m.Called(employeeId, req, content, size)
+
+	var r0 domain.DocumentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.DocumentRequest, io.Reader, int64) (domain.DocumentResponse, error)); ok {
+		return rf(employeeId, req, content, size)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.DocumentRequest, io.Reader, int64) domain.DocumentResponse); ok {
+		r0 = rf(employeeId, req, content, size)
+	} else {
+		r0 = ret.Get(0).(domain.DocumentResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.DocumentRequest, io.Reader, int64) error); ok {
+		r1 = rf(employeeId, req, content, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewDocumentUsecase creates a new instance of DocumentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDocumentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DocumentUsecase {
+	mock := &DocumentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -13,13 +13,13 @@ type EmployeeRepository struct {
 	mock.Mock
 }
 
-// DeleteById provides a mock function with given fields: id
-func (_m *EmployeeRepository) DeleteById(id uint) error {
-	ret := _m.Called(id)
+// DeleteById provides a mock function with given fields: id, tasks
+func (_m *EmployeeRepository) DeleteById(id uint, tasks []domain.EmployeeTask) error {
+	ret := _m.Called(id, tasks)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint) error); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(uint, []domain.EmployeeTask) error); ok {
+		r0 = rf(id, tasks)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -27,32 +27,32 @@ func (_m *EmployeeRepository) DeleteById(id uint) error {
 	return r0
 }
 
-// FindAll provides a mock function with given fields: limit, offset, orderBy, order
-func (_m *EmployeeRepository) FindAll(limit int, offset int, orderBy string, order string) ([]domain.Employee, int64, error) {
-	ret := _m.Called(limit, offset, orderBy, order)
+// FindAll provides a mock function with given fields: limit, offset, orderBy, sort, filter
+func (_m *EmployeeRepository) FindAll(limit int, offset int, orderBy string, sort string, filter domain.EmployeeFilter) ([]domain.Employee, int64, error) {
+	ret := _m.Called(limit, offset, orderBy, sort, filter)
 
 	var r0 []domain.Employee
 	var r1 int64
 	var r2 error
-	if rf, ok := ret.Get(0).(func(int, int, string, string) ([]domain.Employee, int64, error)); ok {
-		return rf(limit, offset, orderBy, order)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, domain.EmployeeFilter) ([]domain.Employee, int64, error)); ok {
+		return rf(limit, offset, orderBy, sort, filter)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string, string) []domain.Employee); ok {
-		r0 = rf(limit, offset, orderBy, order)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, domain.EmployeeFilter) []domain.Employee); ok {
+		r0 = rf(limit, offset, orderBy, sort, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.Employee)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, string, string) int64); ok {
-		r1 = rf(limit, offset, orderBy, order)
+	if rf, ok := ret.Get(1).(func(int, int, string, string, domain.EmployeeFilter) int64); ok {
+		r1 = rf(limit, offset, orderBy, sort, filter)
 	} else {
 		r1 = ret.Get(1).(int64)
 	}
 
-	if rf, ok := ret.Get(2).(func(int, int, string, string) error); ok {
-		r2 = rf(limit, offset, orderBy, order)
+	if rf, ok := ret.Get(2).(func(int, int, string, string, domain.EmployeeFilter) error); ok {
+		r2 = rf(limit, offset, orderBy, sort, filter)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -108,13 +108,91 @@ func (_m *EmployeeRepository) FindById(id uint) (domain.Employee, error) {
 	return r0, r1
 }
 
-// Store provides a mock function with given fields: employee
-func (_m *EmployeeRepository) Store(employee *domain.Employee) error {
-	ret := _m.Called(employee)
+// FindByIds provides a mock function with given fields: ids
+func (_m *EmployeeRepository) FindByIds(ids []uint) ([]domain.Employee, error) {
+	ret := _m.Called(ids)
+
+	var r0 []domain.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]domain.Employee, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []domain.Employee); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByManagerIds provides a mock function with given fields: managerIds
+func (_m *EmployeeRepository) FindByManagerIds(managerIds []uint) ([]domain.Employee, error) {
+	ret := _m.Called(managerIds)
+
+	var r0 []domain.Employee
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]domain.Employee, error)); ok {
+		return rf(managerIds)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []domain.Employee); ok {
+		r0 = rf(managerIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Employee)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(managerIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: terms, limit
+func (_m *EmployeeRepository) Search(terms []string, limit int) ([]domain.EmployeeSearchMatch, error) {
+	ret := _m.Called(terms, limit)
+
+	var r0 []domain.EmployeeSearchMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, int) ([]domain.EmployeeSearchMatch, error)); ok {
+		return rf(terms, limit)
+	}
+	if rf, ok := ret.Get(0).(func([]string, int) []domain.EmployeeSearchMatch); ok {
+		r0 = rf(terms, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EmployeeSearchMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, int) error); ok {
+		r1 = rf(terms, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: employee, tasks
+func (_m *EmployeeRepository) Store(employee *domain.Employee, tasks []domain.EmployeeTask) error {
+	ret := _m.Called(employee, tasks)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*domain.Employee) error); ok {
-		r0 = rf(employee)
+	if rf, ok := ret.Get(0).(func(*domain.Employee, []domain.EmployeeTask) error); ok {
+		r0 = rf(employee, tasks)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -136,6 +214,62 @@ func (_m *EmployeeRepository) UpdateById(employee *domain.Employee) error {
 	return r0
 }
 
+// UpdateCalendar provides a mock function with given fields: id, calendarId
+func (_m *EmployeeRepository) UpdateCalendar(id uint, calendarId *uint) error {
+	ret := _m.Called(id, calendarId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, *uint) error); ok {
+		r0 = rf(id, calendarId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEmploymentStatus provides a mock function with given fields: employee, tasks
+func (_m *EmployeeRepository) UpdateEmploymentStatus(employee *domain.Employee, tasks []domain.EmployeeTask) error {
+	ret := _m.Called(employee, tasks)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Employee, []domain.EmployeeTask) error); ok {
+		r0 = rf(employee, tasks)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateManager provides a mock function with given fields: id, managerId
+func (_m *EmployeeRepository) UpdateManager(id uint, managerId *uint) error {
+	ret := _m.Called(id, managerId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, *uint) error); ok {
+		r0 = rf(id, managerId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePhotoVersion provides a mock function with given fields: id, version
+func (_m *EmployeeRepository) UpdatePhotoVersion(id uint, version string) error {
+	ret := _m.Called(id, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, string) error); ok {
+		r0 = rf(id, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewEmployeeRepository creates a new instance of EmployeeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewEmployeeRepository(t interface {
@@ -13,6 +13,54 @@ type EmployeeUsecase struct {
 	mock.Mock
 }
 
+// ActivateEmployee provides a mock function with given fields: id
+func (_m *EmployeeUsecase) ActivateEmployee(id uint) (domain.EmployeeResponse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.EmployeeResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.EmployeeResponse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssignManager provides a mock function with given fields: id, req
+func (_m *EmployeeUsecase) AssignManager(id uint, req domain.ManagerRequest) (domain.EmployeeResponse, error) {
+	ret := _m.Called(id, req)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.ManagerRequest) (domain.EmployeeResponse, error)); ok {
+		return rf(id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.ManagerRequest) domain.EmployeeResponse); ok {
+		r0 = rf(id, req)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.ManagerRequest) error); ok {
+		r1 = rf(id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateEmployee provides a mock function with given fields: req
 func (_m *EmployeeUsecase) CreateEmployee(req domain.EmployeeRequest) (domain.EmployeeResponse, error) {
 	ret := _m.Called(req)
@@ -51,23 +99,49 @@ func (_m *EmployeeUsecase) DeleteEmployeeById(id uint) error {
 	return r0
 }
 
-// GetAllEmployee provides a mock function with given fields: page, limit, orderBy, sort
-func (_m *EmployeeUsecase) GetAllEmployee(page int, limit int, orderBy string, sort string) (domain.PaginationResponse, error) {
-	ret := _m.Called(page, limit, orderBy, sort)
+// GetAllEmployee provides a mock function with given fields: page, limit, orderBy, sort, filter
+func (_m *EmployeeUsecase) GetAllEmployee(page int, limit int, orderBy string, sort string, filter domain.EmployeeFilter) (domain.PaginationResponse, error) {
+	ret := _m.Called(page, limit, orderBy, sort, filter)
 
 	var r0 domain.PaginationResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(int, int, string, string) (domain.PaginationResponse, error)); ok {
-		return rf(page, limit, orderBy, sort)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, domain.EmployeeFilter) (domain.PaginationResponse, error)); ok {
+		return rf(page, limit, orderBy, sort, filter)
 	}
-	if rf, ok := ret.Get(0).(func(int, int, string, string) domain.PaginationResponse); ok {
-		r0 = rf(page, limit, orderBy, sort)
+	if rf, ok := ret.Get(0).(func(int, int, string, string, domain.EmployeeFilter) domain.PaginationResponse); ok {
+		r0 = rf(page, limit, orderBy, sort, filter)
 	} else {
 		r0 = ret.Get(0).(domain.PaginationResponse)
 	}
 
-	if rf, ok := ret.Get(1).(func(int, int, string, string) error); ok {
-		r1 = rf(page, limit, orderBy, sort)
+	if rf, ok := ret.Get(1).(func(int, int, string, string, domain.EmployeeFilter) error); ok {
+		r1 = rf(page, limit, orderBy, sort, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDirectReports provides a mock function with given fields: managerIds
+func (_m *EmployeeUsecase) GetDirectReports(managerIds []uint) (map[uint][]domain.EmployeeResponse, error) {
+	ret := _m.Called(managerIds)
+
+	var r0 map[uint][]domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) (map[uint][]domain.EmployeeResponse, error)); ok {
+		return rf(managerIds)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) map[uint][]domain.EmployeeResponse); ok {
+		r0 = rf(managerIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint][]domain.EmployeeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(managerIds)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -99,6 +173,154 @@ func (_m *EmployeeUsecase) GetEmployeeById(id uint) (domain.EmployeeResponse, er
 	return r0, r1
 }
 
+// GetEmployeesByIds provides a mock function with given fields: ids
+func (_m *EmployeeUsecase) GetEmployeesByIds(ids []uint) (map[uint]domain.EmployeeResponse, error) {
+	ret := _m.Called(ids)
+
+	var r0 map[uint]domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) (map[uint]domain.EmployeeResponse, error)); ok {
+		return rf(ids)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) map[uint]domain.EmployeeResponse); ok {
+		r0 = rf(ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]domain.EmployeeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlaceEmployeeOnLeave provides a mock function with given fields: id
+func (_m *EmployeeUsecase) PlaceEmployeeOnLeave(id uint) (domain.EmployeeResponse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.EmployeeResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.EmployeeResponse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RehireEmployee provides a mock function with given fields: id, req
+func (_m *EmployeeUsecase) RehireEmployee(id uint, req domain.RehireRequest) (domain.EmployeeResponse, error) {
+	ret := _m.Called(id, req)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.RehireRequest) (domain.EmployeeResponse, error)); ok {
+		return rf(id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.RehireRequest) domain.EmployeeResponse); ok {
+		r0 = rf(id, req)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.RehireRequest) error); ok {
+		r1 = rf(id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReturnEmployeeFromLeave provides a mock function with given fields: id
+func (_m *EmployeeUsecase) ReturnEmployeeFromLeave(id uint) (domain.EmployeeResponse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.EmployeeResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.EmployeeResponse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchEmployees provides a mock function with given fields: query, limit
+func (_m *EmployeeUsecase) SearchEmployees(query string, limit int) ([]domain.EmployeeSearchResult, error) {
+	ret := _m.Called(query, limit)
+
+	var r0 []domain.EmployeeSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]domain.EmployeeSearchResult, error)); ok {
+		return rf(query, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []domain.EmployeeSearchResult); ok {
+		r0 = rf(query, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.EmployeeSearchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(query, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TerminateEmployee provides a mock function with given fields: id, req
+func (_m *EmployeeUsecase) TerminateEmployee(id uint, req domain.TerminationRequest) (domain.EmployeeResponse, error) {
+	ret := _m.Called(id, req)
+
+	var r0 domain.EmployeeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.TerminationRequest) (domain.EmployeeResponse, error)); ok {
+		return rf(id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.TerminationRequest) domain.EmployeeResponse); ok {
+		r0 = rf(id, req)
+	} else {
+		r0 = ret.Get(0).(domain.EmployeeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.TerminationRequest) error); ok {
+		r1 = rf(id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateEmployeeById provides a mock function with given fields: id, req
 func (_m *EmployeeUsecase) UpdateEmployeeById(id uint, req domain.EmployeeRequest) (domain.EmployeeResponse, error) {
 	ret := _m.Called(id, req)
@@ -0,0 +1,68 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobAssignmentRepository is an autogenerated mock type for the JobAssignmentRepository type
+type JobAssignmentRepository struct {
+	mock.Mock
+}
+
+// Assign provides a mock function with given fields: assignment
+func (_m *JobAssignmentRepository) Assign(assignment *domain.JobAssignment) error {
+	ret := _m.Called(assignment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.JobAssignment) error); ok {
+		r0 = rf(assignment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindByEmployeeId provides a mock function with given fields: employeeId
+func (_m *JobAssignmentRepository) FindByEmployeeId(employeeId uint) ([]domain.JobAssignment, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.JobAssignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.JobAssignment, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.JobAssignment); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.JobAssignment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewJobAssignmentRepository creates a new instance of JobAssignmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobAssignmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobAssignmentRepository {
+	mock := &JobAssignmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
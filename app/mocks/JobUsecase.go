@@ -0,0 +1,78 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobUsecase is an autogenerated mock type for the JobUsecase type
+type JobUsecase struct {
+	mock.Mock
+}
+
+// AssignJob provides a mock function with given fields: employeeId, req
+func (_m *JobUsecase) AssignJob(employeeId uint, req domain.JobAssignmentRequest) (domain.JobAssignmentResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.JobAssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.JobAssignmentRequest) (domain.JobAssignmentResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.JobAssignmentRequest) domain.JobAssignmentResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.JobAssignmentResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.JobAssignmentRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetJobHistory provides a mock function with given fields: employeeId, date
+func (_m *JobUsecase) GetJobHistory(employeeId uint, date string) ([]domain.JobAssignmentResponse, error) {
+	ret := _m.Called(employeeId, date)
+
+	var r0 []domain.JobAssignmentResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) ([]domain.JobAssignmentResponse, error)); ok {
+		return rf(employeeId, date)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) []domain.JobAssignmentResponse); ok {
+		r0 = rf(employeeId, date)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.JobAssignmentResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(employeeId, date)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewJobUsecase creates a new instance of JobUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobUsecase {
+	mock := &JobUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
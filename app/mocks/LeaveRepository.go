@@ -0,0 +1,158 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LeaveRepository is an autogenerated mock type for the LeaveRepository type
+type LeaveRepository struct {
+	mock.Mock
+}
+
+// Approve provides a mock function with given fields: leave
+func (_m *LeaveRepository) Approve(leave *domain.Leave) error {
+	ret := _m.Called(leave)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Leave) error); ok {
+		r0 = rf(leave)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindBalance provides a mock function with given fields: employeeId, leaveTypeId, year
+func (_m *LeaveRepository) FindBalance(employeeId uint, leaveTypeId uint, year int) (domain.LeaveBalance, error) {
+	ret := _m.Called(employeeId, leaveTypeId, year)
+
+	var r0 domain.LeaveBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, int) (domain.LeaveBalance, error)); ok {
+		return rf(employeeId, leaveTypeId, year)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, int) domain.LeaveBalance); ok {
+		r0 = rf(employeeId, leaveTypeId, year)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveBalance)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, int) error); ok {
+		r1 = rf(employeeId, leaveTypeId, year)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByEmployeeId provides a mock function with given fields: employeeId
+func (_m *LeaveRepository) FindByEmployeeId(employeeId uint) ([]domain.Leave, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Leave
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Leave, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Leave); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Leave)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: id
+func (_m *LeaveRepository) FindById(id uint) (domain.Leave, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Leave
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.Leave, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.Leave); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Leave)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reject provides a mock function with given fields: leave
+func (_m *LeaveRepository) Reject(leave *domain.Leave) error {
+	ret := _m.Called(leave)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Leave) error); ok {
+		r0 = rf(leave)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Store provides a mock function with given fields: leave
+func (_m *LeaveRepository) Store(leave *domain.Leave) error {
+	ret := _m.Called(leave)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Leave) error); ok {
+		r0 = rf(leave)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreBalance provides a mock function with given fields: balance
+func (_m *LeaveRepository) StoreBalance(balance *domain.LeaveBalance) error {
+	ret := _m.Called(balance)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.LeaveBalance) error); ok {
+		r0 = rf(balance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewLeaveRepository creates a new instance of LeaveRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLeaveRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LeaveRepository {
+	mock := &LeaveRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,116 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LeaveTypeRepository is an autogenerated mock type for the LeaveTypeRepository type
+type LeaveTypeRepository struct {
+	mock.Mock
+}
+
+// FindAll provides a mock function with given fields:
+func (_m *LeaveTypeRepository) FindAll() ([]domain.LeaveType, error) {
+	ret := _m.Called()
+
+	var r0 []domain.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.LeaveType, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.LeaveType); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LeaveType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCode provides a mock function with given fields: code
+func (_m *LeaveTypeRepository) FindByCode(code string) (domain.LeaveType, error) {
+	ret := _m.Called(code)
+
+	var r0 domain.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (domain.LeaveType, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) domain.LeaveType); ok {
+		r0 = rf(code)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveType)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: id
+func (_m *LeaveTypeRepository) FindById(id uint) (domain.LeaveType, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.LeaveType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.LeaveType, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.LeaveType); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveType)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: leaveType
+func (_m *LeaveTypeRepository) Store(leaveType *domain.LeaveType) error {
+	ret := _m.Called(leaveType)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.LeaveType) error); ok {
+		r0 = rf(leaveType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewLeaveTypeRepository creates a new instance of LeaveTypeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLeaveTypeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LeaveTypeRepository {
+	mock := &LeaveTypeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
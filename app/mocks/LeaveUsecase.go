@@ -0,0 +1,202 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LeaveUsecase is an autogenerated mock type for the LeaveUsecase type
+type LeaveUsecase struct {
+	mock.Mock
+}
+
+// ApproveLeave provides a mock function with given fields: id, approverId, req
+func (_m *LeaveUsecase) ApproveLeave(id uint, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error) {
+	ret := _m.Called(id, approverId, req)
+
+	var r0 domain.LeaveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.LeaveDecisionRequest) (domain.LeaveResponse, error)); ok {
+		return rf(id, approverId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.LeaveDecisionRequest) domain.LeaveResponse); ok {
+		r0 = rf(id, approverId, req)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, domain.LeaveDecisionRequest) error); ok {
+		r1 = rf(id, approverId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateLeaveType provides a mock function with given fields: req
+func (_m *LeaveUsecase) CreateLeaveType(req domain.LeaveTypeRequest) (domain.LeaveTypeResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.LeaveTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.LeaveTypeRequest) (domain.LeaveTypeResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.LeaveTypeRequest) domain.LeaveTypeResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveTypeResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.LeaveTypeRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllLeaveType provides a mock function with given fields:
+func (_m *LeaveUsecase) GetAllLeaveType() ([]domain.LeaveTypeResponse, error) {
+	ret := _m.Called()
+
+	var r0 []domain.LeaveTypeResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.LeaveTypeResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.LeaveTypeResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LeaveTypeResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeaveBalances provides a mock function with given fields: employeeId, year
+func (_m *LeaveUsecase) GetLeaveBalances(employeeId uint, year int) ([]domain.LeaveBalanceResponse, error) {
+	ret := _m.Called(employeeId, year)
+
+	var r0 []domain.LeaveBalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]domain.LeaveBalanceResponse, error)); ok {
+		return rf(employeeId, year)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []domain.LeaveBalanceResponse); ok {
+		r0 = rf(employeeId, year)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LeaveBalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(employeeId, year)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLeaveRequests provides a mock function with given fields: employeeId
+func (_m *LeaveUsecase) GetLeaveRequests(employeeId uint) ([]domain.LeaveResponse, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.LeaveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.LeaveResponse, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.LeaveResponse); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.LeaveResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RejectLeave provides a mock function with given fields: id, approverId, req
+func (_m *LeaveUsecase) RejectLeave(id uint, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error) {
+	ret := _m.Called(id, approverId, req)
+
+	var r0 domain.LeaveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.LeaveDecisionRequest) (domain.LeaveResponse, error)); ok {
+		return rf(id, approverId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, domain.LeaveDecisionRequest) domain.LeaveResponse); ok {
+		r0 = rf(id, approverId, req)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, domain.LeaveDecisionRequest) error); ok {
+		r1 = rf(id, approverId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestLeave provides a mock function with given fields: employeeId, req
+func (_m *LeaveUsecase) RequestLeave(employeeId uint, req domain.LeaveRequest) (domain.LeaveResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.LeaveResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.LeaveRequest) (domain.LeaveResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.LeaveRequest) domain.LeaveResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.LeaveResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.LeaveRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLeaveUsecase creates a new instance of LeaveUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLeaveUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LeaveUsecase {
+	mock := &LeaveUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
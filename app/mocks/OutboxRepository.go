@@ -0,0 +1,196 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OutboxRepository is an autogenerated mock type for the OutboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+// Claim provides a mock function with given fields: limit, now, lease
+func (_m *OutboxRepository) Claim(limit int, now time.Time, lease time.Duration) ([]domain.OutboxEvent, error) {
+	ret := _m.Called(limit, now, lease)
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, time.Time, time.Duration) ([]domain.OutboxEvent, error)); ok {
+		return rf(limit, now, lease)
+	}
+	if rf, ok := ret.Get(0).(func(int, time.Time, time.Duration) []domain.OutboxEvent); ok {
+		r0 = rf(limit, now, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, time.Time, time.Duration) error); ok {
+		r1 = rf(limit, now, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFinishedBefore provides a mock function with given fields: before
+func (_m *OutboxRepository) DeleteFinishedBefore(before time.Time) (int64, error) {
+	ret := _m.Called(before)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int64, error)); ok {
+		return rf(before)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int64); ok {
+		r0 = rf(before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Exists provides a mock function with given fields: id
+func (_m *OutboxRepository) Exists(id uint64) (bool, error) {
+	ret := _m.Called(id)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint64) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAfter provides a mock function with given fields: after, until, limit
+func (_m *OutboxRepository) FindAfter(after uint64, until uint64, limit int) ([]domain.OutboxEvent, error) {
+	ret := _m.Called(after, until, limit)
+
+	var r0 []domain.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint64, uint64, int) ([]domain.OutboxEvent, error)); ok {
+		return rf(after, until, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint64, uint64, int) []domain.OutboxEvent); ok {
+		r0 = rf(after, until, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint64, uint64, int) error); ok {
+		r1 = rf(after, until, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkDispatched provides a mock function with given fields: id, at
+func (_m *OutboxRepository) MarkDispatched(id uint64, at time.Time) error {
+	ret := _m.Called(id, at)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, time.Time) error); ok {
+		r0 = rf(id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkFailed provides a mock function with given fields: id, at, lastError
+func (_m *OutboxRepository) MarkFailed(id uint64, at time.Time, lastError string) error {
+	ret := _m.Called(id, at, lastError)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, time.Time, string) error); ok {
+		r0 = rf(id, at, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkRetry provides a mock function with given fields: id, nextAttemptAt, lastError
+func (_m *OutboxRepository) MarkRetry(id uint64, nextAttemptAt time.Time, lastError string) error {
+	ret := _m.Called(id, nextAttemptAt, lastError)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, time.Time, string) error); ok {
+		r0 = rf(id, nextAttemptAt, lastError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Snapshot provides a mock function with given fields:
+func (_m *OutboxRepository) Snapshot() (domain.OutboxSnapshot, error) {
+	ret := _m.Called()
+
+	var r0 domain.OutboxSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (domain.OutboxSnapshot, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() domain.OutboxSnapshot); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(domain.OutboxSnapshot)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,87 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PhotoUsecase is an autogenerated mock type for the PhotoUsecase type
+type PhotoUsecase struct {
+	mock.Mock
+}
+
+// GetPhoto provides a mock function with given fields: employeeId, size
+func (_m *PhotoUsecase) GetPhoto(employeeId uint, size string) (domain.PhotoResponse, io.ReadCloser, error) {
+	ret := _m.Called(employeeId, size)
+
+	var r0 domain.PhotoResponse
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(uint, string) (domain.PhotoResponse, io.ReadCloser, error)); ok {
+		return rf(employeeId, size)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) domain.PhotoResponse); ok {
+		r0 = rf(employeeId, size)
+	} else {
+		r0 = ret.Get(0).(domain.PhotoResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) io.ReadCloser); ok {
+		r1 = rf(employeeId, size)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(uint, string) error); ok {
+		r2 = rf(employeeId, size)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UploadPhoto provides a mock function with given fields: employeeId, content, size
+func (_m *PhotoUsecase) UploadPhoto(employeeId uint, content io.Reader, size int64) (domain.PhotoResponse, error) {
+	ret := _m.Called(employeeId, content, size)
+
+	var r0 domain.PhotoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, io.Reader, int64) (domain.PhotoResponse, error)); ok {
+		return rf(employeeId, content, size)
+	}
+	if rf, ok := ret.Get(0).(func(uint, io.Reader, int64) domain.PhotoResponse); ok {
+		r0 = rf(employeeId, content, size)
+	} else {
+		r0 = ret.Get(0).(domain.PhotoResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, io.Reader, int64) error); ok {
+		r1 = rf(employeeId, content, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPhotoUsecase creates a new instance of PhotoUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPhotoUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PhotoUsecase {
+	mock := &PhotoUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,144 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PositionRepository is an autogenerated mock type for the PositionRepository type
+type PositionRepository struct {
+	mock.Mock
+}
+
+// DeleteById provides a mock function with given fields: id
+func (_m *PositionRepository) DeleteById(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindAll provides a mock function with given fields:
+func (_m *PositionRepository) FindAll() ([]domain.Position, error) {
+	ret := _m.Called()
+
+	var r0 []domain.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.Position, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.Position); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: id
+func (_m *PositionRepository) FindById(id uint) (domain.Position, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.Position, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.Position); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Position)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByTitle provides a mock function with given fields: title
+func (_m *PositionRepository) FindByTitle(title string) (domain.Position, error) {
+	ret := _m.Called(title)
+
+	var r0 domain.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (domain.Position, error)); ok {
+		return rf(title)
+	}
+	if rf, ok := ret.Get(0).(func(string) domain.Position); ok {
+		r0 = rf(title)
+	} else {
+		r0 = ret.Get(0).(domain.Position)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(title)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: position
+func (_m *PositionRepository) Store(position *domain.Position) error {
+	ret := _m.Called(position)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Position) error); ok {
+		r0 = rf(position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateById provides a mock function with given fields: position
+func (_m *PositionRepository) UpdateById(position *domain.Position) error {
+	ret := _m.Called(position)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Position) error); ok {
+		r0 = rf(position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPositionRepository creates a new instance of PositionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPositionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PositionRepository {
+	mock := &PositionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
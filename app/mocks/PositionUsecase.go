@@ -0,0 +1,140 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PositionUsecase is an autogenerated mock type for the PositionUsecase type
+type PositionUsecase struct {
+	mock.Mock
+}
+
+// CreatePosition provides a mock function with given fields: req
+func (_m *PositionUsecase) CreatePosition(req domain.PositionRequest) (domain.PositionResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.PositionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.PositionRequest) (domain.PositionResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.PositionRequest) domain.PositionResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.PositionResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.PositionRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePositionById provides a mock function with given fields: id
+func (_m *PositionUsecase) DeletePositionById(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllPosition provides a mock function with given fields:
+func (_m *PositionUsecase) GetAllPosition() ([]domain.PositionResponse, error) {
+	ret := _m.Called()
+
+	var r0 []domain.PositionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.PositionResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.PositionResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.PositionResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPositionById provides a mock function with given fields: id
+func (_m *PositionUsecase) GetPositionById(id uint) (domain.PositionResponse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.PositionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.PositionResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.PositionResponse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.PositionResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePositionById provides a mock function with given fields: id, req
+func (_m *PositionUsecase) UpdatePositionById(id uint, req domain.PositionRequest) (domain.PositionResponse, error) {
+	ret := _m.Called(id, req)
+
+	var r0 domain.PositionResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.PositionRequest) (domain.PositionResponse, error)); ok {
+		return rf(id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.PositionRequest) domain.PositionResponse); ok {
+		r0 = rf(id, req)
+	} else {
+		r0 = ret.Get(0).(domain.PositionResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.PositionRequest) error); ok {
+		r1 = rf(id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPositionUsecase creates a new instance of PositionUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPositionUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PositionUsecase {
+	mock := &PositionUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,225 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewRepository is an autogenerated mock type for the ReviewRepository type
+type ReviewRepository struct {
+	mock.Mock
+}
+
+// FindAllCycle provides a mock function with given fields:
+func (_m *ReviewRepository) FindAllCycle() ([]domain.ReviewCycle, error) {
+	ret := _m.Called()
+
+	var r0 []domain.ReviewCycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.ReviewCycle, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.ReviewCycle); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReviewCycle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByCycleAndEmployee provides a mock function with given fields: cycleId, employeeId
+func (_m *ReviewRepository) FindByCycleAndEmployee(cycleId uint, employeeId uint) (domain.Review, error) {
+	ret := _m.Called(cycleId, employeeId)
+
+	var r0 domain.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (domain.Review, error)); ok {
+		return rf(cycleId, employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) domain.Review); ok {
+		r0 = rf(cycleId, employeeId)
+	} else {
+		r0 = ret.Get(0).(domain.Review)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(cycleId, employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindByEmployeeId provides a mock function with given fields: employeeId
+func (_m *ReviewRepository) FindByEmployeeId(employeeId uint) ([]domain.Review, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.Review, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.Review); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Review)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: id
+func (_m *ReviewRepository) FindById(id uint) (domain.Review, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Review
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.Review, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.Review); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Review)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindCycleById provides a mock function with given fields: id
+func (_m *ReviewRepository) FindCycleById(id uint) (domain.ReviewCycle, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.ReviewCycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.ReviewCycle, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.ReviewCycle); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewCycle)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindCycleByName provides a mock function with given fields: name
+func (_m *ReviewRepository) FindCycleByName(name string) (domain.ReviewCycle, error) {
+	ret := _m.Called(name)
+
+	var r0 domain.ReviewCycle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (domain.ReviewCycle, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) domain.ReviewCycle); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewCycle)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store provides a mock function with given fields: review
+func (_m *ReviewRepository) Store(review *domain.Review) error {
+	ret := _m.Called(review)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Review) error); ok {
+		r0 = rf(review)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreCycle provides a mock function with given fields: cycle
+func (_m *ReviewRepository) StoreCycle(cycle *domain.ReviewCycle) error {
+	ret := _m.Called(cycle)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.ReviewCycle) error); ok {
+		r0 = rf(cycle)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: review, status, columns
+func (_m *ReviewRepository) Update(review *domain.Review, status string, columns ...string) error {
+	_va := make([]interface{}, len(columns))
+	for _i := range columns {
+		_va[_i] = columns[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, review, status)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Review, string, ...string) error); ok {
+		r0 = rf(review, status, columns...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewReviewRepository creates a new instance of ReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewRepository {
+	mock := &ReviewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
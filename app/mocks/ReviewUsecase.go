@@ -0,0 +1,224 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReviewUsecase is an autogenerated mock type for the ReviewUsecase type
+type ReviewUsecase struct {
+	mock.Mock
+}
+
+// AcknowledgeReview provides a mock function with given fields: employeeId, reviewId, actorId
+func (_m *ReviewUsecase) AcknowledgeReview(employeeId uint, reviewId uint, actorId uint) (domain.ReviewResponse, error) {
+	ret := _m.Called(employeeId, reviewId, actorId)
+
+	var r0 domain.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) (domain.ReviewResponse, error)); ok {
+		return rf(employeeId, reviewId, actorId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) domain.ReviewResponse); ok {
+		r0 = rf(employeeId, reviewId, actorId)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
+		r1 = rf(employeeId, reviewId, actorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateReviewCycle provides a mock function with given fields: req
+func (_m *ReviewUsecase) CreateReviewCycle(req domain.ReviewCycleRequest) (domain.ReviewCycleResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.ReviewCycleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.ReviewCycleRequest) (domain.ReviewCycleResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.ReviewCycleRequest) domain.ReviewCycleResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewCycleResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.ReviewCycleRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllReviewCycle provides a mock function with given fields:
+func (_m *ReviewUsecase) GetAllReviewCycle() ([]domain.ReviewCycleResponse, error) {
+	ret := _m.Called()
+
+	var r0 []domain.ReviewCycleResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.ReviewCycleResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.ReviewCycleResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReviewCycleResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReviews provides a mock function with given fields: employeeId
+func (_m *ReviewUsecase) GetReviews(employeeId uint) ([]domain.ReviewResponse, error) {
+	ret := _m.Called(employeeId)
+
+	var r0 []domain.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]domain.ReviewResponse, error)); ok {
+		return rf(employeeId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []domain.ReviewResponse); ok {
+		r0 = rf(employeeId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ReviewResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(employeeId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartReview provides a mock function with given fields: employeeId, req
+func (_m *ReviewUsecase) StartReview(employeeId uint, req domain.StartReviewRequest) (domain.ReviewResponse, error) {
+	ret := _m.Called(employeeId, req)
+
+	var r0 domain.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.StartReviewRequest) (domain.ReviewResponse, error)); ok {
+		return rf(employeeId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.StartReviewRequest) domain.ReviewResponse); ok {
+		r0 = rf(employeeId, req)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.StartReviewRequest) error); ok {
+		r1 = rf(employeeId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitReview provides a mock function with given fields: employeeId, reviewId, actorId
+func (_m *ReviewUsecase) SubmitReview(employeeId uint, reviewId uint, actorId uint) (domain.ReviewResponse, error) {
+	ret := _m.Called(employeeId, reviewId, actorId)
+
+	var r0 domain.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) (domain.ReviewResponse, error)); ok {
+		return rf(employeeId, reviewId, actorId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) domain.ReviewResponse); ok {
+		r0 = rf(employeeId, reviewId, actorId)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
+		r1 = rf(employeeId, reviewId, actorId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WriteManagerAssessment provides a mock function with given fields: employeeId, reviewId, actorId, req
+func (_m *ReviewUsecase) WriteManagerAssessment(employeeId uint, reviewId uint, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error) {
+	ret := _m.Called(employeeId, reviewId, actorId, req)
+
+	var r0 domain.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, domain.AssessmentRequest) (domain.ReviewResponse, error)); ok {
+		return rf(employeeId, reviewId, actorId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, domain.AssessmentRequest) domain.ReviewResponse); ok {
+		r0 = rf(employeeId, reviewId, actorId, req)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint, domain.AssessmentRequest) error); ok {
+		r1 = rf(employeeId, reviewId, actorId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WriteSelfAssessment provides a mock function with given fields: employeeId, reviewId, actorId, req
+func (_m *ReviewUsecase) WriteSelfAssessment(employeeId uint, reviewId uint, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error) {
+	ret := _m.Called(employeeId, reviewId, actorId, req)
+
+	var r0 domain.ReviewResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, domain.AssessmentRequest) (domain.ReviewResponse, error)); ok {
+		return rf(employeeId, reviewId, actorId, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, domain.AssessmentRequest) domain.ReviewResponse); ok {
+		r0 = rf(employeeId, reviewId, actorId, req)
+	} else {
+		r0 = ret.Get(0).(domain.ReviewResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint, domain.AssessmentRequest) error); ok {
+		r1 = rf(employeeId, reviewId, actorId, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReviewUsecase creates a new instance of ReviewUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReviewUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReviewUsecase {
+	mock := &ReviewUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
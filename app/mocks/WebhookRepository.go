@@ -0,0 +1,318 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// WebhookRepository is an autogenerated mock type for the WebhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+// ClaimDeliveries provides a mock function with given fields: limit, now, lease
+func (_m *WebhookRepository) ClaimDeliveries(limit int, now time.Time, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	ret := _m.Called(limit, now, lease)
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, time.Time, time.Duration) ([]domain.WebhookDelivery, error)); ok {
+		return rf(limit, now, lease)
+	}
+	if rf, ok := ret.Get(0).(func(int, time.Time, time.Duration) []domain.WebhookDelivery); ok {
+		r0 = rf(limit, now, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, time.Time, time.Duration) error); ok {
+		r1 = rf(limit, now, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteById provides a mock function with given fields: id
+func (_m *WebhookRepository) DeleteById(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExtendDeliveryLease provides a mock function with given fields: ids, until
+func (_m *WebhookRepository) ExtendDeliveryLease(ids []uint64, until time.Time) error {
+	ret := _m.Called(ids, until)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]uint64, time.Time) error); ok {
+		r0 = rf(ids, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindActive provides a mock function with given fields: eventType
+func (_m *WebhookRepository) FindActive(eventType string) ([]domain.Webhook, error) {
+	ret := _m.Called(eventType)
+
+	var r0 []domain.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]domain.Webhook, error)); ok {
+		return rf(eventType)
+	}
+	if rf, ok := ret.Get(0).(func(string) []domain.Webhook); ok {
+		r0 = rf(eventType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(eventType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAll provides a mock function with given fields:
+func (_m *WebhookRepository) FindAll() ([]domain.Webhook, error) {
+	ret := _m.Called()
+
+	var r0 []domain.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.Webhook, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.Webhook); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Webhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindById provides a mock function with given fields: id
+func (_m *WebhookRepository) FindById(id uint) (domain.Webhook, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.Webhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.Webhook, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.Webhook); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.Webhook)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeliveries provides a mock function with given fields: webhookId, limit
+func (_m *WebhookRepository) FindDeliveries(webhookId uint, limit int) ([]domain.WebhookDelivery, error) {
+	ret := _m.Called(webhookId, limit)
+
+	var r0 []domain.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]domain.WebhookDelivery, error)); ok {
+		return rf(webhookId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []domain.WebhookDelivery); ok {
+		r0 = rf(webhookId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(webhookId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindDeliveryById provides a mock function with given fields: webhookId, id
+func (_m *WebhookRepository) FindDeliveryById(webhookId uint, id uint64) (domain.WebhookDelivery, error) {
+	ret := _m.Called(webhookId, id)
+
+	var r0 domain.WebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint64) (domain.WebhookDelivery, error)); ok {
+		return rf(webhookId, id)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint64) domain.WebhookDelivery); ok {
+		r0 = rf(webhookId, id)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookDelivery)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint64) error); ok {
+		r1 = rf(webhookId, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordFailure provides a mock function with given fields: id, limit, at
+func (_m *WebhookRepository) RecordFailure(id uint, limit int, at time.Time) (bool, error) {
+	ret := _m.Called(id, limit, at)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int, time.Time) (bool, error)); ok {
+		return rf(id, limit, at)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int, time.Time) bool); ok {
+		r0 = rf(id, limit, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int, time.Time) error); ok {
+		r1 = rf(id, limit, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordSuccess provides a mock function with given fields: id
+func (_m *WebhookRepository) RecordSuccess(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseDeliveries provides a mock function with given fields: ids, nextAttemptAt
+func (_m *WebhookRepository) ReleaseDeliveries(ids []uint64, nextAttemptAt time.Time) error {
+	ret := _m.Called(ids, nextAttemptAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]uint64, time.Time) error); ok {
+		r0 = rf(ids, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Store provides a mock function with given fields: webhook
+func (_m *WebhookRepository) Store(webhook *domain.Webhook) error {
+	ret := _m.Called(webhook)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Webhook) error); ok {
+		r0 = rf(webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StoreDeliveries provides a mock function with given fields: deliveries
+func (_m *WebhookRepository) StoreDeliveries(deliveries []domain.WebhookDelivery) error {
+	ret := _m.Called(deliveries)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]domain.WebhookDelivery) error); ok {
+		r0 = rf(deliveries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Update provides a mock function with given fields: webhook
+func (_m *WebhookRepository) Update(webhook *domain.Webhook) error {
+	ret := _m.Called(webhook)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.Webhook) error); ok {
+		r0 = rf(webhook)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDelivery provides a mock function with given fields: delivery
+func (_m *WebhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	ret := _m.Called(delivery)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*domain.WebhookDelivery) error); ok {
+		r0 = rf(delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
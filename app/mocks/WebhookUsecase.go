@@ -0,0 +1,190 @@
+// Code generated by mockery v2.33.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	domain "github.com/RuhullahReza/Employee-App/app/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WebhookUsecase is an autogenerated mock type for the WebhookUsecase type
+type WebhookUsecase struct {
+	mock.Mock
+}
+
+// CreateWebhook provides a mock function with given fields: req
+func (_m *WebhookUsecase) CreateWebhook(req domain.WebhookRequest) (domain.WebhookResponse, error) {
+	ret := _m.Called(req)
+
+	var r0 domain.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(domain.WebhookRequest) (domain.WebhookResponse, error)); ok {
+		return rf(req)
+	}
+	if rf, ok := ret.Get(0).(func(domain.WebhookRequest) domain.WebhookResponse); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(domain.WebhookRequest) error); ok {
+		r1 = rf(req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWebhook provides a mock function with given fields: id
+func (_m *WebhookUsecase) DeleteWebhook(id uint) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllWebhook provides a mock function with given fields:
+func (_m *WebhookUsecase) GetAllWebhook() ([]domain.WebhookResponse, error) {
+	ret := _m.Called()
+
+	var r0 []domain.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]domain.WebhookResponse, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []domain.WebhookResponse); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDeliveries provides a mock function with given fields: webhookId, limit
+func (_m *WebhookUsecase) GetDeliveries(webhookId uint, limit int) ([]domain.WebhookDeliveryResponse, error) {
+	ret := _m.Called(webhookId, limit)
+
+	var r0 []domain.WebhookDeliveryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, int) ([]domain.WebhookDeliveryResponse, error)); ok {
+		return rf(webhookId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, int) []domain.WebhookDeliveryResponse); ok {
+		r0 = rf(webhookId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.WebhookDeliveryResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, int) error); ok {
+		r1 = rf(webhookId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWebhookById provides a mock function with given fields: id
+func (_m *WebhookUsecase) GetWebhookById(id uint) (domain.WebhookResponse, error) {
+	ret := _m.Called(id)
+
+	var r0 domain.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (domain.WebhookResponse, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) domain.WebhookResponse); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Redeliver provides a mock function with given fields: webhookId, deliveryId
+func (_m *WebhookUsecase) Redeliver(webhookId uint, deliveryId uint64) (domain.WebhookDeliveryResponse, error) {
+	ret := _m.Called(webhookId, deliveryId)
+
+	var r0 domain.WebhookDeliveryResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint64) (domain.WebhookDeliveryResponse, error)); ok {
+		return rf(webhookId, deliveryId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint64) domain.WebhookDeliveryResponse); ok {
+		r0 = rf(webhookId, deliveryId)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookDeliveryResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint64) error); ok {
+		r1 = rf(webhookId, deliveryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWebhook provides a mock function with given fields: id, req
+func (_m *WebhookUsecase) UpdateWebhook(id uint, req domain.WebhookRequest) (domain.WebhookResponse, error) {
+	ret := _m.Called(id, req)
+
+	var r0 domain.WebhookResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, domain.WebhookRequest) (domain.WebhookResponse, error)); ok {
+		return rf(id, req)
+	}
+	if rf, ok := ret.Get(0).(func(uint, domain.WebhookRequest) domain.WebhookResponse); ok {
+		r0 = rf(id, req)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, domain.WebhookRequest) error); ok {
+		r1 = rf(id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWebhookUsecase creates a new instance of WebhookUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookUsecase {
+	mock := &WebhookUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
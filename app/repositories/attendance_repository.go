@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type AttendanceRepository interface {
+	Store(attendance *domain.Attendance) error
+	ClockOut(attendance *domain.Attendance) error
+	FindOpenByEmployeeId(employeeId uint) ([]domain.Attendance, error)
+	FindByEmployeeIdBetween(employeeId uint, from, to time.Time) ([]domain.Attendance, error)
+}
+
+type attendanceRepository struct {
+	db *gorm.DB
+}
+
+func NewAttendanceRepository(db *gorm.DB) AttendanceRepository {
+	return &attendanceRepository{
+		db: db,
+	}
+}
+
+func (r *attendanceRepository) Store(attendance *domain.Attendance) error {
+	if attendance == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(attendance).Error
+}
+
+// ClockOut closes the session, it fails when the session was already closed.
+func (r *attendanceRepository) ClockOut(attendance *domain.Attendance) error {
+	if attendance == nil {
+		return ErrNilReference
+	}
+
+	tx := r.db.Model(&domain.Attendance{}).
+		Where("id = ? AND clock_out IS NULL", attendance.ID).
+		Updates(map[string]interface{}{
+			"clock_out":           attendance.ClockOut,
+			"clock_out_latitude":  attendance.ClockOutLatitude,
+			"clock_out_longitude": attendance.ClockOutLongitude,
+			"notes":               attendance.Notes,
+		})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *attendanceRepository) FindOpenByEmployeeId(employeeId uint) ([]domain.Attendance, error) {
+	var attendances []domain.Attendance
+	tx := r.db.Where("employee_id = ? AND clock_out IS NULL", employeeId).Order("clock_in ASC").Find(&attendances)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return attendances, nil
+}
+
+// FindByEmployeeIdBetween returns the sessions with a work date from from to
+// to, both inclusive.
+func (r *attendanceRepository) FindByEmployeeIdBetween(employeeId uint, from, to time.Time) ([]domain.Attendance, error) {
+	var attendances []domain.Attendance
+	tx := r.db.Where("employee_id = ? AND work_date BETWEEN ? AND ?", employeeId, from, to).
+		Order("clock_in ASC").
+		Find(&attendances)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return attendances, nil
+}
@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CalendarRepository interface {
+	Store(calendar *domain.WorkCalendar) error
+	FindAll() ([]domain.WorkCalendar, error)
+	FindById(id uint) (domain.WorkCalendar, error)
+	FindByName(name string) (domain.WorkCalendar, error)
+	StoreHolidays(holidays []domain.Holiday) error
+}
+
+type calendarRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarRepository(db *gorm.DB) CalendarRepository {
+	return &calendarRepository{
+		db: db,
+	}
+}
+
+func (r *calendarRepository) Store(calendar *domain.WorkCalendar) error {
+	if calendar == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(calendar).Error
+}
+
+func (r *calendarRepository) FindAll() ([]domain.WorkCalendar, error) {
+	var calendars []domain.WorkCalendar
+	tx := r.db.Order("id ASC").Find(&calendars)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return calendars, nil
+}
+
+// FindById loads the calendar with its holidays ordered by date.
+func (r *calendarRepository) FindById(id uint) (domain.WorkCalendar, error) {
+	var calendar domain.WorkCalendar
+
+	tx := r.db.Preload("Holidays", func(db *gorm.DB) *gorm.DB {
+		return db.Order("date ASC")
+	}).Where("id", id).First(&calendar)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.WorkCalendar{}, ErrRecordNotFound
+		}
+
+		return domain.WorkCalendar{}, tx.Error
+	}
+
+	return calendar, nil
+}
+
+func (r *calendarRepository) FindByName(name string) (domain.WorkCalendar, error) {
+	var calendar domain.WorkCalendar
+
+	tx := r.db.Where("LOWER(name) = LOWER(?)", name).First(&calendar)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.WorkCalendar{}, ErrRecordNotFound
+		}
+
+		return domain.WorkCalendar{}, tx.Error
+	}
+
+	return calendar, nil
+}
+
+// StoreHolidays inserts the holidays, a holiday on a date the calendar
+// already has replaces its name, so importing the same file twice is safe.
+func (r *calendarRepository) StoreHolidays(holidays []domain.Holiday) error {
+	if len(holidays) == 0 {
+		return nil
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "calendar_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name"}),
+	}).Create(&holidays).Error
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type ChecklistRepository interface {
+	StoreItem(item *domain.ChecklistItem) error
+	FindItems(kind string) ([]domain.ChecklistItem, error)
+	DeleteItem(id uint) error
+	FindTasks(filter domain.TaskFilter) ([]domain.EmployeeTask, error)
+	FindTaskById(id uint) (domain.EmployeeTask, error)
+	UpdateTask(task *domain.EmployeeTask) error
+}
+
+type checklistRepository struct {
+	db *gorm.DB
+}
+
+func NewChecklistRepository(db *gorm.DB) ChecklistRepository {
+	return &checklistRepository{
+		db: db,
+	}
+}
+
+func (r *checklistRepository) StoreItem(item *domain.ChecklistItem) error {
+	if item == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(item).Error
+}
+
+func (r *checklistRepository) FindItems(kind string) ([]domain.ChecklistItem, error) {
+	query := r.db.Model(&domain.ChecklistItem{})
+	if kind != "" {
+		query = query.Where("kind", kind)
+	}
+
+	var items []domain.ChecklistItem
+	tx := query.Order("kind ASC, due_days ASC, id ASC").Find(&items)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return items, nil
+}
+
+func (r *checklistRepository) DeleteItem(id uint) error {
+	tx := r.db.Where("id", id).Delete(&domain.ChecklistItem{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *checklistRepository) FindTasks(filter domain.TaskFilter) ([]domain.EmployeeTask, error) {
+	query := r.db.Model(&domain.EmployeeTask{})
+	if filter.EmployeeId != 0 {
+		query = query.Where("employee_id", filter.EmployeeId)
+	}
+
+	if filter.Kind != "" {
+		query = query.Where("kind", filter.Kind)
+	}
+
+	if filter.OpenOnly {
+		query = query.Where("completed_at IS NULL")
+	}
+
+	var tasks []domain.EmployeeTask
+	tx := query.Order("due_date ASC, id ASC").Find(&tasks)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return tasks, nil
+}
+
+func (r *checklistRepository) FindTaskById(id uint) (domain.EmployeeTask, error) {
+	var task domain.EmployeeTask
+
+	tx := r.db.Where("id", id).First(&task)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.EmployeeTask{}, ErrRecordNotFound
+		}
+
+		return domain.EmployeeTask{}, tx.Error
+	}
+
+	return task, nil
+}
+
+func (r *checklistRepository) UpdateTask(task *domain.EmployeeTask) error {
+	if task == nil {
+		return ErrNilReference
+	}
+
+	tx := r.db.Model(task).
+		Select("assignee_id", "completed_at", "completed_by_id").
+		Updates(task)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type CompensationRepository interface {
+	Store(compensation *domain.Compensation) error
+	FindByEmployeeId(employeeId uint) ([]domain.Compensation, error)
+}
+
+type compensationRepository struct {
+	db *gorm.DB
+}
+
+func NewCompensationRepository(db *gorm.DB) CompensationRepository {
+	return &compensationRepository{
+		db: db,
+	}
+}
+
+func (r *compensationRepository) Store(compensation *domain.Compensation) error {
+	if compensation == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(compensation).Error
+}
+
+func (r *compensationRepository) FindByEmployeeId(employeeId uint) ([]domain.Compensation, error) {
+	var compensations []domain.Compensation
+	tx := r.db.Where("employee_id", employeeId).Order("effective_date ASC").Find(&compensations)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return compensations, nil
+}
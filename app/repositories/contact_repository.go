@@ -0,0 +1,171 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type ContactRepository interface {
+	FindPhones(employeeId uint) ([]domain.Phone, error)
+	StorePhone(phone *domain.Phone) error
+	UpdatePhone(phone *domain.Phone) error
+	DeletePhone(employeeId, id uint) error
+	FindAddresses(employeeId uint) ([]domain.Address, error)
+	FindAddressByType(employeeId uint, addressType string) (domain.Address, error)
+	StoreAddress(address *domain.Address) error
+	UpdateAddress(address *domain.Address) error
+	DeleteAddress(employeeId, id uint) error
+	FindEmergencyContacts(employeeId uint) ([]domain.EmergencyContact, error)
+	StoreEmergencyContact(contact *domain.EmergencyContact) error
+	UpdateEmergencyContact(contact *domain.EmergencyContact) error
+	DeleteEmergencyContact(employeeId, id uint) error
+}
+
+type contactRepository struct {
+	db *gorm.DB
+}
+
+func NewContactRepository(db *gorm.DB) ContactRepository {
+	return &contactRepository{
+		db: db,
+	}
+}
+
+func (r *contactRepository) FindPhones(employeeId uint) ([]domain.Phone, error) {
+	var phones []domain.Phone
+	tx := r.db.Where("employee_id", employeeId).Order("id ASC").Find(&phones)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return phones, nil
+}
+
+func (r *contactRepository) StorePhone(phone *domain.Phone) error {
+	if phone == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(phone).Error
+}
+
+func (r *contactRepository) UpdatePhone(phone *domain.Phone) error {
+	if phone == nil {
+		return ErrNilReference
+	}
+
+	return r.updateOwned(phone, phone.ID, phone.EmployeeID, "type", "number")
+}
+
+func (r *contactRepository) DeletePhone(employeeId, id uint) error {
+	return r.deleteOwned(&domain.Phone{}, employeeId, id)
+}
+
+func (r *contactRepository) FindAddresses(employeeId uint) ([]domain.Address, error) {
+	var addresses []domain.Address
+	tx := r.db.Where("employee_id", employeeId).Order("type ASC").Find(&addresses)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return addresses, nil
+}
+
+func (r *contactRepository) FindAddressByType(employeeId uint, addressType string) (domain.Address, error) {
+	var address domain.Address
+
+	tx := r.db.Where("employee_id = ? AND type = ?", employeeId, addressType).First(&address)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Address{}, ErrRecordNotFound
+		}
+
+		return domain.Address{}, tx.Error
+	}
+
+	return address, nil
+}
+
+func (r *contactRepository) StoreAddress(address *domain.Address) error {
+	if address == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(address).Error
+}
+
+func (r *contactRepository) UpdateAddress(address *domain.Address) error {
+	if address == nil {
+		return ErrNilReference
+	}
+
+	return r.updateOwned(address, address.ID, address.EmployeeID,
+		"type", "line1", "line2", "city", "region", "postal_code", "country")
+}
+
+func (r *contactRepository) DeleteAddress(employeeId, id uint) error {
+	return r.deleteOwned(&domain.Address{}, employeeId, id)
+}
+
+func (r *contactRepository) FindEmergencyContacts(employeeId uint) ([]domain.EmergencyContact, error) {
+	var contacts []domain.EmergencyContact
+	tx := r.db.Where("employee_id", employeeId).Order("id ASC").Find(&contacts)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return contacts, nil
+}
+
+func (r *contactRepository) StoreEmergencyContact(contact *domain.EmergencyContact) error {
+	if contact == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(contact).Error
+}
+
+func (r *contactRepository) UpdateEmergencyContact(contact *domain.EmergencyContact) error {
+	if contact == nil {
+		return ErrNilReference
+	}
+
+	return r.updateOwned(contact, contact.ID, contact.EmployeeID, "name", "relationship", "phone", "email")
+}
+
+func (r *contactRepository) DeleteEmergencyContact(employeeId, id uint) error {
+	return r.deleteOwned(&domain.EmergencyContact{}, employeeId, id)
+}
+
+// updateOwned only updates the row when it belongs to the employee.
+func (r *contactRepository) updateOwned(model interface{}, id, employeeId uint, columns ...string) error {
+	tx := r.db.Model(model).
+		Where("id = ? AND employee_id = ?", id, employeeId).
+		Select(columns).
+		Updates(model)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *contactRepository) deleteOwned(model interface{}, employeeId, id uint) error {
+	tx := r.db.Where("id = ? AND employee_id = ?", id, employeeId).Delete(model)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type CustomFieldRepository interface {
+	Store(field *domain.CustomField) error
+	FindAll() ([]domain.CustomField, error)
+	FindByName(name string) (domain.CustomField, error)
+	DeleteById(id uint) error
+}
+
+type customFieldRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomFieldRepository(db *gorm.DB) CustomFieldRepository {
+	return &customFieldRepository{
+		db: db,
+	}
+}
+
+func (r *customFieldRepository) Store(field *domain.CustomField) error {
+	if field == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(field).Error
+}
+
+func (r *customFieldRepository) FindAll() ([]domain.CustomField, error) {
+	var fields []domain.CustomField
+	tx := r.db.Order("name ASC").Find(&fields)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return fields, nil
+}
+
+func (r *customFieldRepository) FindByName(name string) (domain.CustomField, error) {
+	var field domain.CustomField
+
+	tx := r.db.Where("name", name).First(&field)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.CustomField{}, ErrRecordNotFound
+		}
+
+		return domain.CustomField{}, tx.Error
+	}
+
+	return field, nil
+}
+
+// DeleteById also removes the values of the field from every employee.
+func (r *customFieldRepository) DeleteById(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var field domain.CustomField
+		if err := tx.Where("id", id).First(&field).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrRecordNotFound
+			}
+
+			return err
+		}
+
+		if err := tx.Delete(&field).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.Employee{}).Unscoped().
+			Where("custom_fields -> ? IS NOT NULL", field.Name).
+			UpdateColumn("custom_fields", gorm.Expr("custom_fields - ?", field.Name)).Error
+	})
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type DocumentRepository interface {
+	Store(document *domain.Document) error
+	FindByEmployeeId(employeeId uint) ([]domain.Document, error)
+	FindById(employeeId, id uint) (domain.Document, error)
+	DeleteById(employeeId, id uint) error
+}
+
+type documentRepository struct {
+	db *gorm.DB
+}
+
+func NewDocumentRepository(db *gorm.DB) DocumentRepository {
+	return &documentRepository{
+		db: db,
+	}
+}
+
+func (r *documentRepository) Store(document *domain.Document) error {
+	if document == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(document).Error
+}
+
+func (r *documentRepository) FindByEmployeeId(employeeId uint) ([]domain.Document, error) {
+	var documents []domain.Document
+	tx := r.db.Where("employee_id", employeeId).Order("created_at DESC").Find(&documents)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return documents, nil
+}
+
+func (r *documentRepository) FindById(employeeId, id uint) (domain.Document, error) {
+	var document domain.Document
+
+	tx := r.db.Where("id = ? AND employee_id = ?", id, employeeId).First(&document)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Document{}, ErrRecordNotFound
+		}
+
+		return domain.Document{}, tx.Error
+	}
+
+	return document, nil
+}
+
+func (r *documentRepository) DeleteById(employeeId, id uint) error {
+	tx := r.db.Where("id = ? AND employee_id = ?", id, employeeId).Delete(&domain.Document{})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -1,26 +1,45 @@
 package repositories
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/pkg/search"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EmployeeRepository interface {
-	Store(employee *domain.Employee) error
-	FindAll(limit, offset int, orderBy, sort string) ([]domain.Employee, int64, error)
+	Store(employee *domain.Employee, tasks []domain.EmployeeTask) error
+	FindAll(limit, offset int, orderBy, sort string, filter domain.EmployeeFilter) ([]domain.Employee, int64, error)
 	FindById(id uint) (domain.Employee, error)
+	FindByIds(ids []uint) ([]domain.Employee, error)
+	FindByManagerIds(managerIds []uint) ([]domain.Employee, error)
 	FindByEmail(email string) (domain.Employee, error)
+	Search(terms []string, limit int) ([]domain.EmployeeSearchMatch, error)
 	UpdateById(employee *domain.Employee) error
-	DeleteById(id uint) error
+	UpdateEmploymentStatus(employee *domain.Employee, tasks []domain.EmployeeTask) error
+	UpdateManager(id uint, managerId *uint) error
+	UpdateCalendar(id uint, calendarId *uint) error
+	UpdatePhotoVersion(id uint, version string) error
+	DeleteById(id uint, tasks []domain.EmployeeTask) error
 }
 
 type employeeRepository struct {
 	db *gorm.DB
+
+	// index serves the search on databases other than Postgres. It is loaded
+	// on the first search and then follows the writes of this repository.
+	index       *search.Index
+	indexMu     sync.Mutex
+	indexLoaded bool
 }
 
 var (
@@ -29,35 +48,146 @@ var (
 )
 
 func NewEmployeeRepository(db *gorm.DB) EmployeeRepository {
-	return &employeeRepository{
+	r := &employeeRepository{
 		db: db,
 	}
+
+	if db.Dialector.Name() != "postgres" {
+		r.index = search.NewIndex()
+	}
+
+	return r
 }
 
-func (r *employeeRepository) Store(employee *domain.Employee) error {
+// Store creates the employee together with its checklist tasks.
+func (r *employeeRepository) Store(employee *domain.Employee, tasks []domain.EmployeeTask) error {
 	if employee == nil {
 		return ErrNilReference
 	}
 
-	created := r.db.Create(employee)
-	if created.Error != nil {
-		return created.Error
+	return r.writeWithEvent(domain.EventEmployeeCreated, func(tx *gorm.DB) (uint, error) {
+		if err := tx.Create(employee).Error; err != nil {
+			return 0, err
+		}
+
+		return employee.ID, storeTasks(tx, employee.ID, tasks)
+	})
+}
+
+// storeTasks creates the checklist tasks for the employee with id.
+func storeTasks(tx *gorm.DB, id uint, tasks []domain.EmployeeTask) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	for i := range tasks {
+		tasks[i].EmployeeID = id
 	}
 
+	return tx.Create(&tasks).Error
+}
+
+// writeWithEvent runs write and records an eventType event for the employee
+// whose id it returns in the same transaction. The payload is read back
+// after the write so it holds the stored state, including soft deleted rows.
+func (r *employeeRepository) writeWithEvent(eventType string, write func(tx *gorm.DB) (uint, error)) error {
+	var employee domain.Employee
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		id, err := write(tx)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Scopes(preloadCurrentJob).Where("id", id).First(&employee).Error; err != nil {
+			return err
+		}
+
+		event, err := domain.NewEmployeeEvent(eventType, employee)
+		if err != nil {
+			return err
+		}
+
+		event.NextAttemptAt = time.Now()
+		return tx.Create(&event).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	r.updateIndex(employee)
 	return nil
 }
 
-func (r *employeeRepository) FindAll(limit, offset int, orderBy, sort string) ([]domain.Employee, int64, error) {
+// updateWithEvent records an updated event when query changes the employee.
+func (r *employeeRepository) updateWithEvent(id uint, query func(tx *gorm.DB) *gorm.DB) error {
+	return r.writeWithEvent(domain.EventEmployeeUpdated, func(tx *gorm.DB) (uint, error) {
+		updated := query(tx)
+		if updated.Error != nil {
+			return 0, updated.Error
+		}
+
+		if updated.RowsAffected == 0 {
+			return 0, ErrRecordNotFound
+		}
+
+		return id, nil
+	})
+}
+
+// preloadCurrentJob loads the job assignment that covers today into Jobs.
+func preloadCurrentJob(db *gorm.DB) *gorm.DB {
+	today := utils.Today().Format(utils.ISODate)
+	return db.Preload("Jobs", "start_date <= ? AND (end_date IS NULL OR end_date >= ?)", today, today)
+}
+
+func (r *employeeRepository) filterQuery(filter domain.EmployeeFilter) *gorm.DB {
+	query := r.db.Model(&domain.Employee{})
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+
+	if len(filter.CustomFields) > 0 {
+		values, _ := json.Marshal(filter.CustomFields)
+		query = query.Where("custom_fields @> ?::jsonb", string(values))
+	}
+
+	return query
+}
+
+// orderClause sorts custom fields by their JSONB value so numbers compare as
+// numbers, employees without a value come last. First names sort by the
+// preferred name when there is one.
+func orderClause(orderBy, sort string) clause.OrderBy {
+	if orderBy == "first_name" {
+		return clause.OrderBy{Expression: clause.Expr{
+			SQL: fmt.Sprintf("coalesce(nullif(preferred_name, ''), first_name) %s", sort),
+		}}
+	}
+
+	if strings.HasPrefix(orderBy, domain.CustomFieldOrderPrefix) {
+		name := strings.TrimPrefix(orderBy, domain.CustomFieldOrderPrefix)
+		return clause.OrderBy{Expression: clause.Expr{
+			SQL:  fmt.Sprintf("custom_fields -> ? %s NULLS LAST", sort),
+			Vars: []interface{}{name},
+		}}
+	}
+
+	return clause.OrderBy{Columns: []clause.OrderByColumn{{
+		Column: clause.Column{Name: fmt.Sprintf("%s %s", orderBy, sort), Raw: true},
+	}}}
+}
+
+func (r *employeeRepository) FindAll(limit, offset int, orderBy, sort string, filter domain.EmployeeFilter) ([]domain.Employee, int64, error) {
 	var count int64
-	err := r.db.Model(&domain.Employee{}).Count(&count).Error
+	err := r.filterQuery(filter).Count(&count).Error
 	if err != nil {
 		return nil, -1, err
 	}
 
-	queryOrder := fmt.Sprintf("%s %s", orderBy, sort)
+	queryOrder := orderClause(orderBy, sort)
 
 	var employees []domain.Employee
-	tx := r.db.Order(queryOrder).Limit(limit).Offset(offset).Find(&employees)
+	tx := r.filterQuery(filter).Scopes(preloadCurrentJob).Clauses(queryOrder).Limit(limit).Offset(offset).Find(&employees)
 	if tx.Error != nil {
 		return nil, -1, tx.Error
 	}
@@ -65,10 +195,170 @@ func (r *employeeRepository) FindAll(limit, offset int, orderBy, sort string) ([
 	return employees, count, nil
 }
 
+// searchSimilarityThreshold is lower than the pg_trgm default of 0.6 so a
+// single typo in a short name still matches.
+const searchSimilarityThreshold = 0.3
+
+// Search ranks employees matching every term as a prefix, or the whole query
+// approximately, by full-text rank plus trigram word similarity.
+func (r *employeeRepository) Search(terms []string, limit int) ([]domain.EmployeeSearchMatch, error) {
+	if r.index != nil {
+		return r.searchIndex(terms, limit)
+	}
+
+	prefixes := make([]string, 0, len(terms))
+	for _, term := range terms {
+		prefixes = append(prefixes, term+":*")
+	}
+
+	tsQuery := strings.Join(prefixes, " & ")
+	text := strings.Join(terms, " ")
+
+	var matches []domain.EmployeeSearchMatch
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(fmt.Sprintf("SET LOCAL pg_trgm.word_similarity_threshold = %v", searchSimilarityThreshold)).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&domain.Employee{}).
+			Select(
+				"id, first_name, last_name, preferred_name, name_locale, email, status, photo_version, "+
+					"ts_headline('simple', concat_ws(' ', first_name, nullif(preferred_name, ''), last_name), to_tsquery('simple', @query), @options) AS name_highlight, "+
+					"ts_headline('simple', email, to_tsquery('simple', @query), @options) AS email_highlight, "+
+					"ts_rank("+domain.EmployeeSearchDocument+", to_tsquery('simple', @query)) + word_similarity(@text, "+domain.EmployeeSearchText+") AS score",
+				map[string]interface{}{
+					"query":   tsQuery,
+					"text":    text,
+					"options": "StartSel=<mark>, StopSel=</mark>, HighlightAll=true",
+				},
+			).
+			Where(domain.EmployeeSearchDocument+" @@ to_tsquery('simple', ?) OR ? <% "+domain.EmployeeSearchText, tsQuery, text).
+			Order("score DESC, id ASC").
+			Limit(limit).
+			Scan(&matches).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
+
+// searchIndex runs the search against the in-process index and reads the
+// matches back in rank order.
+func (r *employeeRepository) searchIndex(terms []string, limit int) ([]domain.EmployeeSearchMatch, error) {
+	if err := r.loadIndex(); err != nil {
+		return nil, err
+	}
+
+	found := r.index.Search(terms, limit)
+	if len(found) == 0 {
+		return []domain.EmployeeSearchMatch{}, nil
+	}
+
+	ids := make([]uint, 0, len(found))
+	for _, m := range found {
+		ids = append(ids, m.ID)
+	}
+
+	var employees []domain.Employee
+	if err := r.db.Where("id IN ?", ids).Find(&employees).Error; err != nil {
+		return nil, err
+	}
+
+	byId := make(map[uint]domain.Employee, len(employees))
+	for _, e := range employees {
+		byId[e.ID] = e
+	}
+
+	matches := make([]domain.EmployeeSearchMatch, 0, len(found))
+	for _, m := range found {
+		e, ok := byId[m.ID]
+		if !ok {
+			continue
+		}
+
+		matches = append(matches, domain.EmployeeSearchMatch{
+			ID:             e.ID,
+			FirstName:      e.FirstName,
+			LastName:       e.LastName,
+			PreferredName:  e.PreferredName,
+			NameLocale:     e.NameLocale,
+			Email:          e.Email,
+			Status:         e.Status,
+			PhotoVersion:   e.PhotoVersion,
+			NameHighlight:  m.Highlights[0],
+			EmailHighlight: m.Highlights[1],
+			Score:          m.Score,
+		})
+	}
+
+	return matches, nil
+}
+
+// loadIndex fills the index from the database once, a failed load is retried
+// by the next search.
+func (r *employeeRepository) loadIndex() error {
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	if r.indexLoaded {
+		return nil
+	}
+
+	var employees []domain.Employee
+	err := r.db.Select("id", "first_name", "preferred_name", "last_name", "email").
+		FindInBatches(&employees, 1000, func(tx *gorm.DB, batch int) error {
+			for _, e := range employees {
+				putIndex(r.index, e)
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return err
+	}
+
+	r.indexLoaded = true
+	return nil
+}
+
+// updateIndex applies a committed write to the index. Writes before the first
+// search are skipped, the load reads them from the database.
+func (r *employeeRepository) updateIndex(employee domain.Employee) {
+	if r.index == nil {
+		return
+	}
+
+	r.indexMu.Lock()
+	defer r.indexMu.Unlock()
+
+	if !r.indexLoaded {
+		return
+	}
+
+	if employee.DeletedAt != nil && employee.DeletedAt.Valid {
+		r.index.Delete(employee.ID)
+		return
+	}
+
+	putIndex(r.index, employee)
+}
+
+// putIndex indexes the name the way the Postgres search highlights it, first,
+// preferred and last name, and the email.
+func putIndex(index *search.Index, e domain.Employee) {
+	name := []string{e.FirstName}
+	if e.PreferredName != "" {
+		name = append(name, e.PreferredName)
+	}
+
+	index.Put(e.ID, strings.Join(append(name, e.LastName), " "), e.Email)
+}
+
 func (r *employeeRepository) FindById(id uint) (domain.Employee, error) {
 	var employee domain.Employee
 
-	tx := r.db.Where("id", id).First(&employee)
+	tx := r.db.Scopes(preloadCurrentJob).Where("id", id).First(&employee)
 	if tx.Error != nil {
 		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
 			return domain.Employee{}, ErrRecordNotFound
@@ -80,6 +370,30 @@ func (r *employeeRepository) FindById(id uint) (domain.Employee, error) {
 	return employee, nil
 }
 
+// FindByIds returns the employees with the given ids, ids without an
+// employee are left out.
+func (r *employeeRepository) FindByIds(ids []uint) ([]domain.Employee, error) {
+	var employees []domain.Employee
+	tx := r.db.Scopes(preloadCurrentJob).Where("id IN ?", ids).Order("id ASC").Find(&employees)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return employees, nil
+}
+
+// FindByManagerIds returns the employees reporting directly to any of the
+// managers.
+func (r *employeeRepository) FindByManagerIds(managerIds []uint) ([]domain.Employee, error) {
+	var employees []domain.Employee
+	tx := r.db.Scopes(preloadCurrentJob).Where("manager_id IN ?", managerIds).Order("id ASC").Find(&employees)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return employees, nil
+}
+
 func (r *employeeRepository) FindByEmail(email string) (domain.Employee, error) {
 	var employee domain.Employee
 
@@ -104,33 +418,74 @@ func (r *employeeRepository) UpdateById(employee *domain.Employee) error {
 		return ErrNilReference
 	}
 
-	tx := r.db.Updates(employee)
-	if tx.Error != nil {
-		return tx.Error
+	// Updates skips zero values, the columns are listed so names and
+	// preferences can be cleared. Custom fields are only written when set.
+	columns := []string{"first_name", "last_name", "preferred_name", "pronouns", "name_locale", "email", "hire_date"}
+	if employee.CustomFields != nil {
+		columns = append(columns, "custom_fields")
 	}
 
-	if tx.RowsAffected == 0 {
-		return ErrRecordNotFound
+	return r.updateWithEvent(employee.ID, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(employee).Select(columns).Updates(employee)
+	})
+}
+
+// UpdateEmploymentStatus stores the status change together with the
+// checklist tasks it starts.
+func (r *employeeRepository) UpdateEmploymentStatus(employee *domain.Employee, tasks []domain.EmployeeTask) error {
+	if employee == nil {
+		return ErrNilReference
 	}
 
-	return nil
+	return r.updateWithEvent(employee.ID, func(tx *gorm.DB) *gorm.DB {
+		updated := tx.Model(employee).
+			Select("status", "hire_date", "termination_date", "termination_reason").
+			Updates(employee)
+		if updated.Error == nil && updated.RowsAffected > 0 {
+			updated.Error = storeTasks(tx, employee.ID, tasks)
+		}
+
+		return updated
+	})
 }
 
-func (r *employeeRepository) DeleteById(id uint) error {
-	var employee domain.Employee
+func (r *employeeRepository) UpdateManager(id uint, managerId *uint) error {
+	return r.updateWithEvent(id, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&domain.Employee{}).Where("id", id).Update("manager_id", managerId)
+	})
+}
 
-	now := time.Now()
-	tx := r.db.Model(&employee).Where("id", id).Updates(map[string]interface{}{
-		"deleted_at": now,
+func (r *employeeRepository) UpdateCalendar(id uint, calendarId *uint) error {
+	return r.updateWithEvent(id, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&domain.Employee{}).Where("id", id).Update("calendar_id", calendarId)
 	})
+}
 
-	if tx.Error != nil {
-		return tx.Error
-	}
+func (r *employeeRepository) UpdatePhotoVersion(id uint, version string) error {
+	return r.updateWithEvent(id, func(tx *gorm.DB) *gorm.DB {
+		return tx.Model(&domain.Employee{}).Where("id", id).Update("photo_version", version)
+	})
+}
 
-	if tx.RowsAffected == 0 {
-		return ErrRecordNotFound
-	}
+// DeleteById soft deletes the employee together with the checklist tasks
+// the deletion starts.
+func (r *employeeRepository) DeleteById(id uint, tasks []domain.EmployeeTask) error {
+	return r.writeWithEvent(domain.EventEmployeeDeleted, func(tx *gorm.DB) (uint, error) {
+		var employee domain.Employee
 
-	return nil
+		now := time.Now()
+		deleted := tx.Model(&employee).Where("id", id).Updates(map[string]interface{}{
+			"deleted_at": now,
+		})
+
+		if deleted.Error != nil {
+			return 0, deleted.Error
+		}
+
+		if deleted.RowsAffected == 0 {
+			return 0, ErrRecordNotFound
+		}
+
+		return id, storeTasks(tx, id, tasks)
+	})
 }
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type JobAssignmentRepository interface {
+	FindByEmployeeId(employeeId uint) ([]domain.JobAssignment, error)
+	Assign(assignment *domain.JobAssignment) error
+}
+
+type jobAssignmentRepository struct {
+	db *gorm.DB
+}
+
+func NewJobAssignmentRepository(db *gorm.DB) JobAssignmentRepository {
+	return &jobAssignmentRepository{
+		db: db,
+	}
+}
+
+func (r *jobAssignmentRepository) FindByEmployeeId(employeeId uint) ([]domain.JobAssignment, error) {
+	var assignments []domain.JobAssignment
+	tx := r.db.Where("employee_id", employeeId).Order("start_date ASC").Find(&assignments)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return assignments, nil
+}
+
+// Assign ends the open assignment of the employee the day before the new one
+// starts and stores the new assignment, both in one transaction.
+func (r *jobAssignmentRepository) Assign(assignment *domain.JobAssignment) error {
+	if assignment == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		previousEnd := assignment.StartDate.AddDate(0, 0, -1)
+		err := tx.Model(&domain.JobAssignment{}).
+			Where("employee_id = ? AND end_date IS NULL", assignment.EmployeeID).
+			Update("end_date", previousEnd).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(assignment).Error
+	})
+}
@@ -0,0 +1,187 @@
+package repositories
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LeaveRepository interface {
+	Store(leave *domain.Leave) error
+	FindById(id uint) (domain.Leave, error)
+	FindByEmployeeId(employeeId uint) ([]domain.Leave, error)
+	FindBalance(employeeId, leaveTypeId uint, year int) (domain.LeaveBalance, error)
+	StoreBalance(balance *domain.LeaveBalance) error
+	Approve(leave *domain.Leave) error
+	Reject(leave *domain.Leave) error
+}
+
+type leaveRepository struct {
+	db *gorm.DB
+}
+
+var (
+	ErrLeaveAlreadyDecided = errors.New("leave request was already decided")
+	ErrInsufficientBalance = errors.New("leave balance does not cover the leave")
+)
+
+func NewLeaveRepository(db *gorm.DB) LeaveRepository {
+	return &leaveRepository{
+		db: db,
+	}
+}
+
+func (r *leaveRepository) Store(leave *domain.Leave) error {
+	if leave == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(leave).Error
+}
+
+func (r *leaveRepository) FindById(id uint) (domain.Leave, error) {
+	var leave domain.Leave
+
+	tx := r.db.Where("id", id).First(&leave)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Leave{}, ErrRecordNotFound
+		}
+
+		return domain.Leave{}, tx.Error
+	}
+
+	return leave, nil
+}
+
+func (r *leaveRepository) FindByEmployeeId(employeeId uint) ([]domain.Leave, error) {
+	var leaves []domain.Leave
+	tx := r.db.Where("employee_id", employeeId).Order("start_date ASC").Find(&leaves)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return leaves, nil
+}
+
+func (r *leaveRepository) FindBalance(employeeId, leaveTypeId uint, year int) (domain.LeaveBalance, error) {
+	var balance domain.LeaveBalance
+
+	tx := r.db.Where("employee_id = ? AND leave_type_id = ? AND year = ?", employeeId, leaveTypeId, year).First(&balance)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.LeaveBalance{}, ErrRecordNotFound
+		}
+
+		return domain.LeaveBalance{}, tx.Error
+	}
+
+	return balance, nil
+}
+
+// StoreBalance does nothing when the employee already has a balance of the
+// leave type for the year, a request accruing it at the same time won.
+func (r *leaveRepository) StoreBalance(balance *domain.LeaveBalance) error {
+	if balance == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "employee_id"}, {Name: "leave_type_id"}, {Name: "year"}},
+		DoNothing: true,
+	}).Create(balance).Error
+}
+
+// Approve marks a pending leave as approved and deducts its days from the
+// balance of the year it starts in, both in one transaction. The balance is
+// only deducted while it covers the leave, so approvals running at the same
+// time cannot overdraw it. The days carried over into the following years
+// shrink with it.
+func (r *leaveRepository) Approve(leave *domain.Leave) error {
+	if leave == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := decide(tx, leave, domain.LeaveStatusApproved); err != nil {
+			return err
+		}
+
+		year := leave.StartDate.Year()
+		updated := tx.Model(&domain.LeaveBalance{}).
+			Where("employee_id = ? AND leave_type_id = ? AND year = ? AND entitled + carried_over - used >= ?", leave.EmployeeID, leave.LeaveTypeID, year, leave.Days).
+			Update("used", gorm.Expr("used + ?", leave.Days))
+		if updated.Error != nil {
+			return updated.Error
+		}
+
+		if updated.RowsAffected == 0 {
+			return ErrInsufficientBalance
+		}
+
+		return carryOver(tx, leave.EmployeeID, leave.LeaveTypeID, year+1)
+	})
+}
+
+// carryOverQuery sets the days carried over into a year from the days left in
+// the year before, up to the limit of the leave type.
+const carryOverQuery = `UPDATE leave_balances AS b
+SET carried_over = LEAST(t.carry_over_days, GREATEST(0, p.entitled + p.carried_over - p.used)), updated_at = @now
+FROM leave_balances AS p, leave_types AS t
+WHERE b.employee_id = @employee AND b.leave_type_id = @leaveType AND b.year = @year
+AND p.employee_id = b.employee_id AND p.leave_type_id = b.leave_type_id AND p.year = b.year - 1
+AND t.id = b.leave_type_id`
+
+// carryOver updates the balances from year on until a year has none.
+func carryOver(tx *gorm.DB, employeeId, leaveTypeId uint, year int) error {
+	for ; ; year++ {
+		updated := tx.Exec(carryOverQuery, map[string]interface{}{
+			"employee":  employeeId,
+			"leaveType": leaveTypeId,
+			"year":      year,
+			"now":       time.Now(),
+		})
+		if updated.Error != nil {
+			return updated.Error
+		}
+
+		if updated.RowsAffected == 0 {
+			return nil
+		}
+	}
+}
+
+func (r *leaveRepository) Reject(leave *domain.Leave) error {
+	if leave == nil {
+		return ErrNilReference
+	}
+
+	return decide(r.db, leave, domain.LeaveStatusRejected)
+}
+
+// decide only updates leave requests that are still pending, so two managers
+// deciding at the same time cannot both succeed.
+func decide(db *gorm.DB, leave *domain.Leave, status string) error {
+	tx := db.Model(&domain.Leave{}).
+		Where("id = ? AND status = ?", leave.ID, domain.LeaveStatusPending).
+		Updates(map[string]interface{}{
+			"status":        status,
+			"approver_id":   leave.ApproverID,
+			"decision_note": leave.DecisionNote,
+			"decided_at":    leave.DecidedAt,
+		})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrLeaveAlreadyDecided
+	}
+
+	leave.Status = status
+	return nil
+}
@@ -0,0 +1,74 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type LeaveTypeRepository interface {
+	Store(leaveType *domain.LeaveType) error
+	FindAll() ([]domain.LeaveType, error)
+	FindById(id uint) (domain.LeaveType, error)
+	FindByCode(code string) (domain.LeaveType, error)
+}
+
+type leaveTypeRepository struct {
+	db *gorm.DB
+}
+
+func NewLeaveTypeRepository(db *gorm.DB) LeaveTypeRepository {
+	return &leaveTypeRepository{
+		db: db,
+	}
+}
+
+func (r *leaveTypeRepository) Store(leaveType *domain.LeaveType) error {
+	if leaveType == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(leaveType).Error
+}
+
+func (r *leaveTypeRepository) FindAll() ([]domain.LeaveType, error) {
+	var leaveTypes []domain.LeaveType
+	tx := r.db.Order("id ASC").Find(&leaveTypes)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return leaveTypes, nil
+}
+
+func (r *leaveTypeRepository) FindById(id uint) (domain.LeaveType, error) {
+	var leaveType domain.LeaveType
+
+	tx := r.db.Where("id", id).First(&leaveType)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.LeaveType{}, ErrRecordNotFound
+		}
+
+		return domain.LeaveType{}, tx.Error
+	}
+
+	return leaveType, nil
+}
+
+func (r *leaveTypeRepository) FindByCode(code string) (domain.LeaveType, error) {
+	var leaveType domain.LeaveType
+
+	tx := r.db.Where("LOWER(code) = LOWER(?)", code).First(&leaveType)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.LeaveType{}, ErrRecordNotFound
+		}
+
+		return domain.LeaveType{}, tx.Error
+	}
+
+	return leaveType, nil
+}
@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"sort"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type OutboxRepository interface {
+	Claim(limit int, now time.Time, lease time.Duration) ([]domain.OutboxEvent, error)
+	MarkDispatched(id uint64, at time.Time) error
+	MarkRetry(id uint64, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(id uint64, at time.Time, lastError string) error
+	DeleteFinishedBefore(before time.Time) (int64, error)
+
+	Snapshot() (domain.OutboxSnapshot, error)
+	FindAfter(after uint64, until uint64, limit int) ([]domain.OutboxEvent, error)
+	Exists(id uint64) (bool, error)
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
+
+// claimQuery picks the oldest pending event of every employee that is due and
+// moves its next attempt past the lease, so other instances skip it while it
+// is delivered. Later events of the employee wait until it is dispatched or
+// failed, which keeps them in order.
+const claimQuery = `UPDATE outbox_events SET attempts = attempts + 1, next_attempt_at = @lease
+WHERE id IN (
+	SELECT o.id FROM outbox_events o
+	WHERE o.dispatched_at IS NULL AND o.failed_at IS NULL AND o.next_attempt_at <= @now
+	AND NOT EXISTS (
+		SELECT 1 FROM outbox_events p
+		WHERE p.employee_id = o.employee_id AND p.dispatched_at IS NULL AND p.failed_at IS NULL AND p.id < o.id
+	)
+	ORDER BY o.id
+	LIMIT @limit
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *`
+
+func (r *outboxRepository) Claim(limit int, now time.Time, lease time.Duration) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+	err := r.db.Raw(claimQuery, map[string]interface{}{
+		"now":   now,
+		"lease": now.Add(lease),
+		"limit": limit,
+	}).Scan(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].ID < events[j].ID
+	})
+
+	return events, nil
+}
+
+func (r *outboxRepository) MarkDispatched(id uint64, at time.Time) error {
+	return r.update(id, map[string]interface{}{
+		"dispatched_at": at,
+		"last_error":    "",
+	})
+}
+
+func (r *outboxRepository) MarkRetry(id uint64, nextAttemptAt time.Time, lastError string) error {
+	return r.update(id, map[string]interface{}{
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	})
+}
+
+// MarkFailed gives up on the event, later events of the employee are
+// dispatched again.
+func (r *outboxRepository) MarkFailed(id uint64, at time.Time, lastError string) error {
+	return r.update(id, map[string]interface{}{
+		"failed_at":  at,
+		"last_error": lastError,
+	})
+}
+
+// DeleteFinishedBefore removes dispatched and failed events, pending events
+// are kept however old they are.
+func (r *outboxRepository) DeleteFinishedBefore(before time.Time) (int64, error) {
+	tx := r.db.Where("dispatched_at < ? OR failed_at < ?", before, before).Delete(&domain.OutboxEvent{})
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	return tx.RowsAffected, nil
+}
+
+// snapshotQuery reads the latest event id and the transaction horizon in
+// one statement, so both belong to the same snapshot.
+const snapshotQuery = `SELECT COALESCE(MAX(id), 0) AS latest_id,
+	pg_snapshot_xmin(pg_current_snapshot())::text::bigint AS xmin,
+	pg_snapshot_xmax(pg_current_snapshot())::text::bigint AS xmax
+FROM outbox_events`
+
+func (r *outboxRepository) Snapshot() (domain.OutboxSnapshot, error) {
+	var snapshot domain.OutboxSnapshot
+	err := r.db.Raw(snapshotQuery).Scan(&snapshot).Error
+
+	return snapshot, err
+}
+
+// FindAfter returns the events with an id in (after, until] in id order,
+// whether they are dispatched or not.
+func (r *outboxRepository) FindAfter(after uint64, until uint64, limit int) ([]domain.OutboxEvent, error) {
+	var events []domain.OutboxEvent
+	tx := r.db.Where("id > ? AND id <= ?", after, until).Order("id ASC").Limit(limit).Find(&events)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return events, nil
+}
+
+func (r *outboxRepository) Exists(id uint64) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.OutboxEvent{}).Where("id", id).Count(&count).Error
+
+	return count > 0, err
+}
+
+func (r *outboxRepository) update(id uint64, values map[string]interface{}) error {
+	tx := r.db.Model(&domain.OutboxEvent{}).Where("id", id).Updates(values)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type PositionRepository interface {
+	Store(position *domain.Position) error
+	FindAll() ([]domain.Position, error)
+	FindById(id uint) (domain.Position, error)
+	FindByTitle(title string) (domain.Position, error)
+	UpdateById(position *domain.Position) error
+	DeleteById(id uint) error
+}
+
+type positionRepository struct {
+	db *gorm.DB
+}
+
+func NewPositionRepository(db *gorm.DB) PositionRepository {
+	return &positionRepository{
+		db: db,
+	}
+}
+
+func (r *positionRepository) Store(position *domain.Position) error {
+	if position == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(position).Error
+}
+
+func (r *positionRepository) FindAll() ([]domain.Position, error) {
+	var positions []domain.Position
+	tx := r.db.Order("title ASC").Find(&positions)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return positions, nil
+}
+
+func (r *positionRepository) FindById(id uint) (domain.Position, error) {
+	var position domain.Position
+
+	tx := r.db.Where("id", id).First(&position)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Position{}, ErrRecordNotFound
+		}
+
+		return domain.Position{}, tx.Error
+	}
+
+	return position, nil
+}
+
+func (r *positionRepository) FindByTitle(title string) (domain.Position, error) {
+	var position domain.Position
+
+	tx := r.db.Where("LOWER(title) = LOWER(?)", title).First(&position)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Position{}, ErrRecordNotFound
+		}
+
+		return domain.Position{}, tx.Error
+	}
+
+	return position, nil
+}
+
+func (r *positionRepository) UpdateById(position *domain.Position) error {
+	if position == nil {
+		return ErrNilReference
+	}
+
+	tx := r.db.Model(position).
+		Select("title", "department", "grade", "description").
+		Updates(position)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *positionRepository) DeleteById(id uint) error {
+	tx := r.db.Delete(&domain.Position{}, id)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+)
+
+type ReviewRepository interface {
+	StoreCycle(cycle *domain.ReviewCycle) error
+	FindAllCycle() ([]domain.ReviewCycle, error)
+	FindCycleById(id uint) (domain.ReviewCycle, error)
+	FindCycleByName(name string) (domain.ReviewCycle, error)
+	Store(review *domain.Review) error
+	FindById(id uint) (domain.Review, error)
+	FindByEmployeeId(employeeId uint) ([]domain.Review, error)
+	FindByCycleAndEmployee(cycleId, employeeId uint) (domain.Review, error)
+	Update(review *domain.Review, status string, columns ...string) error
+}
+
+var ErrReviewStatusChanged = errors.New("review status changed since it was read")
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+func NewReviewRepository(db *gorm.DB) ReviewRepository {
+	return &reviewRepository{
+		db: db,
+	}
+}
+
+func (r *reviewRepository) StoreCycle(cycle *domain.ReviewCycle) error {
+	if cycle == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(cycle).Error
+}
+
+func (r *reviewRepository) FindAllCycle() ([]domain.ReviewCycle, error) {
+	var cycles []domain.ReviewCycle
+	tx := r.db.Order("period_start DESC").Find(&cycles)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return cycles, nil
+}
+
+func (r *reviewRepository) FindCycleById(id uint) (domain.ReviewCycle, error) {
+	var cycle domain.ReviewCycle
+
+	tx := r.db.Where("id", id).First(&cycle)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.ReviewCycle{}, ErrRecordNotFound
+		}
+
+		return domain.ReviewCycle{}, tx.Error
+	}
+
+	return cycle, nil
+}
+
+func (r *reviewRepository) FindCycleByName(name string) (domain.ReviewCycle, error) {
+	var cycle domain.ReviewCycle
+
+	tx := r.db.Where("LOWER(name) = LOWER(?)", name).First(&cycle)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.ReviewCycle{}, ErrRecordNotFound
+		}
+
+		return domain.ReviewCycle{}, tx.Error
+	}
+
+	return cycle, nil
+}
+
+func (r *reviewRepository) Store(review *domain.Review) error {
+	if review == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(review).Error
+}
+
+func (r *reviewRepository) FindById(id uint) (domain.Review, error) {
+	var review domain.Review
+
+	tx := r.db.Where("id", id).First(&review)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Review{}, ErrRecordNotFound
+		}
+
+		return domain.Review{}, tx.Error
+	}
+
+	return review, nil
+}
+
+func (r *reviewRepository) FindByEmployeeId(employeeId uint) ([]domain.Review, error) {
+	var reviews []domain.Review
+	tx := r.db.Where("employee_id", employeeId).Order("id ASC").Find(&reviews)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewRepository) FindByCycleAndEmployee(cycleId, employeeId uint) (domain.Review, error) {
+	var review domain.Review
+
+	tx := r.db.Where("cycle_id = ? AND employee_id = ?", cycleId, employeeId).First(&review)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Review{}, ErrRecordNotFound
+		}
+
+		return domain.Review{}, tx.Error
+	}
+
+	return review, nil
+}
+
+// Update writes columns of the review only while it still has the status it
+// was read with, so a write based on a stale read cannot undo a transition.
+func (r *reviewRepository) Update(review *domain.Review, status string, columns ...string) error {
+	if review == nil {
+		return ErrNilReference
+	}
+
+	tx := r.db.Model(review).
+		Where("status = ?", status).
+		Select(columns).
+		Updates(review)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrReviewStatusChanged
+	}
+
+	return nil
+}
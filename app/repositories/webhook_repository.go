@@ -0,0 +1,263 @@
+package repositories
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository interface {
+	Store(webhook *domain.Webhook) error
+	FindAll() ([]domain.Webhook, error)
+	FindById(id uint) (domain.Webhook, error)
+	FindActive(eventType string) ([]domain.Webhook, error)
+	Update(webhook *domain.Webhook) error
+	DeleteById(id uint) error
+	RecordSuccess(id uint) error
+	RecordFailure(id uint, limit int, at time.Time) (bool, error)
+
+	StoreDeliveries(deliveries []domain.WebhookDelivery) error
+	FindDeliveries(webhookId uint, limit int) ([]domain.WebhookDelivery, error)
+	FindDeliveryById(webhookId uint, id uint64) (domain.WebhookDelivery, error)
+	ClaimDeliveries(limit int, now time.Time, lease time.Duration) ([]domain.WebhookDelivery, error)
+	ExtendDeliveryLease(ids []uint64, until time.Time) error
+	ReleaseDeliveries(ids []uint64, nextAttemptAt time.Time) error
+	UpdateDelivery(delivery *domain.WebhookDelivery) error
+}
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (r *webhookRepository) Store(webhook *domain.Webhook) error {
+	if webhook == nil {
+		return ErrNilReference
+	}
+
+	return r.db.Create(webhook).Error
+}
+
+func (r *webhookRepository) FindAll() ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	tx := r.db.Order("id ASC").Find(&webhooks)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepository) FindById(id uint) (domain.Webhook, error) {
+	var webhook domain.Webhook
+
+	tx := r.db.Where("id", id).First(&webhook)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.Webhook{}, ErrRecordNotFound
+		}
+
+		return domain.Webhook{}, tx.Error
+	}
+
+	return webhook, nil
+}
+
+// FindActive returns the enabled webhooks subscribed to eventType.
+func (r *webhookRepository) FindActive(eventType string) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	tx := r.db.Where("active AND event_types @> ?::jsonb", domain.StringList{eventType}).Order("id ASC").Find(&webhooks)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return webhooks, nil
+}
+
+func (r *webhookRepository) Update(webhook *domain.Webhook) error {
+	if webhook == nil {
+		return ErrNilReference
+	}
+
+	tx := r.db.Model(webhook).
+		Select("url", "event_types", "secret", "active", "consecutive_failures", "disabled_at").
+		Updates(webhook)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// DeleteById removes the webhook together with its delivery log.
+func (r *webhookRepository) DeleteById(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("webhook_id", id).Delete(&domain.WebhookDelivery{}).Error; err != nil {
+			return err
+		}
+
+		deleted := tx.Where("id", id).Delete(&domain.Webhook{})
+		if deleted.Error != nil {
+			return deleted.Error
+		}
+
+		if deleted.RowsAffected == 0 {
+			return ErrRecordNotFound
+		}
+
+		return nil
+	})
+}
+
+func (r *webhookRepository) RecordSuccess(id uint) error {
+	return r.db.Model(&domain.Webhook{}).
+		Where("id = ? AND consecutive_failures > 0", id).
+		Update("consecutive_failures", 0).Error
+}
+
+// RecordFailure counts a failed delivery and disables the webhook once limit
+// deliveries in a row failed, it reports whether the webhook was disabled.
+func (r *webhookRepository) RecordFailure(id uint, limit int, at time.Time) (bool, error) {
+	disabled := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Webhook{}).Where("id", id).
+			Update("consecutive_failures", gorm.Expr("consecutive_failures + 1")).Error
+		if err != nil {
+			return err
+		}
+
+		updated := tx.Model(&domain.Webhook{}).
+			Where("id = ? AND active AND consecutive_failures >= ?", id, limit).
+			Updates(map[string]interface{}{"active": false, "disabled_at": at})
+		if updated.Error != nil {
+			return updated.Error
+		}
+
+		disabled = updated.RowsAffected > 0
+		return nil
+	})
+
+	return disabled, err
+}
+
+// StoreDeliveries skips deliveries of an event the webhook already has, so
+// events delivered twice by the outbox are only sent once.
+func (r *webhookRepository) StoreDeliveries(deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+// FindDeliveries returns the latest deliveries of the webhook first.
+func (r *webhookRepository) FindDeliveries(webhookId uint, limit int) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	tx := r.db.Where("webhook_id", webhookId).Order("id DESC").Limit(limit).Find(&deliveries)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	return deliveries, nil
+}
+
+func (r *webhookRepository) FindDeliveryById(webhookId uint, id uint64) (domain.WebhookDelivery, error) {
+	var delivery domain.WebhookDelivery
+
+	tx := r.db.Where("id = ? AND webhook_id = ?", id, webhookId).First(&delivery)
+	if tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return domain.WebhookDelivery{}, ErrRecordNotFound
+		}
+
+		return domain.WebhookDelivery{}, tx.Error
+	}
+
+	return delivery, nil
+}
+
+// claimDeliveriesQuery works like the outbox claim, per webhook and employee.
+// Deliveries of disabled webhooks wait until the webhook is enabled again.
+const claimDeliveriesQuery = `UPDATE webhook_deliveries SET attempts = attempts + 1, next_attempt_at = @lease
+WHERE id IN (
+	SELECT d.id FROM webhook_deliveries d JOIN webhooks w ON w.id = d.webhook_id
+	WHERE d.status = 'pending' AND d.next_attempt_at <= @now AND w.active
+	AND NOT EXISTS (
+		SELECT 1 FROM webhook_deliveries p
+		WHERE p.webhook_id = d.webhook_id AND p.employee_id = d.employee_id AND p.status = 'pending' AND p.id < d.id
+	)
+	ORDER BY d.id
+	LIMIT @limit
+	FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING *`
+
+func (r *webhookRepository) ClaimDeliveries(limit int, now time.Time, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	var deliveries []domain.WebhookDelivery
+	err := r.db.Raw(claimDeliveriesQuery, map[string]interface{}{
+		"now":   now,
+		"lease": now.Add(lease),
+		"limit": limit,
+	}).Scan(&deliveries).Error
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].ID < deliveries[j].ID
+	})
+
+	return deliveries, nil
+}
+
+// ExtendDeliveryLease keeps claimed deliveries that are still pending away
+// from other instances until until.
+func (r *webhookRepository) ExtendDeliveryLease(ids []uint64, until time.Time) error {
+	return r.db.Model(&domain.WebhookDelivery{}).
+		Where("id IN ? AND status = ?", ids, domain.DeliveryPending).
+		Update("next_attempt_at", until).Error
+}
+
+// ReleaseDeliveries gives claimed deliveries back unsent, the attempt counted
+// by the claim is taken back.
+func (r *webhookRepository) ReleaseDeliveries(ids []uint64, nextAttemptAt time.Time) error {
+	return r.db.Model(&domain.WebhookDelivery{}).
+		Where("id IN ? AND status = ?", ids, domain.DeliveryPending).
+		Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts - 1"),
+			"next_attempt_at": nextAttemptAt,
+		}).Error
+}
+
+func (r *webhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	if delivery == nil {
+		return ErrNilReference
+	}
+
+	tx := r.db.Model(delivery).
+		Select("status", "next_attempt_at", "response_code", "last_error", "delivered_at").
+		Updates(delivery)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -1,30 +1,224 @@
 package app
 
 import (
+	"context"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
 	"github.com/RuhullahReza/Employee-App/app/handlers"
 	"github.com/RuhullahReza/Employee-App/app/repositories"
 	"github.com/RuhullahReza/Employee-App/app/usecases"
 	config "github.com/RuhullahReza/Employee-App/config"
 	"github.com/RuhullahReza/Employee-App/pkg/database"
+	"github.com/RuhullahReza/Employee-App/pkg/events"
+	"github.com/RuhullahReza/Employee-App/pkg/featureflag"
+	"github.com/RuhullahReza/Employee-App/pkg/lifecycle"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
 	"github.com/RuhullahReza/Employee-App/pkg/routes"
+	"github.com/RuhullahReza/Employee-App/pkg/storage"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
 
 	"github.com/gofiber/fiber/v2"
 )
 
-func NewServer(cfg *config.Config) *fiber.App {
-	db := database.Init(cfg)
-	database.AutoMigrate(db)
+type Server struct {
+	cfg       *config.Config
+	app       *fiber.App
+	lifecycle *lifecycle.Manager
+	stream    *events.Stream
+}
+
+func NewServer(cfg *config.Config) (*Server, error) {
+	lc := lifecycle.NewManager()
+	applyRuntimeConfig(cfg)
+
+	db, err := database.Init(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.OnClose("database", func(ctx context.Context) error {
+		return database.Close(db)
+	})
+
+	if err := database.AutoMigrate(db); err != nil {
+		database.Close(db)
+		return nil, err
+	}
+
+	documentStorage, err := storage.New(cfg)
+	if err != nil {
+		database.Close(db)
+		return nil, err
+	}
 
 	employeeRepository := repositories.NewEmployeeRepository(db)
-	empolyeeUsecase := usecases.NewEmployeeUsecase(employeeRepository)
-	employeeHandler := handlers.NewEmployeeHandler(empolyeeUsecase)
+	positionRepository := repositories.NewPositionRepository(db)
+	jobAssignmentRepository := repositories.NewJobAssignmentRepository(db)
+	compensationRepository := repositories.NewCompensationRepository(db)
+	leaveTypeRepository := repositories.NewLeaveTypeRepository(db)
+	leaveRepository := repositories.NewLeaveRepository(db)
+	calendarRepository := repositories.NewCalendarRepository(db)
+	attendanceRepository := repositories.NewAttendanceRepository(db)
+	reviewRepository := repositories.NewReviewRepository(db)
+	checklistRepository := repositories.NewChecklistRepository(db)
+	contactRepository := repositories.NewContactRepository(db)
+	customFieldRepository := repositories.NewCustomFieldRepository(db)
+	documentRepository := repositories.NewDocumentRepository(db)
+	outboxRepository := repositories.NewOutboxRepository(db)
+	webhookRepository := repositories.NewWebhookRepository(db)
+
+	empolyeeUsecase := usecases.NewEmployeeUsecase(employeeRepository, checklistRepository, customFieldRepository, domain.HireDatePolicy{
+		MinDate:       cfg.HireDateMinTime(),
+		MaxFutureDays: cfg.HireMaxFutureDays,
+	}, cfg.EndpointPrefix)
+	positionUsecase := usecases.NewPositionUsecase(positionRepository)
+	jobUsecase := usecases.NewJobUsecase(employeeRepository, positionRepository, jobAssignmentRepository)
+	compensationUsecase := usecases.NewCompensationUsecase(employeeRepository, compensationRepository)
+	leaveUsecase := usecases.NewLeaveUsecase(employeeRepository, leaveTypeRepository, leaveRepository, calendarRepository)
+	calendarUsecase := usecases.NewCalendarUsecase(employeeRepository, calendarRepository, cfg.EndpointPrefix)
+	attendanceUsecase := usecases.NewAttendanceUsecase(employeeRepository, attendanceRepository)
+	reviewUsecase := usecases.NewReviewUsecase(employeeRepository, reviewRepository)
+	checklistUsecase := usecases.NewChecklistUsecase(employeeRepository, checklistRepository)
+	contactUsecase := usecases.NewContactUsecase(employeeRepository, contactRepository)
+	customFieldUsecase := usecases.NewCustomFieldUsecase(customFieldRepository)
+	documentUsecase := usecases.NewDocumentUsecase(employeeRepository, documentRepository, documentStorage, cfg.DocumentMaxSize, cfg.DocumentTypeList())
+	photoUsecase := usecases.NewPhotoUsecase(employeeRepository, documentStorage, cfg.PhotoMaxSize, cfg.EndpointPrefix)
+	webhookUsecase := usecases.NewWebhookUsecase(webhookRepository)
+
+	graphQLHandler, err := handlers.NewGraphQLHandler(empolyeeUsecase, cfg.GqlMaxDepth, cfg.GqlMaxComplexity)
+	if err != nil {
+		database.Close(db)
+		return nil, err
+	}
+
+	eventBus := events.NewBus()
+	dispatcher := events.NewDispatcher(outboxRepository, events.DispatcherOptions{
+		PollInterval: cfg.OutboxPollInterval,
+		BatchSize:    cfg.OutboxBatchSize,
+		MaxAttempts:  cfg.OutboxMaxAttempts,
+		RetryBackoff: cfg.OutboxRetryBackoff,
+		MaxBackoff:   cfg.OutboxMaxBackoff,
+		Retention:    cfg.OutboxRetention,
+	}, eventBus, events.NewWebhookSink(webhookRepository))
+	lc.Go("outbox dispatcher", dispatcher.Run)
+
+	webhookSender := events.NewWebhookSender(webhookRepository, events.WebhookOptions{
+		PollInterval: cfg.OutboxPollInterval,
+		BatchSize:    cfg.OutboxBatchSize,
+		MaxAttempts:  cfg.WebhookMaxAttempts,
+		RetryBackoff: cfg.WebhookRetryBackoff,
+		MaxBackoff:   cfg.WebhookMaxBackoff,
+		Timeout:      cfg.WebhookTimeout,
+		FailureLimit: cfg.WebhookFailureLimit,
+		Concurrency:  cfg.WebhookConcurrency,
+	})
+	lc.Go("webhook sender", webhookSender.Run)
+
+	stream := events.NewStream(outboxRepository, events.StreamOptions{
+		PollInterval: cfg.StreamPollInterval,
+		BufferSize:   cfg.StreamBufferSize,
+		MaxClients:   cfg.StreamMaxClients,
+	})
+	lc.Go("event stream", stream.Run)
 
 	app := fiber.New(fiber.Config{
-		AppName: cfg.AppName,
+		AppName:           cfg.AppName,
+		StreamRequestBody: true,
 	})
 
-	router := routes.NewRoutes(app, employeeHandler)
+	watcher := config.NewWatcher(cfg)
+	watcher.Subscribe(config.SubscriberFunc(func(previous, current *config.Config) {
+		applyRuntimeConfig(current)
+	}))
+
+	corsMiddleware := middleware.NewCORS(cfg)
+	rateLimiter := middleware.NewRateLimiter(cfg)
+	watcher.Subscribe(corsMiddleware)
+	watcher.Subscribe(rateLimiter)
+	app.Use(corsMiddleware.Handler, rateLimiter.Handler)
+	app.Use(middleware.BodyLimit(fiber.DefaultBodyLimit, routes.StreamsBody(cfg.EndpointPrefix)))
+
+	authorizer := middleware.NewAuthorizer(cfg)
+	watcher.Subscribe(authorizer)
+
+	router := routes.NewRoutes(app, routes.Handlers{
+		Employee: handlers.NewEmployeeHandler(empolyeeUsecase),
+		Health:   handlers.NewHealthHandler(lc.Ready),
+		Position: handlers.NewPositionHandler(positionUsecase),
+		Job:      handlers.NewJobHandler(jobUsecase),
+		Stream:   handlers.NewStreamHandler(stream, cfg.StreamHeartbeat),
+		GraphQL:  graphQLHandler,
+
+		Compensation: handlers.NewCompensationHandler(compensationUsecase),
+		Leave:        handlers.NewLeaveHandler(leaveUsecase),
+		Calendar:     handlers.NewCalendarHandler(calendarUsecase),
+		Attendance:   handlers.NewAttendanceHandler(attendanceUsecase),
+		Review:       handlers.NewReviewHandler(reviewUsecase),
+		Checklist:    handlers.NewChecklistHandler(checklistUsecase),
+		Contact:      handlers.NewContactHandler(contactUsecase),
+		CustomField:  handlers.NewCustomFieldHandler(customFieldUsecase),
+		Document:     handlers.NewDocumentHandler(documentUsecase),
+		Photo:        handlers.NewPhotoHandler(photoUsecase),
+		Webhook:      handlers.NewWebhookHandler(webhookUsecase),
+	}, authorizer)
 	router.Init(cfg.EndpointPrefix)
 
-	return app
+	watcher.Start()
+
+	app.Hooks().OnListen(func(_ fiber.ListenData) error {
+		lc.SetReady(true)
+		return nil
+	})
+
+	return &Server{
+		cfg:       cfg,
+		app:       app,
+		lifecycle: lc,
+		stream:    stream,
+	}, nil
+}
+
+// applyRuntimeConfig pushes the settings that can change without a restart
+// to the packages that use them.
+func applyRuntimeConfig(cfg *config.Config) {
+	if err := logger.SetLevel(cfg.LogLevel); err != nil {
+		logger.Log.Error(err, "failed to set log level", "level", cfg.LogLevel)
+	}
+
+	featureflag.Set(cfg.FeatureFlagList())
+	utils.SetNameCasing(cfg.NameCasing)
+
+	if layouts, err := cfg.DateLayoutList(); err != nil {
+		logger.Log.Error(err, "failed to set date formats", "formats", cfg.DateFormats)
+	} else {
+		utils.SetDateLayouts(layouts)
+	}
+
+	if loc, err := time.LoadLocation(cfg.AppTimeZone); err != nil {
+		logger.Log.Error(err, "failed to set time zone", "timeZone", cfg.AppTimeZone)
+	} else {
+		utils.SetTimeZone(loc)
+	}
+}
+
+func (s *Server) Listen() error {
+	return s.app.Listen(s.cfg.AppHost)
+}
+
+// Shutdown stops accepting new connections, waits for in-flight requests and
+// background workers until ctx is done, then releases the database pool.
+// Event streams never finish on their own, they are closed first.
+func (s *Server) Shutdown(ctx context.Context) error {
+	err := s.lifecycle.Shutdown(ctx, s.cfg.ShutdownDrainDelay, func(ctx context.Context) error {
+		s.stream.Close()
+		return s.app.ShutdownWithContext(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Log.Info("server stopped gracefully")
+	return nil
 }
@@ -0,0 +1,211 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type AttendanceUsecase interface {
+	ClockIn(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error)
+	ClockOut(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error)
+	GetTimesheet(employeeId uint, month string) (domain.TimesheetResponse, error)
+}
+
+type attendanceUsecase struct {
+	employeeRepository   repositories.EmployeeRepository
+	attendanceRepository repositories.AttendanceRepository
+	now                  func() time.Time
+}
+
+const monthLayout = "2006-01"
+
+var (
+	ErrAlreadyClockedIn = errors.New("employee is already clocked in")
+	ErrNotClockedIn     = errors.New("employee is not clocked in")
+	ErrInvalidMonth     = errors.New("invalid month format, use YYYY-MM")
+)
+
+func NewAttendanceUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	attendanceRepository repositories.AttendanceRepository,
+) AttendanceUsecase {
+	return &attendanceUsecase{
+		employeeRepository:   employeeRepository,
+		attendanceRepository: attendanceRepository,
+		now:                  time.Now,
+	}
+}
+
+// ClockIn opens a session for today in the business time zone. Sessions left
+// open on earlier days do not block a new clock-in, they are reported as
+// missing clock-outs.
+func (uc *attendanceUsecase) ClockIn(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error) {
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.AttendanceResponse{}, err
+	}
+
+	if employee.Status == domain.StatusTerminated {
+		return domain.AttendanceResponse{}, ErrEmployeeTerminated
+	}
+
+	now := uc.now().UTC()
+	today := utils.DateOf(now)
+
+	open, err := uc.attendanceRepository.FindOpenByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find open attendance")
+		return domain.AttendanceResponse{}, err
+	}
+
+	for _, a := range open {
+		if a.WorkDate.Equal(today) {
+			return domain.AttendanceResponse{}, ErrAlreadyClockedIn
+		}
+	}
+
+	attendance := domain.Attendance{
+		EmployeeID:       employeeId,
+		WorkDate:         today,
+		ClockIn:          now,
+		ClockInLatitude:  req.Latitude,
+		ClockInLongitude: req.Longitude,
+		Notes:            req.Notes,
+	}
+
+	if err := uc.attendanceRepository.Store(&attendance); err != nil {
+		logger.Log.Error(err, "failed to store attendance")
+		return domain.AttendanceResponse{}, err
+	}
+
+	logger.Log.Info("successfully clock in", "employeeId", employeeId)
+	return toAttendanceResponse(attendance), nil
+}
+
+// ClockOut closes the latest open session, whatever day it was opened on, so
+// overnight shifts can clock out after midnight.
+func (uc *attendanceUsecase) ClockOut(employeeId uint, req domain.AttendanceRequest) (domain.AttendanceResponse, error) {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.AttendanceResponse{}, err
+	}
+
+	now := uc.now().UTC()
+
+	open, err := uc.attendanceRepository.FindOpenByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find open attendance")
+		return domain.AttendanceResponse{}, err
+	}
+
+	if len(open) == 0 {
+		return domain.AttendanceResponse{}, ErrNotClockedIn
+	}
+
+	attendance := &open[len(open)-1]
+
+	attendance.ClockOut = &now
+	attendance.ClockOutLatitude = req.Latitude
+	attendance.ClockOutLongitude = req.Longitude
+	if req.Notes != "" {
+		attendance.Notes = req.Notes
+	}
+
+	if err := uc.attendanceRepository.ClockOut(attendance); err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			return domain.AttendanceResponse{}, ErrNotClockedIn
+		}
+
+		logger.Log.Error(err, "failed to clock out")
+		return domain.AttendanceResponse{}, err
+	}
+
+	logger.Log.Info("successfully clock out", "employeeId", employeeId)
+	return toAttendanceResponse(*attendance), nil
+}
+
+// GetTimesheet summarises the sessions of month (YYYY-MM) per day.
+func (uc *attendanceUsecase) GetTimesheet(employeeId uint, month string) (domain.TimesheetResponse, error) {
+	from, err := time.Parse(monthLayout, month)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse month")
+		return domain.TimesheetResponse{}, ErrInvalidMonth
+	}
+
+	to := from.AddDate(0, 1, -1)
+
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.TimesheetResponse{}, err
+	}
+
+	attendances, err := uc.attendanceRepository.FindByEmployeeIdBetween(employeeId, from, to)
+	if err != nil {
+		logger.Log.Error(err, "failed to find attendance")
+		return domain.TimesheetResponse{}, err
+	}
+
+	res := domain.TimesheetResponse{
+		EmployeeId: employeeId,
+		Month:      month,
+		Days:       summarizeAttendance(attendances, utils.DateOf(uc.now())),
+	}
+
+	for _, d := range res.Days {
+		res.TotalWorkedMinutes += d.WorkedMinutes
+		if d.MissingClockOut {
+			res.MissingClockOuts++
+		}
+	}
+
+	return res, nil
+}
+
+// summarizeAttendance expects the sessions ordered by clock-in.
+func summarizeAttendance(attendances []domain.Attendance, today time.Time) []domain.DailyAttendance {
+	days := []domain.DailyAttendance{}
+	for _, a := range attendances {
+		if len(days) == 0 || !days[len(days)-1].Date.Equal(a.WorkDate) {
+			days = append(days, domain.DailyAttendance{Date: a.WorkDate, FirstClockIn: a.ClockIn})
+		}
+
+		day := &days[len(days)-1]
+		day.Sessions++
+
+		if a.MissingClockOut(today) {
+			day.MissingClockOut = true
+		}
+
+		if a.ClockOut == nil {
+			continue
+		}
+
+		day.WorkedMinutes += int(a.ClockOut.Sub(a.ClockIn).Minutes())
+		if day.LastClockOut == nil || a.ClockOut.After(*day.LastClockOut) {
+			day.LastClockOut = a.ClockOut
+		}
+	}
+
+	return days
+}
+
+func toAttendanceResponse(a domain.Attendance) domain.AttendanceResponse {
+	return domain.AttendanceResponse{
+		Id:                a.ID,
+		EmployeeId:        a.EmployeeID,
+		WorkDate:          a.WorkDate,
+		ClockIn:           a.ClockIn,
+		ClockOut:          a.ClockOut,
+		ClockInLatitude:   a.ClockInLatitude,
+		ClockInLongitude:  a.ClockInLongitude,
+		ClockOutLatitude:  a.ClockOutLatitude,
+		ClockOutLongitude: a.ClockOutLongitude,
+		Notes:             a.Notes,
+	}
+}
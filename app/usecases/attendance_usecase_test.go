@@ -0,0 +1,212 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestClockIn(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	ar := mocks.NewAttendanceRepository(t)
+	uc := NewAttendanceUsecase(er, ar).(*attendanceUsecase)
+	logger.Init()
+
+	now := time.Date(2024, 5, 6, 8, 30, 0, 0, time.UTC)
+	today := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	employeeId := uint(1)
+	employee := domain.Employee{ID: employeeId, Status: domain.StatusActive}
+	lat, lng := -6.2, 106.8
+
+	t.Run("success with stale open session", func(t *testing.T) {
+		stale := domain.Attendance{ID: 3, EmployeeID: employeeId, WorkDate: today.AddDate(0, 0, -3)}
+
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		ar.On("FindOpenByEmployeeId", employeeId).Return([]domain.Attendance{stale}, nil).Once()
+		ar.On("Store", &domain.Attendance{
+			EmployeeID:       employeeId,
+			WorkDate:         today,
+			ClockIn:          now,
+			ClockInLatitude:  &lat,
+			ClockInLongitude: &lng,
+			Notes:            "kiosk",
+		}).Return(nil).Once()
+
+		res, err := uc.ClockIn(employeeId, domain.AttendanceRequest{Latitude: &lat, Longitude: &lng, Notes: "kiosk"})
+		assert.NoError(t, err)
+		assert.Equal(t, now, res.ClockIn)
+	})
+
+	t.Run("work date in business time zone", func(t *testing.T) {
+		utils.SetTimeZone(time.FixedZone("WIB", 7*60*60))
+		defer utils.SetTimeZone(time.UTC)
+
+		early := time.Date(2024, 5, 5, 23, 30, 0, 0, time.UTC)
+		uc.now = func() time.Time { return early }
+		defer func() { uc.now = func() time.Time { return now } }()
+
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		ar.On("FindOpenByEmployeeId", employeeId).Return(nil, nil).Once()
+		ar.On("Store", mock.MatchedBy(func(a *domain.Attendance) bool {
+			return a.WorkDate.Equal(today) && a.ClockIn.Equal(early)
+		})).Return(nil).Once()
+
+		res, err := uc.ClockIn(employeeId, domain.AttendanceRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, today, res.WorkDate)
+	})
+
+	t.Run("already clocked in", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		ar.On("FindOpenByEmployeeId", employeeId).
+			Return([]domain.Attendance{{ID: 4, EmployeeID: employeeId, WorkDate: today}}, nil).
+			Once()
+
+		_, err := uc.ClockIn(employeeId, domain.AttendanceRequest{})
+		assert.ErrorIs(t, err, ErrAlreadyClockedIn)
+	})
+
+	t.Run("terminated employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId, Status: domain.StatusTerminated}, nil).Once()
+
+		_, err := uc.ClockIn(employeeId, domain.AttendanceRequest{})
+		assert.ErrorIs(t, err, ErrEmployeeTerminated)
+	})
+
+	t.Run("deleted employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.ClockIn(employeeId, domain.AttendanceRequest{})
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestClockOut(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	ar := mocks.NewAttendanceRepository(t)
+	uc := NewAttendanceUsecase(er, ar).(*attendanceUsecase)
+	logger.Init()
+
+	now := time.Date(2024, 5, 6, 17, 0, 0, 0, time.UTC)
+	today := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	uc.now = func() time.Time { return now }
+
+	employeeId := uint(1)
+
+	t.Run("success", func(t *testing.T) {
+		open := domain.Attendance{ID: 4, EmployeeID: employeeId, WorkDate: today, ClockIn: today.Add(8 * time.Hour)}
+
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		ar.On("FindOpenByEmployeeId", employeeId).Return([]domain.Attendance{open}, nil).Once()
+		ar.On("ClockOut", mock.MatchedBy(func(a *domain.Attendance) bool {
+			return a.ID == 4 && a.ClockOut.Equal(now)
+		})).Return(nil).Once()
+
+		res, err := uc.ClockOut(employeeId, domain.AttendanceRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, now, *res.ClockOut)
+	})
+
+	t.Run("overnight shift closes the latest session", func(t *testing.T) {
+		utils.SetTimeZone(time.FixedZone("WIB", 7*60*60))
+		defer utils.SetTimeZone(time.UTC)
+
+		stale := domain.Attendance{ID: 2, EmployeeID: employeeId, WorkDate: today.AddDate(0, 0, -3)}
+		night := domain.Attendance{ID: 3, EmployeeID: employeeId, WorkDate: today.AddDate(0, 0, -1), ClockIn: today.Add(-10 * time.Hour)}
+
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		ar.On("FindOpenByEmployeeId", employeeId).Return([]domain.Attendance{stale, night}, nil).Once()
+		ar.On("ClockOut", mock.MatchedBy(func(a *domain.Attendance) bool {
+			return a.ID == 3 && a.ClockOut.Equal(now)
+		})).Return(nil).Once()
+
+		res, err := uc.ClockOut(employeeId, domain.AttendanceRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, uint(3), res.Id)
+	})
+
+	t.Run("not clocked in", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		ar.On("FindOpenByEmployeeId", employeeId).Return(nil, nil).Once()
+
+		_, err := uc.ClockOut(employeeId, domain.AttendanceRequest{})
+		assert.ErrorIs(t, err, ErrNotClockedIn)
+	})
+}
+
+func TestGetTimesheet(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	ar := mocks.NewAttendanceRepository(t)
+	uc := NewAttendanceUsecase(er, ar).(*attendanceUsecase)
+	logger.Init()
+
+	uc.now = func() time.Time { return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC) }
+
+	employeeId := uint(1)
+	day1 := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 5, 7, 0, 0, 0, 0, time.UTC)
+	at := func(day time.Time, hour int) *time.Time {
+		t := day.Add(time.Duration(hour) * time.Hour)
+		return &t
+	}
+
+	attendances := []domain.Attendance{
+		{ID: 1, WorkDate: day1, ClockIn: *at(day1, 8), ClockOut: at(day1, 12)},
+		{ID: 2, WorkDate: day1, ClockIn: *at(day1, 13), ClockOut: at(day1, 17)},
+		{ID: 3, WorkDate: day2, ClockIn: *at(day2, 9)},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		ar.On("FindByEmployeeIdBetween", employeeId,
+			time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+		).Return(attendances, nil).Once()
+
+		res, err := uc.GetTimesheet(employeeId, "2024-05")
+		assert.NoError(t, err)
+		assert.Len(t, res.Days, 2)
+
+		assert.Equal(t, 2, res.Days[0].Sessions)
+		assert.Equal(t, 480, res.Days[0].WorkedMinutes)
+		assert.Equal(t, at(day1, 17), res.Days[0].LastClockOut)
+		assert.False(t, res.Days[0].MissingClockOut)
+
+		assert.True(t, res.Days[1].MissingClockOut)
+		assert.Equal(t, 480, res.TotalWorkedMinutes)
+		assert.Equal(t, 1, res.MissingClockOuts)
+	})
+
+	t.Run("missing clock out in business time zone", func(t *testing.T) {
+		utils.SetTimeZone(time.FixedZone("WIB", 7*60*60))
+		defer utils.SetTimeZone(time.UTC)
+
+		// 2024-05-08 02:00 in Jakarta, the session of 2024-05-07 is over.
+		uc.now = func() time.Time { return time.Date(2024, 5, 7, 19, 0, 0, 0, time.UTC) }
+		defer func() { uc.now = func() time.Time { return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC) } }()
+
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		ar.On("FindByEmployeeIdBetween", employeeId, mock.Anything, mock.Anything).
+			Return(attendances[2:], nil).
+			Once()
+
+		res, err := uc.GetTimesheet(employeeId, "2024-05")
+		assert.NoError(t, err)
+		assert.True(t, res.Days[0].MissingClockOut)
+	})
+
+	t.Run("invalid month", func(t *testing.T) {
+		_, err := uc.GetTimesheet(employeeId, "05-2024")
+		assert.ErrorIs(t, err, ErrInvalidMonth)
+	})
+}
@@ -0,0 +1,321 @@
+package usecases
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/ical"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type CalendarUsecase interface {
+	CreateCalendar(req domain.WorkCalendarRequest) (domain.WorkCalendarResponse, error)
+	GetAllCalendar() ([]domain.WorkCalendarResponse, error)
+	GetCalendarById(id uint) (domain.WorkCalendarResponse, error)
+	AddHoliday(calendarId uint, req domain.HolidayRequest) (domain.HolidayResponse, error)
+	ImportHolidays(calendarId uint, r io.Reader) (domain.HolidayImportResponse, error)
+	CountWorkingDays(calendarId uint, from, to string) (domain.WorkingDaysResponse, error)
+	AssignCalendar(employeeId uint, req domain.CalendarAssignmentRequest) (domain.EmployeeResponse, error)
+	CheckEmployeeDate(employeeId uint, date string) (domain.DateCheckResponse, error)
+}
+
+type calendarUsecase struct {
+	employeeRepository repositories.EmployeeRepository
+	calendarRepository repositories.CalendarRepository
+	endpointPrefix     string
+}
+
+// maxHolidayDays caps how many days a single imported event can span.
+const maxHolidayDays = 31
+
+// maxWorkingDaysRange caps how many days working days are counted over, the
+// count walks the range day by day.
+const maxWorkingDaysRange = 366
+
+var (
+	ErrDuplicateCalendarName = errors.New("calendar name already exists")
+	ErrCalendarNotFound      = errors.New("calendar not found")
+	ErrNoCalendar            = errors.New("employee has no work calendar")
+	ErrHolidayTooLong        = errors.New("holiday spans too many days")
+	ErrToBeforeFrom          = errors.New("to is before from")
+	ErrRangeTooLong          = errors.New("date range spans more than 366 days")
+)
+
+func NewCalendarUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	calendarRepository repositories.CalendarRepository,
+	endpointPrefix string,
+) CalendarUsecase {
+	return &calendarUsecase{
+		employeeRepository: employeeRepository,
+		calendarRepository: calendarRepository,
+		endpointPrefix:     endpointPrefix,
+	}
+}
+
+func (uc *calendarUsecase) CreateCalendar(req domain.WorkCalendarRequest) (domain.WorkCalendarResponse, error) {
+	found, err := uc.calendarRepository.FindByName(req.Name)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find calendar by name")
+		return domain.WorkCalendarResponse{}, err
+	}
+
+	if found.ID != 0 {
+		return domain.WorkCalendarResponse{}, ErrDuplicateCalendarName
+	}
+
+	workingDays, err := domain.ParseWeekdays(req.WorkingDays)
+	if err != nil {
+		return domain.WorkCalendarResponse{}, err
+	}
+
+	calendar := domain.WorkCalendar{
+		Name:        req.Name,
+		Country:     req.Country,
+		Region:      req.Region,
+		WorkingDays: workingDays,
+	}
+
+	if err := uc.calendarRepository.Store(&calendar); err != nil {
+		logger.Log.Error(err, "failed to store calendar")
+		return domain.WorkCalendarResponse{}, err
+	}
+
+	logger.Log.Info("successfully create calendar", "id", calendar.ID, "name", calendar.Name)
+	return toWorkCalendarResponse(calendar), nil
+}
+
+func (uc *calendarUsecase) GetAllCalendar() ([]domain.WorkCalendarResponse, error) {
+	calendars, err := uc.calendarRepository.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all calendar")
+		return nil, err
+	}
+
+	res := make([]domain.WorkCalendarResponse, 0, len(calendars))
+	for _, c := range calendars {
+		res = append(res, toWorkCalendarResponse(c))
+	}
+
+	return res, nil
+}
+
+func (uc *calendarUsecase) GetCalendarById(id uint) (domain.WorkCalendarResponse, error) {
+	calendar, err := uc.calendarRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find calendar by id")
+		return domain.WorkCalendarResponse{}, err
+	}
+
+	return toWorkCalendarResponse(calendar), nil
+}
+
+func (uc *calendarUsecase) AddHoliday(calendarId uint, req domain.HolidayRequest) (domain.HolidayResponse, error) {
+	date, err := utils.ParseDateString(req.Date)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse Date")
+		return domain.HolidayResponse{}, ErrInvalidDate
+	}
+
+	if _, err := uc.calendarRepository.FindById(calendarId); err != nil {
+		logger.Log.Error(err, "failed to find calendar by id")
+		return domain.HolidayResponse{}, err
+	}
+
+	holiday := domain.Holiday{CalendarID: calendarId, Date: date, Name: strings.TrimSpace(req.Name)}
+	if err := uc.calendarRepository.StoreHolidays([]domain.Holiday{holiday}); err != nil {
+		logger.Log.Error(err, "failed to store holiday")
+		return domain.HolidayResponse{}, err
+	}
+
+	return toHolidayResponse(holiday), nil
+}
+
+// ImportHolidays adds every day of every event in an iCalendar file as a
+// holiday of the calendar.
+func (uc *calendarUsecase) ImportHolidays(calendarId uint, r io.Reader) (domain.HolidayImportResponse, error) {
+	events, err := ical.Parse(r)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse iCalendar file")
+		return domain.HolidayImportResponse{}, err
+	}
+
+	if _, err := uc.calendarRepository.FindById(calendarId); err != nil {
+		logger.Log.Error(err, "failed to find calendar by id")
+		return domain.HolidayImportResponse{}, err
+	}
+
+	var holidays []domain.Holiday
+	for _, event := range events {
+		days := event.Days()
+		if len(days) > maxHolidayDays {
+			return domain.HolidayImportResponse{}, ErrHolidayTooLong
+		}
+
+		for _, day := range days {
+			holidays = append(holidays, domain.Holiday{
+				CalendarID: calendarId,
+				Date:       day,
+				Name:       strings.TrimSpace(event.Summary),
+			})
+		}
+	}
+
+	if err := uc.calendarRepository.StoreHolidays(holidays); err != nil {
+		logger.Log.Error(err, "failed to store holidays")
+		return domain.HolidayImportResponse{}, err
+	}
+
+	logger.Log.Info("successfully import holidays", "calendarId", calendarId, "count", len(holidays))
+	return domain.HolidayImportResponse{Imported: len(holidays)}, nil
+}
+
+func (uc *calendarUsecase) CountWorkingDays(calendarId uint, from, to string) (domain.WorkingDaysResponse, error) {
+	fromDate, err := utils.ParseDateString(from)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse from date")
+		return domain.WorkingDaysResponse{}, ErrInvalidDate
+	}
+
+	toDate, err := utils.ParseDateString(to)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse to date")
+		return domain.WorkingDaysResponse{}, ErrInvalidDate
+	}
+
+	if toDate.Before(fromDate) {
+		return domain.WorkingDaysResponse{}, ErrToBeforeFrom
+	}
+
+	if err := checkWorkingDaysRange(fromDate, toDate); err != nil {
+		return domain.WorkingDaysResponse{}, err
+	}
+
+	calendar, err := uc.calendarRepository.FindById(calendarId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find calendar by id")
+		return domain.WorkingDaysResponse{}, err
+	}
+
+	return domain.WorkingDaysResponse{
+		From:        fromDate,
+		To:          toDate,
+		WorkingDays: calendar.WorkingDaysBetween(fromDate, toDate),
+	}, nil
+}
+
+// checkWorkingDaysRange rejects ranges too long to count working days over,
+// both dates included.
+func checkWorkingDaysRange(start, end time.Time) error {
+	if end.After(start.AddDate(0, 0, maxWorkingDaysRange-1)) {
+		return ErrRangeTooLong
+	}
+
+	return nil
+}
+
+func (uc *calendarUsecase) AssignCalendar(employeeId uint, req domain.CalendarAssignmentRequest) (domain.EmployeeResponse, error) {
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.EmployeeResponse{}, err
+	}
+
+	if req.CalendarId != nil {
+		if _, err := uc.findCalendar(*req.CalendarId); err != nil {
+			return domain.EmployeeResponse{}, err
+		}
+	}
+
+	if err := uc.employeeRepository.UpdateCalendar(employeeId, req.CalendarId); err != nil {
+		logger.Log.Error(err, "failed to update calendar")
+		return domain.EmployeeResponse{}, err
+	}
+
+	employee.CalendarID = req.CalendarId
+
+	logger.Log.Info("successfully assign calendar", "id", employeeId)
+	return toEmployeeResponse(employee, uc.endpointPrefix), nil
+}
+
+// CheckEmployeeDate tells whether date is a working day in the calendar of
+// the employee, date defaults to the hire date.
+func (uc *calendarUsecase) CheckEmployeeDate(employeeId uint, date string) (domain.DateCheckResponse, error) {
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.DateCheckResponse{}, err
+	}
+
+	onDate := employee.HireDate
+	if date != "" {
+		onDate, err = utils.ParseDateString(date)
+		if err != nil {
+			logger.Log.Error(err, "failed to parse date")
+			return domain.DateCheckResponse{}, ErrInvalidDate
+		}
+	}
+
+	if employee.CalendarID == nil {
+		return domain.DateCheckResponse{}, ErrNoCalendar
+	}
+
+	calendar, err := uc.findCalendar(*employee.CalendarID)
+	if err != nil {
+		return domain.DateCheckResponse{}, err
+	}
+
+	res := domain.DateCheckResponse{
+		Date:       onDate,
+		CalendarId: calendar.ID,
+		WorkingDay: calendar.IsWorkingDay(onDate),
+	}
+
+	if holiday, ok := calendar.HolidayOn(onDate); ok {
+		res.Holiday = holiday.Name
+	}
+
+	return res, nil
+}
+
+func (uc *calendarUsecase) findCalendar(id uint) (domain.WorkCalendar, error) {
+	calendar, err := uc.calendarRepository.FindById(id)
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return domain.WorkCalendar{}, ErrCalendarNotFound
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to find calendar by id")
+		return domain.WorkCalendar{}, err
+	}
+
+	return calendar, nil
+}
+
+func toWorkCalendarResponse(c domain.WorkCalendar) domain.WorkCalendarResponse {
+	res := domain.WorkCalendarResponse{
+		Id:          c.ID,
+		Name:        c.Name,
+		Country:     c.Country,
+		Region:      c.Region,
+		WorkingDays: strings.Split(c.WorkingDays, ","),
+	}
+
+	for _, h := range c.Holidays {
+		res.Holidays = append(res.Holidays, toHolidayResponse(h))
+	}
+
+	return res
+}
+
+func toHolidayResponse(h domain.Holiday) domain.HolidayResponse {
+	return domain.HolidayResponse{
+		Date: h.Date,
+		Name: h.Name,
+	}
+}
@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/ical"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCalendar(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewCalendarUsecase(er, cr, "/api")
+	logger.Init()
+
+	t.Run("success", func(t *testing.T) {
+		cr.On("FindByName", "Indonesia").Return(domain.WorkCalendar{}, repositories.ErrRecordNotFound).Once()
+		cr.On("Store", &domain.WorkCalendar{Name: "Indonesia", Country: "ID", WorkingDays: "mon,tue,wed,thu,fri,sat"}).
+			Return(nil).
+			Once()
+
+		res, err := uc.CreateCalendar(domain.WorkCalendarRequest{
+			Name:        "Indonesia",
+			Country:     "ID",
+			WorkingDays: []string{"Saturday", "mon", "tue", "wed", "thu", "fri"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"mon", "tue", "wed", "thu", "fri", "sat"}, res.WorkingDays)
+	})
+
+	t.Run("invalid weekday", func(t *testing.T) {
+		for _, day := range []string{"funday", "monkey", "sunshine", "wedding"} {
+			cr.On("FindByName", "Indonesia").Return(domain.WorkCalendar{}, repositories.ErrRecordNotFound).Once()
+
+			_, err := uc.CreateCalendar(domain.WorkCalendarRequest{Name: "Indonesia", WorkingDays: []string{day}})
+			assert.ErrorIs(t, err, domain.ErrInvalidWeekday, day)
+		}
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		cr.On("FindByName", "Indonesia").Return(domain.WorkCalendar{ID: 1}, nil).Once()
+
+		_, err := uc.CreateCalendar(domain.WorkCalendarRequest{Name: "Indonesia"})
+		assert.ErrorIs(t, err, ErrDuplicateCalendarName)
+	})
+}
+
+func TestImportHolidays(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewCalendarUsecase(er, cr, "/api")
+	logger.Init()
+
+	data := "BEGIN:VCALENDAR\n" +
+		"BEGIN:VEVENT\nDTSTART;VALUE=DATE:20240410\nDTEND;VALUE=DATE:20240412\nSUMMARY:Idul Fitri\nEND:VEVENT\n" +
+		"END:VCALENDAR\n"
+
+	t.Run("success", func(t *testing.T) {
+		first, _ := utils.ParseDateString("2024-04-10")
+		second, _ := utils.ParseDateString("2024-04-11")
+
+		cr.On("FindById", uint(1)).Return(domain.WorkCalendar{ID: 1}, nil).Once()
+		cr.On("StoreHolidays", []domain.Holiday{
+			{CalendarID: 1, Date: first, Name: "Idul Fitri"},
+			{CalendarID: 1, Date: second, Name: "Idul Fitri"},
+		}).Return(nil).Once()
+
+		res, err := uc.ImportHolidays(1, strings.NewReader(data))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Imported)
+	})
+
+	t.Run("invalid file", func(t *testing.T) {
+		_, err := uc.ImportHolidays(1, strings.NewReader("not a calendar"))
+		assert.ErrorIs(t, err, ical.ErrInvalidCalendar)
+	})
+
+	t.Run("calendar not found", func(t *testing.T) {
+		cr.On("FindById", uint(2)).Return(domain.WorkCalendar{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.ImportHolidays(2, strings.NewReader(data))
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestCountWorkingDays(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewCalendarUsecase(er, cr, "/api")
+	logger.Init()
+
+	holiday, _ := utils.ParseDateString("2024-05-09")
+	calendar := domain.WorkCalendar{ID: 1, WorkingDays: "mon,tue,wed,thu,fri", Holidays: []domain.Holiday{{Date: holiday, Name: "Ascension Day"}}}
+
+	t.Run("success", func(t *testing.T) {
+		cr.On("FindById", uint(1)).Return(calendar, nil).Once()
+
+		res, err := uc.CountWorkingDays(1, "2024-05-06", "2024-05-12")
+		assert.NoError(t, err)
+		assert.Equal(t, 4, res.WorkingDays)
+	})
+
+	t.Run("to before from", func(t *testing.T) {
+		_, err := uc.CountWorkingDays(1, "2024-05-12", "2024-05-06")
+		assert.ErrorIs(t, err, ErrToBeforeFrom)
+	})
+
+	t.Run("full leap year", func(t *testing.T) {
+		cr.On("FindById", uint(1)).Return(calendar, nil).Once()
+
+		res, err := uc.CountWorkingDays(1, "2024-01-01", "2024-12-31")
+		assert.NoError(t, err)
+		assert.Equal(t, 261, res.WorkingDays)
+	})
+
+	t.Run("range too long", func(t *testing.T) {
+		_, err := uc.CountWorkingDays(1, "2024-01-01", "2025-01-01")
+		assert.ErrorIs(t, err, ErrRangeTooLong)
+
+		_, err = uc.CountWorkingDays(1, "0001-01-01", "9999-12-31")
+		assert.ErrorIs(t, err, ErrRangeTooLong)
+	})
+}
+
+func TestCheckEmployeeDate(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewCalendarUsecase(er, cr, "/api")
+	logger.Init()
+
+	calendarId := uint(1)
+	hireDate, _ := utils.ParseDateString("2024-05-09")
+	calendar := domain.WorkCalendar{ID: calendarId, WorkingDays: "mon,tue,wed,thu,fri", Holidays: []domain.Holiday{{Date: hireDate, Name: "Ascension Day"}}}
+
+	t.Run("hire date on holiday", func(t *testing.T) {
+		er.On("FindById", uint(1)).Return(domain.Employee{ID: 1, HireDate: hireDate, CalendarID: &calendarId}, nil).Once()
+		cr.On("FindById", calendarId).Return(calendar, nil).Once()
+
+		res, err := uc.CheckEmployeeDate(1, "")
+		assert.NoError(t, err)
+		assert.False(t, res.WorkingDay)
+		assert.Equal(t, "Ascension Day", res.Holiday)
+	})
+
+	t.Run("working day", func(t *testing.T) {
+		er.On("FindById", uint(1)).Return(domain.Employee{ID: 1, HireDate: hireDate, CalendarID: &calendarId}, nil).Once()
+		cr.On("FindById", calendarId).Return(calendar, nil).Once()
+
+		res, err := uc.CheckEmployeeDate(1, "2024-05-10")
+		assert.NoError(t, err)
+		assert.True(t, res.WorkingDay)
+	})
+
+	t.Run("no calendar", func(t *testing.T) {
+		er.On("FindById", uint(1)).Return(domain.Employee{ID: 1, HireDate: hireDate}, nil).Once()
+
+		_, err := uc.CheckEmployeeDate(1, "")
+		assert.ErrorIs(t, err, ErrNoCalendar)
+	})
+}
+
+func TestAssignCalendar(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewCalendarUsecase(er, cr, "/api")
+	logger.Init()
+
+	calendarId := uint(1)
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", uint(1)).Return(domain.Employee{ID: 1}, nil).Once()
+		cr.On("FindById", calendarId).Return(domain.WorkCalendar{ID: calendarId}, nil).Once()
+		er.On("UpdateCalendar", uint(1), &calendarId).Return(nil).Once()
+
+		res, err := uc.AssignCalendar(1, domain.CalendarAssignmentRequest{CalendarId: &calendarId})
+		assert.NoError(t, err)
+		assert.Equal(t, &calendarId, res.CalendarId)
+	})
+
+	t.Run("calendar not found", func(t *testing.T) {
+		er.On("FindById", uint(1)).Return(domain.Employee{ID: 1}, nil).Once()
+		cr.On("FindById", calendarId).Return(domain.WorkCalendar{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.AssignCalendar(1, domain.CalendarAssignmentRequest{CalendarId: &calendarId})
+		assert.ErrorIs(t, err, ErrCalendarNotFound)
+	})
+}
@@ -0,0 +1,239 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type ChecklistUsecase interface {
+	CreateChecklistItem(req domain.ChecklistItemRequest) (domain.ChecklistItemResponse, error)
+	GetChecklistItems(kind string) ([]domain.ChecklistItemResponse, error)
+	DeleteChecklistItem(id uint) error
+	GetEmployeeTasks(employeeId uint, kind string) ([]domain.TaskResponse, error)
+	GetOpenTasks(kind string) ([]domain.TaskResponse, error)
+	AssignTask(employeeId, taskId uint, req domain.TaskAssignmentRequest) (domain.TaskResponse, error)
+	CompleteTask(employeeId, taskId, completedById uint) (domain.TaskResponse, error)
+}
+
+type checklistUsecase struct {
+	employeeRepository  repositories.EmployeeRepository
+	checklistRepository repositories.ChecklistRepository
+}
+
+var (
+	ErrAssigneeNotFound     = errors.New("assignee not found")
+	ErrTaskAlreadyCompleted = errors.New("task is already completed")
+)
+
+func NewChecklistUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	checklistRepository repositories.ChecklistRepository,
+) ChecklistUsecase {
+	return &checklistUsecase{
+		employeeRepository:  employeeRepository,
+		checklistRepository: checklistRepository,
+	}
+}
+
+func (uc *checklistUsecase) CreateChecklistItem(req domain.ChecklistItemRequest) (domain.ChecklistItemResponse, error) {
+	if req.AssigneeId != nil {
+		if err := uc.findEmployee(*req.AssigneeId, ErrAssigneeNotFound); err != nil {
+			return domain.ChecklistItemResponse{}, err
+		}
+	}
+
+	item := domain.ChecklistItem{
+		Kind:        req.Kind,
+		Title:       req.Title,
+		Description: req.Description,
+		DueDays:     req.DueDays,
+		AssigneeID:  req.AssigneeId,
+	}
+
+	if err := uc.checklistRepository.StoreItem(&item); err != nil {
+		logger.Log.Error(err, "failed to store checklist item")
+		return domain.ChecklistItemResponse{}, err
+	}
+
+	logger.Log.Info("successfully create checklist item", "id", item.ID, "kind", item.Kind)
+	return toChecklistItemResponse(item), nil
+}
+
+func (uc *checklistUsecase) GetChecklistItems(kind string) ([]domain.ChecklistItemResponse, error) {
+	items, err := uc.checklistRepository.FindItems(kind)
+	if err != nil {
+		logger.Log.Error(err, "failed to find checklist items")
+		return nil, err
+	}
+
+	res := make([]domain.ChecklistItemResponse, 0, len(items))
+	for _, i := range items {
+		res = append(res, toChecklistItemResponse(i))
+	}
+
+	return res, nil
+}
+
+// DeleteChecklistItem removes the item from the template, tasks already
+// created from it are kept.
+func (uc *checklistUsecase) DeleteChecklistItem(id uint) error {
+	if err := uc.checklistRepository.DeleteItem(id); err != nil {
+		logger.Log.Error(err, "failed to delete checklist item")
+		return err
+	}
+
+	logger.Log.Info("successfully delete checklist item", "id", id)
+	return nil
+}
+
+// GetEmployeeTasks does not look up the employee so offboarding tasks of
+// deleted employees stay reachable.
+func (uc *checklistUsecase) GetEmployeeTasks(employeeId uint, kind string) ([]domain.TaskResponse, error) {
+	return uc.findTasks(domain.TaskFilter{EmployeeId: employeeId, Kind: kind})
+}
+
+func (uc *checklistUsecase) GetOpenTasks(kind string) ([]domain.TaskResponse, error) {
+	return uc.findTasks(domain.TaskFilter{Kind: kind, OpenOnly: true})
+}
+
+func (uc *checklistUsecase) AssignTask(employeeId, taskId uint, req domain.TaskAssignmentRequest) (domain.TaskResponse, error) {
+	task, err := uc.findTask(employeeId, taskId)
+	if err != nil {
+		return domain.TaskResponse{}, err
+	}
+
+	if req.AssigneeId != nil {
+		if err := uc.findEmployee(*req.AssigneeId, ErrAssigneeNotFound); err != nil {
+			return domain.TaskResponse{}, err
+		}
+	}
+
+	task.AssigneeID = req.AssigneeId
+	return uc.updateTask(task)
+}
+
+// CompleteTask records completedById, the employee of the API key, as the
+// one who completed the task.
+func (uc *checklistUsecase) CompleteTask(employeeId, taskId, completedById uint) (domain.TaskResponse, error) {
+	task, err := uc.findTask(employeeId, taskId)
+	if err != nil {
+		return domain.TaskResponse{}, err
+	}
+
+	if task.CompletedAt != nil {
+		return domain.TaskResponse{}, ErrTaskAlreadyCompleted
+	}
+
+	now := time.Now().UTC()
+	task.CompletedAt = &now
+	task.CompletedByID = &completedById
+
+	return uc.updateTask(task)
+}
+
+func (uc *checklistUsecase) findTasks(filter domain.TaskFilter) ([]domain.TaskResponse, error) {
+	tasks, err := uc.checklistRepository.FindTasks(filter)
+	if err != nil {
+		logger.Log.Error(err, "failed to find tasks")
+		return nil, err
+	}
+
+	today := utils.Today()
+	res := make([]domain.TaskResponse, 0, len(tasks))
+	for _, t := range tasks {
+		res = append(res, toTaskResponse(t, today))
+	}
+
+	return res, nil
+}
+
+// findTask only finds tasks of the employee in the path.
+func (uc *checklistUsecase) findTask(employeeId, taskId uint) (domain.EmployeeTask, error) {
+	task, err := uc.checklistRepository.FindTaskById(taskId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find task by id")
+		return domain.EmployeeTask{}, err
+	}
+
+	if task.EmployeeID != employeeId {
+		return domain.EmployeeTask{}, repositories.ErrRecordNotFound
+	}
+
+	return task, nil
+}
+
+// findEmployee returns notFound when the employee does not exist or was
+// deleted.
+func (uc *checklistUsecase) findEmployee(id uint, notFound error) error {
+	_, err := uc.employeeRepository.FindById(id)
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return notFound
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return err
+	}
+
+	return nil
+}
+
+func (uc *checklistUsecase) updateTask(task domain.EmployeeTask) (domain.TaskResponse, error) {
+	if err := uc.checklistRepository.UpdateTask(&task); err != nil {
+		logger.Log.Error(err, "failed to update task")
+		return domain.TaskResponse{}, err
+	}
+
+	logger.Log.Info("successfully update task", "id", task.ID, "employeeId", task.EmployeeID)
+	return toTaskResponse(task, utils.Today()), nil
+}
+
+// checklistTasks builds the tasks of the kind template due from baseDate.
+// The employee repository sets the employee when it stores them with the
+// employee change that starts the checklist.
+func checklistTasks(cr repositories.ChecklistRepository, kind string, baseDate time.Time) ([]domain.EmployeeTask, error) {
+	items, err := cr.FindItems(kind)
+	if err != nil {
+		logger.Log.Error(err, "failed to find checklist items", "kind", kind)
+		return nil, err
+	}
+
+	tasks := make([]domain.EmployeeTask, 0, len(items))
+	for _, item := range items {
+		tasks = append(tasks, item.NewTask(0, baseDate))
+	}
+
+	return tasks, nil
+}
+
+func toChecklistItemResponse(i domain.ChecklistItem) domain.ChecklistItemResponse {
+	return domain.ChecklistItemResponse{
+		Id:          i.ID,
+		Kind:        i.Kind,
+		Title:       i.Title,
+		Description: i.Description,
+		DueDays:     i.DueDays,
+		AssigneeId:  i.AssigneeID,
+	}
+}
+
+func toTaskResponse(t domain.EmployeeTask, today time.Time) domain.TaskResponse {
+	return domain.TaskResponse{
+		Id:            t.ID,
+		EmployeeId:    t.EmployeeID,
+		Kind:          t.Kind,
+		Title:         t.Title,
+		Description:   t.Description,
+		DueDate:       t.DueDate,
+		AssigneeId:    t.AssigneeID,
+		Completed:     t.CompletedAt != nil,
+		Overdue:       t.Overdue(today),
+		CompletedAt:   t.CompletedAt,
+		CompletedById: t.CompletedByID,
+	}
+}
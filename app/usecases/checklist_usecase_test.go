@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateChecklistItem(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	uc := NewChecklistUsecase(er, cr)
+	logger.Init()
+
+	assigneeId := uint(9)
+	req := domain.ChecklistItemRequest{Kind: domain.ChecklistOffboarding, Title: "Revoke badge", AssigneeId: &assigneeId}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", assigneeId).Return(domain.Employee{ID: assigneeId}, nil).Once()
+		cr.On("StoreItem", &domain.ChecklistItem{Kind: req.Kind, Title: req.Title, AssigneeID: &assigneeId}).Return(nil).Once()
+
+		res, err := uc.CreateChecklistItem(req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Revoke badge", res.Title)
+	})
+
+	t.Run("assignee not found", func(t *testing.T) {
+		er.On("FindById", assigneeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.CreateChecklistItem(req)
+		assert.ErrorIs(t, err, ErrAssigneeNotFound)
+	})
+}
+
+func TestChecklistTasks(t *testing.T) {
+	cr := mocks.NewChecklistRepository(t)
+	logger.Init()
+
+	hireDate := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("due dates from base date", func(t *testing.T) {
+		cr.On("FindItems", domain.ChecklistOnboarding).Return([]domain.ChecklistItem{
+			{ID: 1, Kind: domain.ChecklistOnboarding, Title: "Prepare laptop", DueDays: -3},
+			{ID: 2, Kind: domain.ChecklistOnboarding, Title: "Sign contract", DueDays: 0},
+			{ID: 3, Kind: domain.ChecklistOnboarding, Title: "Create accounts", DueDays: 2},
+		}, nil).Once()
+
+		tasks, err := checklistTasks(cr, domain.ChecklistOnboarding, hireDate)
+		assert.NoError(t, err)
+		assert.Len(t, tasks, 3)
+		assert.Equal(t, time.Date(2024, 6, 28, 0, 0, 0, 0, time.UTC), tasks[0].DueDate)
+		assert.Equal(t, hireDate, tasks[1].DueDate)
+		assert.Equal(t, time.Date(2024, 7, 3, 0, 0, 0, 0, time.UTC), tasks[2].DueDate)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		cr.On("FindItems", domain.ChecklistOnboarding).Return(nil, errors.New("error")).Once()
+
+		_, err := checklistTasks(cr, domain.ChecklistOnboarding, hireDate)
+		assert.Error(t, err)
+	})
+}
+
+func TestCompleteTask(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	uc := NewChecklistUsecase(er, cr)
+	logger.Init()
+
+	employeeId, taskId, completerId := uint(1), uint(2), uint(3)
+	task := domain.EmployeeTask{ID: taskId, EmployeeID: employeeId, Title: "Revoke badge", DueDate: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("success", func(t *testing.T) {
+		cr.On("FindTaskById", taskId).Return(task, nil).Once()
+		cr.On("UpdateTask", mock.MatchedBy(func(t *domain.EmployeeTask) bool {
+			return t.CompletedAt != nil && *t.CompletedByID == completerId
+		})).Return(nil).Once()
+
+		res, err := uc.CompleteTask(employeeId, taskId, completerId)
+		assert.NoError(t, err)
+		assert.True(t, res.Completed)
+		assert.False(t, res.Overdue)
+	})
+
+	t.Run("already completed", func(t *testing.T) {
+		completed := task
+		now := time.Now()
+		completed.CompletedAt = &now
+		cr.On("FindTaskById", taskId).Return(completed, nil).Once()
+
+		_, err := uc.CompleteTask(employeeId, taskId, completerId)
+		assert.ErrorIs(t, err, ErrTaskAlreadyCompleted)
+	})
+
+	t.Run("task of another employee", func(t *testing.T) {
+		cr.On("FindTaskById", taskId).Return(task, nil).Once()
+
+		_, err := uc.CompleteTask(4, taskId, completerId)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestAssignTask(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	uc := NewChecklistUsecase(er, cr)
+	logger.Init()
+
+	employeeId, taskId, assigneeId := uint(1), uint(2), uint(3)
+	task := domain.EmployeeTask{ID: taskId, EmployeeID: employeeId, DueDate: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("success", func(t *testing.T) {
+		cr.On("FindTaskById", taskId).Return(task, nil).Once()
+		er.On("FindById", assigneeId).Return(domain.Employee{ID: assigneeId}, nil).Once()
+		cr.On("UpdateTask", mock.MatchedBy(func(t *domain.EmployeeTask) bool {
+			return *t.AssigneeID == assigneeId
+		})).Return(nil).Once()
+
+		res, err := uc.AssignTask(employeeId, taskId, domain.TaskAssignmentRequest{AssigneeId: &assigneeId})
+		assert.NoError(t, err)
+		assert.Equal(t, &assigneeId, res.AssigneeId)
+		assert.True(t, res.Overdue)
+	})
+
+	t.Run("assignee not found", func(t *testing.T) {
+		cr.On("FindTaskById", taskId).Return(task, nil).Once()
+		er.On("FindById", assigneeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.AssignTask(employeeId, taskId, domain.TaskAssignmentRequest{AssigneeId: &assigneeId})
+		assert.ErrorIs(t, err, ErrAssigneeNotFound)
+	})
+}
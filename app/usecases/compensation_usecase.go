@@ -0,0 +1,194 @@
+package usecases
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type CompensationUsecase interface {
+	AddCompensation(employeeId uint, req domain.CompensationRequest, createdBy string) (domain.CompensationResponse, error)
+	GetCompensationHistory(employeeId uint) ([]domain.CompensationResponse, error)
+	GetCompensationOnDate(employeeId uint, date string) (domain.CompensationResponse, error)
+}
+
+type compensationUsecase struct {
+	employeeRepository     repositories.EmployeeRepository
+	compensationRepository repositories.CompensationRepository
+}
+
+var (
+	ErrCompensationBeforeHire    = errors.New("effective date is before hire date")
+	ErrDuplicateCompensationDate = errors.New("a compensation record with this effective date already exists")
+	ErrCompensationNotFound      = errors.New("no compensation effective on this date")
+)
+
+func NewCompensationUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	compensationRepository repositories.CompensationRepository,
+) CompensationUsecase {
+	return &compensationUsecase{
+		employeeRepository:     employeeRepository,
+		compensationRepository: compensationRepository,
+	}
+}
+
+func (uc *compensationUsecase) AddCompensation(employeeId uint, req domain.CompensationRequest, createdBy string) (domain.CompensationResponse, error) {
+	effectiveDate, err := utils.ParseDateString(req.EffectiveDate)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse Effective Date")
+		return domain.CompensationResponse{}, ErrInvalidDate
+	}
+
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.CompensationResponse{}, err
+	}
+
+	if effectiveDate.Before(utils.CivilDate(employee.HireDate)) {
+		return domain.CompensationResponse{}, ErrCompensationBeforeHire
+	}
+
+	history, err := uc.compensationRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find compensation history")
+		return domain.CompensationResponse{}, err
+	}
+
+	for _, c := range history {
+		if c.EffectiveDate.Equal(effectiveDate) {
+			return domain.CompensationResponse{}, ErrDuplicateCompensationDate
+		}
+	}
+
+	compensation := domain.Compensation{
+		EmployeeID:    employeeId,
+		BaseSalary:    req.BaseSalary,
+		Currency:      req.Currency,
+		PayFrequency:  req.PayFrequency,
+		EffectiveDate: effectiveDate,
+		Reason:        req.Reason,
+		CreatedBy:     createdBy,
+	}
+
+	if err := uc.compensationRepository.Store(&compensation); err != nil {
+		logger.Log.Error(err, "failed to store compensation")
+		return domain.CompensationResponse{}, err
+	}
+
+	logger.Log.Info("successfully add compensation", "employeeId", employeeId, "createdBy", createdBy)
+
+	var previous *domain.Compensation
+	for i := range history {
+		if history[i].EffectiveDate.Before(effectiveDate) {
+			previous = &history[i]
+		}
+	}
+
+	return toCompensationResponse(compensation, previous), nil
+}
+
+// GetCompensationHistory returns the pay history of the employee ordered by
+// effective date, each record carries the change from the previous one.
+func (uc *compensationUsecase) GetCompensationHistory(employeeId uint) ([]domain.CompensationResponse, error) {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return nil, err
+	}
+
+	history, err := uc.compensationRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find compensation history")
+		return nil, err
+	}
+
+	return toCompensationResponses(history), nil
+}
+
+// GetCompensationOnDate returns the record in effect on date, today when date
+// is empty.
+func (uc *compensationUsecase) GetCompensationOnDate(employeeId uint, date string) (domain.CompensationResponse, error) {
+	onDate := utils.Today()
+	if date != "" {
+		parsed, err := utils.ParseDateString(date)
+		if err != nil {
+			logger.Log.Error(err, "failed to parse date")
+			return domain.CompensationResponse{}, ErrInvalidDate
+		}
+
+		onDate = parsed
+	}
+
+	history, err := uc.GetCompensationHistory(employeeId)
+	if err != nil {
+		return domain.CompensationResponse{}, err
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if !history[i].EffectiveDate.After(onDate) {
+			return history[i], nil
+		}
+	}
+
+	return domain.CompensationResponse{}, ErrCompensationNotFound
+}
+
+// toCompensationResponses expects history ordered by effective date.
+func toCompensationResponses(history []domain.Compensation) []domain.CompensationResponse {
+	res := make([]domain.CompensationResponse, 0, len(history))
+	for i, c := range history {
+		var previous *domain.Compensation
+		if i > 0 {
+			previous = &history[i-1]
+		}
+
+		res = append(res, toCompensationResponse(c, previous))
+	}
+
+	return res
+}
+
+func toCompensationResponse(c domain.Compensation, previous *domain.Compensation) domain.CompensationResponse {
+	return domain.CompensationResponse{
+		Id:            c.ID,
+		EmployeeId:    c.EmployeeID,
+		BaseSalary:    c.BaseSalary,
+		Currency:      c.Currency,
+		PayFrequency:  c.PayFrequency,
+		EffectiveDate: c.EffectiveDate,
+		Reason:        c.Reason,
+		ChangePercent: changePercent(previous, c),
+		CreatedBy:     c.CreatedBy,
+		CreatedAt:     c.CreatedAt,
+	}
+}
+
+// changePercent compares the salary with the previous record, raises are
+// only comparable within the same currency and pay frequency.
+func changePercent(previous *domain.Compensation, current domain.Compensation) *string {
+	if previous == nil || previous.Currency != current.Currency || previous.PayFrequency != current.PayFrequency {
+		return nil
+	}
+
+	before, ok := new(big.Rat).SetString(previous.BaseSalary)
+	if !ok || before.Sign() == 0 {
+		return nil
+	}
+
+	after, ok := new(big.Rat).SetString(current.BaseSalary)
+	if !ok {
+		return nil
+	}
+
+	change := new(big.Rat).Sub(after, before)
+	change.Quo(change, before)
+	change.Mul(change, big.NewRat(100, 1))
+
+	percent := change.FloatString(2)
+	return &percent
+}
@@ -0,0 +1,132 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCompensation(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCompensationRepository(t)
+	uc := NewCompensationUsecase(er, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+	hireDate, _ := utils.ParseDateString("2022-01-03")
+	firstDate, _ := utils.ParseDateString("2022-01-03")
+	raiseDate, _ := utils.ParseDateString("2023-01-01")
+
+	employee := domain.Employee{ID: employeeId, HireDate: hireDate}
+	history := []domain.Compensation{
+		{ID: 1, EmployeeID: employeeId, BaseSalary: "8000000.00", Currency: "IDR", PayFrequency: "monthly", EffectiveDate: firstDate},
+	}
+
+	req := domain.CompensationRequest{
+		BaseSalary:    "8800000",
+		Currency:      "IDR",
+		PayFrequency:  "monthly",
+		EffectiveDate: "2023-01-01",
+		Reason:        "annual raise",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		cr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+		cr.On("Store", &domain.Compensation{
+			EmployeeID:    employeeId,
+			BaseSalary:    "8800000",
+			Currency:      "IDR",
+			PayFrequency:  "monthly",
+			EffectiveDate: raiseDate,
+			Reason:        "annual raise",
+			CreatedBy:     "payroll",
+		}).Return(nil).Once()
+
+		res, err := uc.AddCompensation(employeeId, req, "payroll")
+		assert.NoError(t, err)
+		assert.Equal(t, "IDR", res.Currency)
+		assert.Equal(t, "10.00", *res.ChangePercent)
+	})
+
+	t.Run("duplicate effective date", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		cr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		r := req
+		r.EffectiveDate = "2022-01-03"
+		_, err := uc.AddCompensation(employeeId, r, "payroll")
+		assert.ErrorIs(t, err, ErrDuplicateCompensationDate)
+	})
+
+	t.Run("effective before hire date", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+
+		r := req
+		r.EffectiveDate = "2021-12-31"
+		_, err := uc.AddCompensation(employeeId, r, "payroll")
+		assert.ErrorIs(t, err, ErrCompensationBeforeHire)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		er.On("FindById", uint(2)).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.AddCompensation(uint(2), req, "payroll")
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestGetCompensationOnDate(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewCompensationRepository(t)
+	uc := NewCompensationUsecase(er, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+	firstDate, _ := utils.ParseDateString("2022-01-03")
+	raiseDate, _ := utils.ParseDateString("2023-01-01")
+
+	history := []domain.Compensation{
+		{ID: 1, EmployeeID: employeeId, BaseSalary: "5000", Currency: "USD", PayFrequency: "monthly", EffectiveDate: firstDate},
+		{ID: 2, EmployeeID: employeeId, BaseSalary: "5500", Currency: "USD", PayFrequency: "monthly", EffectiveDate: raiseDate},
+	}
+
+	t.Run("before raise", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		res, err := uc.GetCompensationOnDate(employeeId, "2022-12-31")
+		assert.NoError(t, err)
+		assert.Equal(t, "5000", res.BaseSalary)
+		assert.Nil(t, res.ChangePercent)
+	})
+
+	t.Run("on raise", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		res, err := uc.GetCompensationOnDate(employeeId, "2023-01-01")
+		assert.NoError(t, err)
+		assert.Equal(t, "5500", res.BaseSalary)
+		assert.Equal(t, "10.00", *res.ChangePercent)
+	})
+
+	t.Run("before first record", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		_, err := uc.GetCompensationOnDate(employeeId, "2021-01-01")
+		assert.ErrorIs(t, err, ErrCompensationNotFound)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := uc.GetCompensationOnDate(employeeId, "01-01-2023")
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
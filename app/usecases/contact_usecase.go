@@ -0,0 +1,296 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+type ContactUsecase interface {
+	GetContacts(employeeId uint) (domain.ContactsResponse, error)
+	AddPhone(employeeId uint, req domain.PhoneRequest) (domain.PhoneResponse, error)
+	UpdatePhone(employeeId, id uint, req domain.PhoneRequest) (domain.PhoneResponse, error)
+	DeletePhone(employeeId, id uint) error
+	AddAddress(employeeId uint, req domain.AddressRequest) (domain.AddressResponse, error)
+	UpdateAddress(employeeId, id uint, req domain.AddressRequest) (domain.AddressResponse, error)
+	DeleteAddress(employeeId, id uint) error
+	AddEmergencyContact(employeeId uint, req domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error)
+	UpdateEmergencyContact(employeeId, id uint, req domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error)
+	DeleteEmergencyContact(employeeId, id uint) error
+}
+
+type contactUsecase struct {
+	employeeRepository repositories.EmployeeRepository
+	contactRepository  repositories.ContactRepository
+}
+
+var (
+	ErrContactNotFound      = errors.New("contact not found")
+	ErrDuplicateAddressType = errors.New("employee already has an address of this type")
+)
+
+func NewContactUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	contactRepository repositories.ContactRepository,
+) ContactUsecase {
+	return &contactUsecase{
+		employeeRepository: employeeRepository,
+		contactRepository:  contactRepository,
+	}
+}
+
+func (uc *contactUsecase) GetContacts(employeeId uint) (domain.ContactsResponse, error) {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return domain.ContactsResponse{}, err
+	}
+
+	phones, err := uc.contactRepository.FindPhones(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find phones")
+		return domain.ContactsResponse{}, err
+	}
+
+	addresses, err := uc.contactRepository.FindAddresses(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find addresses")
+		return domain.ContactsResponse{}, err
+	}
+
+	contacts, err := uc.contactRepository.FindEmergencyContacts(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find emergency contacts")
+		return domain.ContactsResponse{}, err
+	}
+
+	res := domain.ContactsResponse{
+		EmployeeId:        employeeId,
+		Phones:            make([]domain.PhoneResponse, 0, len(phones)),
+		Addresses:         make([]domain.AddressResponse, 0, len(addresses)),
+		EmergencyContacts: make([]domain.EmergencyContactResponse, 0, len(contacts)),
+	}
+
+	for _, p := range phones {
+		res.Phones = append(res.Phones, toPhoneResponse(p))
+	}
+
+	for _, a := range addresses {
+		res.Addresses = append(res.Addresses, toAddressResponse(a))
+	}
+
+	for _, c := range contacts {
+		res.EmergencyContacts = append(res.EmergencyContacts, toEmergencyContactResponse(c))
+	}
+
+	return res, nil
+}
+
+func (uc *contactUsecase) AddPhone(employeeId uint, req domain.PhoneRequest) (domain.PhoneResponse, error) {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return domain.PhoneResponse{}, err
+	}
+
+	phone := domain.Phone{EmployeeID: employeeId, Type: req.Type, Number: req.Number}
+	if err := uc.contactRepository.StorePhone(&phone); err != nil {
+		logger.Log.Error(err, "failed to store phone")
+		return domain.PhoneResponse{}, err
+	}
+
+	logger.Log.Info("successfully add phone", "id", phone.ID, "employeeId", employeeId)
+	return toPhoneResponse(phone), nil
+}
+
+func (uc *contactUsecase) UpdatePhone(employeeId, id uint, req domain.PhoneRequest) (domain.PhoneResponse, error) {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return domain.PhoneResponse{}, err
+	}
+
+	phone := domain.Phone{ID: id, EmployeeID: employeeId, Type: req.Type, Number: req.Number}
+	if err := uc.contactRepository.UpdatePhone(&phone); err != nil {
+		return domain.PhoneResponse{}, contactError(err, "failed to update phone")
+	}
+
+	logger.Log.Info("successfully update phone", "id", id, "employeeId", employeeId)
+	return toPhoneResponse(phone), nil
+}
+
+func (uc *contactUsecase) DeletePhone(employeeId, id uint) error {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return err
+	}
+
+	if err := uc.contactRepository.DeletePhone(employeeId, id); err != nil {
+		return contactError(err, "failed to delete phone")
+	}
+
+	logger.Log.Info("successfully delete phone", "id", id, "employeeId", employeeId)
+	return nil
+}
+
+func (uc *contactUsecase) AddAddress(employeeId uint, req domain.AddressRequest) (domain.AddressResponse, error) {
+	return uc.saveAddress(employeeId, 0, req)
+}
+
+func (uc *contactUsecase) UpdateAddress(employeeId, id uint, req domain.AddressRequest) (domain.AddressResponse, error) {
+	return uc.saveAddress(employeeId, id, req)
+}
+
+// saveAddress stores a new address when id is 0 and updates it otherwise.
+func (uc *contactUsecase) saveAddress(employeeId, id uint, req domain.AddressRequest) (domain.AddressResponse, error) {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return domain.AddressResponse{}, err
+	}
+
+	found, err := uc.contactRepository.FindAddressByType(employeeId, req.Type)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find address by type")
+		return domain.AddressResponse{}, err
+	}
+
+	if found.ID != 0 && found.ID != id {
+		return domain.AddressResponse{}, ErrDuplicateAddressType
+	}
+
+	address := domain.Address{
+		ID:         id,
+		EmployeeID: employeeId,
+		Type:       req.Type,
+		Line1:      req.Line1,
+		Line2:      req.Line2,
+		City:       req.City,
+		Region:     req.Region,
+		PostalCode: req.PostalCode,
+		Country:    req.Country,
+	}
+
+	if id == 0 {
+		err = uc.contactRepository.StoreAddress(&address)
+	} else {
+		err = uc.contactRepository.UpdateAddress(&address)
+	}
+
+	if err != nil {
+		return domain.AddressResponse{}, contactError(err, "failed to save address")
+	}
+
+	logger.Log.Info("successfully save address", "id", address.ID, "employeeId", employeeId)
+	return toAddressResponse(address), nil
+}
+
+func (uc *contactUsecase) DeleteAddress(employeeId, id uint) error {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return err
+	}
+
+	if err := uc.contactRepository.DeleteAddress(employeeId, id); err != nil {
+		return contactError(err, "failed to delete address")
+	}
+
+	logger.Log.Info("successfully delete address", "id", id, "employeeId", employeeId)
+	return nil
+}
+
+func (uc *contactUsecase) AddEmergencyContact(employeeId uint, req domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error) {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return domain.EmergencyContactResponse{}, err
+	}
+
+	contact := toEmergencyContact(employeeId, 0, req)
+	if err := uc.contactRepository.StoreEmergencyContact(&contact); err != nil {
+		logger.Log.Error(err, "failed to store emergency contact")
+		return domain.EmergencyContactResponse{}, err
+	}
+
+	logger.Log.Info("successfully add emergency contact", "id", contact.ID, "employeeId", employeeId)
+	return toEmergencyContactResponse(contact), nil
+}
+
+func (uc *contactUsecase) UpdateEmergencyContact(employeeId, id uint, req domain.EmergencyContactRequest) (domain.EmergencyContactResponse, error) {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return domain.EmergencyContactResponse{}, err
+	}
+
+	contact := toEmergencyContact(employeeId, id, req)
+	if err := uc.contactRepository.UpdateEmergencyContact(&contact); err != nil {
+		return domain.EmergencyContactResponse{}, contactError(err, "failed to update emergency contact")
+	}
+
+	logger.Log.Info("successfully update emergency contact", "id", id, "employeeId", employeeId)
+	return toEmergencyContactResponse(contact), nil
+}
+
+func (uc *contactUsecase) DeleteEmergencyContact(employeeId, id uint) error {
+	if err := uc.findEmployee(employeeId); err != nil {
+		return err
+	}
+
+	if err := uc.contactRepository.DeleteEmergencyContact(employeeId, id); err != nil {
+		return contactError(err, "failed to delete emergency contact")
+	}
+
+	logger.Log.Info("successfully delete emergency contact", "id", id, "employeeId", employeeId)
+	return nil
+}
+
+func (uc *contactUsecase) findEmployee(employeeId uint) error {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return err
+	}
+
+	return nil
+}
+
+// contactError tells a missing contact apart from a missing employee, both
+// are ErrRecordNotFound in the repositories.
+func contactError(err error, msg string) error {
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return ErrContactNotFound
+	}
+
+	logger.Log.Error(err, msg)
+	return err
+}
+
+func toEmergencyContact(employeeId, id uint, req domain.EmergencyContactRequest) domain.EmergencyContact {
+	return domain.EmergencyContact{
+		ID:           id,
+		EmployeeID:   employeeId,
+		Name:         req.Name,
+		Relationship: req.Relationship,
+		Phone:        req.Phone,
+		Email:        req.Email,
+	}
+}
+
+func toPhoneResponse(p domain.Phone) domain.PhoneResponse {
+	return domain.PhoneResponse{
+		Id:     p.ID,
+		Type:   p.Type,
+		Number: p.Number,
+	}
+}
+
+func toAddressResponse(a domain.Address) domain.AddressResponse {
+	return domain.AddressResponse{
+		Id:         a.ID,
+		Type:       a.Type,
+		Line1:      a.Line1,
+		Line2:      a.Line2,
+		City:       a.City,
+		Region:     a.Region,
+		PostalCode: a.PostalCode,
+		Country:    a.Country,
+	}
+}
+
+func toEmergencyContactResponse(c domain.EmergencyContact) domain.EmergencyContactResponse {
+	return domain.EmergencyContactResponse{
+		Id:           c.ID,
+		Name:         c.Name,
+		Relationship: c.Relationship,
+		Phone:        c.Phone,
+		Email:        c.Email,
+	}
+}
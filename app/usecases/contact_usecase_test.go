@@ -0,0 +1,146 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetContacts(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewContactRepository(t)
+	uc := NewContactUsecase(er, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindPhones", employeeId).Return([]domain.Phone{{ID: 1, Type: domain.PhoneTypeMobile, Number: "+6281234567890"}}, nil).Once()
+		cr.On("FindAddresses", employeeId).Return(nil, nil).Once()
+		cr.On("FindEmergencyContacts", employeeId).Return([]domain.EmergencyContact{{ID: 2, Name: "Siti"}}, nil).Once()
+
+		res, err := uc.GetContacts(employeeId)
+		assert.NoError(t, err)
+		assert.Len(t, res.Phones, 1)
+		assert.NotNil(t, res.Addresses)
+		assert.Empty(t, res.Addresses)
+		assert.Equal(t, "Siti", res.EmergencyContacts[0].Name)
+	})
+
+	t.Run("deleted employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.GetContacts(employeeId)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestPhoneContacts(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewContactRepository(t)
+	uc := NewContactUsecase(er, cr)
+	logger.Init()
+
+	employeeId, phoneId := uint(1), uint(3)
+	req := domain.PhoneRequest{Type: domain.PhoneTypeWork, Number: "+6221555123"}
+
+	t.Run("add", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("StorePhone", &domain.Phone{EmployeeID: employeeId, Type: req.Type, Number: req.Number}).Return(nil).Once()
+
+		res, err := uc.AddPhone(employeeId, req)
+		assert.NoError(t, err)
+		assert.Equal(t, req.Number, res.Number)
+	})
+
+	t.Run("update phone of another employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("UpdatePhone", &domain.Phone{ID: phoneId, EmployeeID: employeeId, Type: req.Type, Number: req.Number}).
+			Return(repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.UpdatePhone(employeeId, phoneId, req)
+		assert.ErrorIs(t, err, ErrContactNotFound)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("DeletePhone", employeeId, phoneId).Return(nil).Once()
+
+		err := uc.DeletePhone(employeeId, phoneId)
+		assert.NoError(t, err)
+	})
+}
+
+func TestAddressContacts(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewContactRepository(t)
+	uc := NewContactUsecase(er, cr)
+	logger.Init()
+
+	employeeId, addressId := uint(1), uint(4)
+	req := domain.AddressRequest{Type: domain.AddressTypeHome, Line1: "Jl. Sudirman 1", City: "Jakarta", Country: "ID"}
+
+	t.Run("add", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindAddressByType", employeeId, domain.AddressTypeHome).Return(domain.Address{}, repositories.ErrRecordNotFound).Once()
+		cr.On("StoreAddress", &domain.Address{EmployeeID: employeeId, Type: req.Type, Line1: req.Line1, City: req.City, Country: req.Country}).
+			Return(nil).Once()
+
+		res, err := uc.AddAddress(employeeId, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Jakarta", res.City)
+	})
+
+	t.Run("add duplicate type", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindAddressByType", employeeId, domain.AddressTypeHome).Return(domain.Address{ID: addressId}, nil).Once()
+
+		_, err := uc.AddAddress(employeeId, req)
+		assert.ErrorIs(t, err, ErrDuplicateAddressType)
+	})
+
+	t.Run("update keeps its own type", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("FindAddressByType", employeeId, domain.AddressTypeHome).Return(domain.Address{ID: addressId}, nil).Once()
+		cr.On("UpdateAddress", &domain.Address{ID: addressId, EmployeeID: employeeId, Type: req.Type, Line1: req.Line1, City: req.City, Country: req.Country}).
+			Return(nil).Once()
+
+		res, err := uc.UpdateAddress(employeeId, addressId, req)
+		assert.NoError(t, err)
+		assert.Equal(t, addressId, res.Id)
+	})
+}
+
+func TestEmergencyContacts(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewContactRepository(t)
+	uc := NewContactUsecase(er, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+	req := domain.EmergencyContactRequest{Name: "Siti Aminah", Relationship: "spouse", Phone: "+6281234567890"}
+
+	t.Run("add", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("StoreEmergencyContact", &domain.EmergencyContact{EmployeeID: employeeId, Name: req.Name, Relationship: req.Relationship, Phone: req.Phone}).
+			Return(nil).Once()
+
+		res, err := uc.AddEmergencyContact(employeeId, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "spouse", res.Relationship)
+	})
+
+	t.Run("delete missing", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		cr.On("DeleteEmergencyContact", employeeId, uint(9)).Return(repositories.ErrRecordNotFound).Once()
+
+		err := uc.DeleteEmergencyContact(employeeId, 9)
+		assert.ErrorIs(t, err, ErrContactNotFound)
+	})
+}
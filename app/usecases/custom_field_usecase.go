@@ -0,0 +1,119 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+type CustomFieldUsecase interface {
+	CreateCustomField(req domain.CustomFieldRequest) (domain.CustomFieldResponse, error)
+	GetAllCustomField() ([]domain.CustomFieldResponse, error)
+	DeleteCustomField(id uint) error
+}
+
+type customFieldUsecase struct {
+	customFieldRepository repositories.CustomFieldRepository
+}
+
+var (
+	ErrDuplicateCustomField = errors.New("custom field name already exists")
+	ErrUnknownCustomField   = errors.New("unknown custom field")
+	ErrMissingCustomField   = errors.New("missing required custom field")
+)
+
+func NewCustomFieldUsecase(customFieldRepository repositories.CustomFieldRepository) CustomFieldUsecase {
+	return &customFieldUsecase{
+		customFieldRepository: customFieldRepository,
+	}
+}
+
+func (uc *customFieldUsecase) CreateCustomField(req domain.CustomFieldRequest) (domain.CustomFieldResponse, error) {
+	found, err := uc.customFieldRepository.FindByName(req.Name)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find custom field by name")
+		return domain.CustomFieldResponse{}, err
+	}
+
+	if found.ID != 0 {
+		return domain.CustomFieldResponse{}, ErrDuplicateCustomField
+	}
+
+	field := domain.CustomField{
+		Name:     req.Name,
+		Label:    req.Label,
+		Type:     req.Type,
+		Required: req.Required,
+		Pattern:  req.Pattern,
+		Options:  req.Options,
+	}
+
+	if err := uc.customFieldRepository.Store(&field); err != nil {
+		logger.Log.Error(err, "failed to store custom field")
+		return domain.CustomFieldResponse{}, err
+	}
+
+	logger.Log.Info("successfully create custom field", "id", field.ID, "name", field.Name)
+	return toCustomFieldResponse(field), nil
+}
+
+func (uc *customFieldUsecase) GetAllCustomField() ([]domain.CustomFieldResponse, error) {
+	fields, err := uc.customFieldRepository.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all custom field")
+		return nil, err
+	}
+
+	res := make([]domain.CustomFieldResponse, 0, len(fields))
+	for _, f := range fields {
+		res = append(res, toCustomFieldResponse(f))
+	}
+
+	return res, nil
+}
+
+func (uc *customFieldUsecase) DeleteCustomField(id uint) error {
+	if err := uc.customFieldRepository.DeleteById(id); err != nil {
+		logger.Log.Error(err, "failed to delete custom field")
+		return err
+	}
+
+	logger.Log.Info("successfully delete custom field", "id", id)
+	return nil
+}
+
+// customFieldsByName loads the field definitions keyed by name with their
+// patterns compiled.
+func customFieldsByName(cr repositories.CustomFieldRepository) (map[string]domain.CustomField, error) {
+	fields, err := cr.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all custom field")
+		return nil, err
+	}
+
+	byName := make(map[string]domain.CustomField, len(fields))
+	for _, f := range fields {
+		if err := f.CompilePattern(); err != nil {
+			logger.Log.Error(err, "failed to compile custom field pattern", "name", f.Name)
+			return nil, err
+		}
+
+		byName[f.Name] = f
+	}
+
+	return byName, nil
+}
+
+func toCustomFieldResponse(f domain.CustomField) domain.CustomFieldResponse {
+	return domain.CustomFieldResponse{
+		Id:       f.ID,
+		Name:     f.Name,
+		Label:    f.Label,
+		Type:     f.Type,
+		Required: f.Required,
+		Pattern:  f.Pattern,
+		Options:  f.Options,
+	}
+}
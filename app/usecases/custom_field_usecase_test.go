@@ -0,0 +1,108 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateCustomField(t *testing.T) {
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewCustomFieldUsecase(fr)
+	logger.Init()
+
+	req := domain.CustomFieldRequest{Name: "shirt_size", Label: "Shirt size", Type: domain.CustomFieldEnum, Options: []string{"S", "M"}}
+
+	t.Run("success", func(t *testing.T) {
+		fr.On("FindByName", "shirt_size").Return(domain.CustomField{}, repositories.ErrRecordNotFound).Once()
+		fr.On("Store", &domain.CustomField{
+			Name:    "shirt_size",
+			Label:   "Shirt size",
+			Type:    domain.CustomFieldEnum,
+			Options: domain.StringList{"S", "M"},
+		}).Return(nil).Once()
+
+		res, err := uc.CreateCustomField(req)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"S", "M"}, res.Options)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		fr.On("FindByName", "shirt_size").Return(domain.CustomField{ID: 1}, nil).Once()
+
+		_, err := uc.CreateCustomField(req)
+		assert.ErrorIs(t, err, ErrDuplicateCustomField)
+	})
+
+	t.Run("failed to find by name", func(t *testing.T) {
+		fr.On("FindByName", "shirt_size").Return(domain.CustomField{}, errors.New("error")).Once()
+
+		_, err := uc.CreateCustomField(req)
+		assert.Error(t, err)
+	})
+}
+
+func TestCustomFieldNormalize(t *testing.T) {
+	date := domain.CustomField{Name: "badge_issued", Type: domain.CustomFieldDate}
+	number := domain.CustomField{Name: "badge", Type: domain.CustomFieldNumber}
+	code := domain.CustomField{Name: "cost_center", Type: domain.CustomFieldString, Pattern: `^CC-\d+$`}
+
+	t.Run("valid values", func(t *testing.T) {
+		v, err := date.Normalize("2024-05-06")
+		assert.NoError(t, err)
+		assert.Equal(t, "2024-05-06", v)
+
+		v, err = number.ParseQuery("7.5")
+		assert.NoError(t, err)
+		assert.Equal(t, 7.5, v)
+
+		v, err = code.Normalize("CC-10")
+		assert.NoError(t, err)
+		assert.Equal(t, "CC-10", v)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		_, err := date.Normalize("06/05/2024")
+		assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+
+		_, err = number.Normalize("7")
+		assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+
+		_, err = code.Normalize("10")
+		assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+	})
+}
+
+func TestCustomFieldsByName(t *testing.T) {
+	fr := mocks.NewCustomFieldRepository(t)
+	logger.Init()
+
+	t.Run("patterns are compiled", func(t *testing.T) {
+		fr.On("FindAll").Return([]domain.CustomField{
+			{ID: 1, Name: "cost_center", Type: domain.CustomFieldString, Pattern: `^CC-\d+$`},
+		}, nil).Once()
+
+		fields, err := customFieldsByName(fr)
+		assert.NoError(t, err)
+
+		field := fields["cost_center"]
+		field.Pattern = `^\d+$`
+		_, err = field.Normalize("CC-10")
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		fr.On("FindAll").Return([]domain.CustomField{
+			{ID: 1, Name: "cost_center", Type: domain.CustomFieldString, Pattern: `(`},
+		}, nil).Once()
+
+		_, err := customFieldsByName(fr)
+		assert.Error(t, err)
+	})
+}
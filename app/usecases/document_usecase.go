@@ -0,0 +1,251 @@
+package usecases
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/storage"
+)
+
+type DocumentUsecase interface {
+	UploadDocument(employeeId uint, req domain.DocumentRequest, content io.Reader, size int64) (domain.DocumentResponse, error)
+	GetDocuments(employeeId uint) ([]domain.DocumentResponse, error)
+	DownloadDocument(employeeId, id uint) (domain.DocumentResponse, io.ReadCloser, error)
+	DeleteDocument(employeeId, id uint) error
+}
+
+type documentUsecase struct {
+	employeeRepository repositories.EmployeeRepository
+	documentRepository repositories.DocumentRepository
+	storage            storage.Storage
+	maxSize            int64
+	allowedTypes       []string
+}
+
+var (
+	ErrDocumentNotFound        = errors.New("document not found")
+	ErrDocumentTooLarge        = errors.New("document is too large")
+	ErrUnsupportedDocumentType = errors.New("unsupported document type")
+)
+
+// sniffLength is the number of bytes http.DetectContentType looks at.
+const sniffLength = 512
+
+func NewDocumentUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	documentRepository repositories.DocumentRepository,
+	storage storage.Storage,
+	maxSize int64,
+	allowedTypes []string,
+) DocumentUsecase {
+	return &documentUsecase{
+		employeeRepository: employeeRepository,
+		documentRepository: documentRepository,
+		storage:            storage,
+		maxSize:            maxSize,
+		allowedTypes:       allowedTypes,
+	}
+}
+
+// UploadDocument streams content to storage while computing its checksum,
+// size is -1 when it is not known up front. The content type is detected
+// from the content, the one sent by the client is not trusted.
+func (uc *documentUsecase) UploadDocument(employeeId uint, req domain.DocumentRequest, content io.Reader, size int64) (domain.DocumentResponse, error) {
+	if size > uc.maxSize {
+		return domain.DocumentResponse{}, fmt.Errorf("%w, the limit is %d bytes", ErrDocumentTooLarge, uc.maxSize)
+	}
+
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.DocumentResponse{}, err
+	}
+
+	br := bufio.NewReaderSize(content, sniffLength)
+	head, err := br.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Log.Error(err, "failed to read document")
+		return domain.DocumentResponse{}, err
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(head), ";")
+	if !uc.isAllowedType(contentType) {
+		return domain.DocumentResponse{}, fmt.Errorf("%w %s, accepted types are %s", ErrUnsupportedDocumentType, contentType, strings.Join(uc.allowedTypes, ", "))
+	}
+
+	key, err := newStorageKey(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to generate storage key")
+		return domain.DocumentResponse{}, err
+	}
+
+	hash := sha256.New()
+	counter := &byteCounter{}
+	body := io.TeeReader(io.LimitReader(br, uc.maxSize+1), io.MultiWriter(hash, counter))
+	if err := uc.storage.Put(key, body, size, contentType); err != nil {
+		logger.Log.Error(err, "failed to store document content")
+		return domain.DocumentResponse{}, err
+	}
+
+	if counter.n > uc.maxSize {
+		uc.deleteContent(key)
+		return domain.DocumentResponse{}, fmt.Errorf("%w, the limit is %d bytes", ErrDocumentTooLarge, uc.maxSize)
+	}
+
+	document := domain.Document{
+		EmployeeID:  employeeId,
+		Category:    req.Category,
+		FileName:    req.FileName,
+		ContentType: contentType,
+		Size:        counter.n,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  key,
+	}
+
+	if err := uc.documentRepository.Store(&document); err != nil {
+		logger.Log.Error(err, "failed to store document")
+		uc.deleteContent(key)
+		return domain.DocumentResponse{}, err
+	}
+
+	logger.Log.Info("successfully upload document", "id", document.ID, "employeeId", employeeId)
+	return toDocumentResponse(document), nil
+}
+
+func (uc *documentUsecase) GetDocuments(employeeId uint) ([]domain.DocumentResponse, error) {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return nil, err
+	}
+
+	documents, err := uc.documentRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find documents")
+		return nil, err
+	}
+
+	res := make([]domain.DocumentResponse, 0, len(documents))
+	for _, d := range documents {
+		res = append(res, toDocumentResponse(d))
+	}
+
+	return res, nil
+}
+
+// DownloadDocument returns the metadata and the content of a document, the
+// caller closes the content.
+func (uc *documentUsecase) DownloadDocument(employeeId, id uint) (domain.DocumentResponse, io.ReadCloser, error) {
+	document, err := uc.findDocument(employeeId, id)
+	if err != nil {
+		return domain.DocumentResponse{}, nil, err
+	}
+
+	content, err := uc.storage.Open(document.StorageKey)
+	if err != nil {
+		logger.Log.Error(err, "failed to open document content", "key", document.StorageKey)
+		return domain.DocumentResponse{}, nil, err
+	}
+
+	return toDocumentResponse(document), content, nil
+}
+
+// DeleteDocument removes the metadata first, content left behind by a failed
+// storage delete is harmless while a row without content is not.
+func (uc *documentUsecase) DeleteDocument(employeeId, id uint) error {
+	document, err := uc.findDocument(employeeId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.documentRepository.DeleteById(employeeId, id); err != nil {
+		logger.Log.Error(err, "failed to delete document")
+		return documentError(err)
+	}
+
+	uc.deleteContent(document.StorageKey)
+
+	logger.Log.Info("successfully delete document", "id", id, "employeeId", employeeId)
+	return nil
+}
+
+func (uc *documentUsecase) findDocument(employeeId, id uint) (domain.Document, error) {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.Document{}, err
+	}
+
+	document, err := uc.documentRepository.FindById(employeeId, id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find document by id")
+		return domain.Document{}, documentError(err)
+	}
+
+	return document, nil
+}
+
+func (uc *documentUsecase) deleteContent(key string) {
+	if err := uc.storage.Delete(key); err != nil {
+		logger.Log.Error(err, "failed to delete document content", "key", key)
+	}
+}
+
+func (uc *documentUsecase) isAllowedType(contentType string) bool {
+	for _, t := range uc.allowedTypes {
+		if t == contentType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// documentError tells a missing document apart from a missing employee.
+func documentError(err error) error {
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return ErrDocumentNotFound
+	}
+
+	return err
+}
+
+// newStorageKey returns a random key, file names sent by clients never end
+// up in storage paths.
+func newStorageKey(employeeId uint) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("employees/%d/%s", employeeId, hex.EncodeToString(b)), nil
+}
+
+type byteCounter struct {
+	n int64
+}
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+func toDocumentResponse(d domain.Document) domain.DocumentResponse {
+	return domain.DocumentResponse{
+		Id:          d.ID,
+		EmployeeId:  d.EmployeeID,
+		Category:    d.Category,
+		FileName:    d.FileName,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		Checksum:    d.Checksum,
+		CreatedAt:   d.CreatedAt,
+	}
+}
@@ -0,0 +1,141 @@
+package usecases
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUploadDocument(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	dr := mocks.NewDocumentRepository(t)
+	st := mocks.NewStorage(t)
+	uc := NewDocumentUsecase(er, dr, st, 64, []string{"application/pdf"})
+	logger.Init()
+
+	employeeId := uint(1)
+	pdf := "%PDF-1.7\nsigned contract"
+	sum := sha256.Sum256([]byte(pdf))
+	req := domain.DocumentRequest{Category: domain.DocumentContract, FileName: "contract.pdf"}
+
+	consume := func(args mock.Arguments) {
+		io.Copy(io.Discard, args.Get(1).(io.Reader))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		st.On("Put", mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "employees/1/")
+		}), mock.Anything, int64(len(pdf)), "application/pdf").Run(consume).Return(nil).Once()
+		dr.On("Store", mock.MatchedBy(func(d *domain.Document) bool {
+			return d.Checksum == hex.EncodeToString(sum[:]) && d.Size == int64(len(pdf)) && d.FileName == "contract.pdf"
+		})).Return(nil).Once()
+
+		res, err := uc.UploadDocument(employeeId, req, strings.NewReader(pdf), int64(len(pdf)))
+		assert.NoError(t, err)
+		assert.Equal(t, "application/pdf", res.ContentType)
+		assert.Equal(t, hex.EncodeToString(sum[:]), res.Checksum)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := uc.UploadDocument(employeeId, req, strings.NewReader(pdf), 65)
+		assert.ErrorIs(t, err, ErrDocumentTooLarge)
+	})
+
+	t.Run("content longer than announced", func(t *testing.T) {
+		long := pdf + strings.Repeat("x", 64)
+
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		st.On("Put", mock.Anything, mock.Anything, int64(len(pdf)), "application/pdf").Run(consume).Return(nil).Once()
+		st.On("Delete", mock.Anything).Return(nil).Once()
+
+		_, err := uc.UploadDocument(employeeId, req, strings.NewReader(long), int64(len(pdf)))
+		assert.ErrorIs(t, err, ErrDocumentTooLarge)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+
+		_, err := uc.UploadDocument(employeeId, req, strings.NewReader("plain text"), 10)
+		assert.ErrorIs(t, err, ErrUnsupportedDocumentType)
+	})
+
+	t.Run("failed to store metadata removes content", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		st.On("Put", mock.Anything, mock.Anything, int64(len(pdf)), "application/pdf").Run(consume).Return(nil).Once()
+		dr.On("Store", mock.Anything).Return(errors.New("error")).Once()
+		st.On("Delete", mock.Anything).Return(nil).Once()
+
+		_, err := uc.UploadDocument(employeeId, req, strings.NewReader(pdf), int64(len(pdf)))
+		assert.Error(t, err)
+	})
+
+	t.Run("deleted employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.UploadDocument(employeeId, req, strings.NewReader(pdf), int64(len(pdf)))
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestDownloadDocument(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	dr := mocks.NewDocumentRepository(t)
+	st := mocks.NewStorage(t)
+	uc := NewDocumentUsecase(er, dr, st, 64, []string{"application/pdf"})
+	logger.Init()
+
+	employeeId := uint(1)
+	document := domain.Document{ID: 2, EmployeeID: employeeId, StorageKey: "employees/1/abc", FileName: "contract.pdf"}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		dr.On("FindById", employeeId, uint(2)).Return(document, nil).Once()
+		st.On("Open", "employees/1/abc").Return(io.NopCloser(strings.NewReader("content")), nil).Once()
+
+		res, content, err := uc.DownloadDocument(employeeId, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, "contract.pdf", res.FileName)
+		body, _ := io.ReadAll(content)
+		assert.Equal(t, "content", string(body))
+	})
+
+	t.Run("document of another employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		dr.On("FindById", employeeId, uint(3)).Return(domain.Document{}, repositories.ErrRecordNotFound).Once()
+
+		_, _, err := uc.DownloadDocument(employeeId, 3)
+		assert.ErrorIs(t, err, ErrDocumentNotFound)
+	})
+}
+
+func TestDeleteDocument(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	dr := mocks.NewDocumentRepository(t)
+	st := mocks.NewStorage(t)
+	uc := NewDocumentUsecase(er, dr, st, 64, []string{"application/pdf"})
+	logger.Init()
+
+	employeeId := uint(1)
+
+	t.Run("success even when content removal fails", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		dr.On("FindById", employeeId, uint(2)).Return(domain.Document{ID: 2, StorageKey: "employees/1/abc"}, nil).Once()
+		dr.On("DeleteById", employeeId, uint(2)).Return(nil).Once()
+		st.On("Delete", "employees/1/abc").Return(errors.New("error")).Once()
+
+		err := uc.DeleteDocument(employeeId, 2)
+		assert.NoError(t, err)
+	})
+}
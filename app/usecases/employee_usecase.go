@@ -2,6 +2,10 @@ package usecases
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/RuhullahReza/Employee-App/app/domain"
 	"github.com/RuhullahReza/Employee-App/app/repositories"
@@ -11,24 +15,55 @@ import (
 
 type EmployeeUsecase interface {
 	CreateEmployee(req domain.EmployeeRequest) (domain.EmployeeResponse, error)
-	GetAllEmployee(page, limit int, orderBy, sort string) (domain.PaginationResponse, error)
+	GetAllEmployee(page, limit int, orderBy, sort string, filter domain.EmployeeFilter) (domain.PaginationResponse, error)
 	GetEmployeeById(id uint) (domain.EmployeeResponse, error)
+	GetEmployeesByIds(ids []uint) (map[uint]domain.EmployeeResponse, error)
+	GetDirectReports(managerIds []uint) (map[uint][]domain.EmployeeResponse, error)
+	SearchEmployees(query string, limit int) ([]domain.EmployeeSearchResult, error)
 	UpdateEmployeeById(id uint, req domain.EmployeeRequest) (domain.EmployeeResponse, error)
 	DeleteEmployeeById(id uint) error
+	ActivateEmployee(id uint) (domain.EmployeeResponse, error)
+	PlaceEmployeeOnLeave(id uint) (domain.EmployeeResponse, error)
+	ReturnEmployeeFromLeave(id uint) (domain.EmployeeResponse, error)
+	TerminateEmployee(id uint, req domain.TerminationRequest) (domain.EmployeeResponse, error)
+	RehireEmployee(id uint, req domain.RehireRequest) (domain.EmployeeResponse, error)
+	AssignManager(id uint, req domain.ManagerRequest) (domain.EmployeeResponse, error)
 }
 
 type employeeUsecase struct {
-	employeeRepository repositories.EmployeeRepository
+	employeeRepository    repositories.EmployeeRepository
+	checklistRepository   repositories.ChecklistRepository
+	customFieldRepository repositories.CustomFieldRepository
+	hireDatePolicy        domain.HireDatePolicy
+	endpointPrefix        string
 }
 
 var (
-	ErrDuplicateEmail = errors.New("duplicate email")
-	ErrInvalidDate    = errors.New("invalid date format")
+	ErrDuplicateEmail          = errors.New("duplicate email")
+	ErrInvalidDate             = errors.New("invalid date format")
+	ErrInvalidStatusTransition = errors.New("invalid employment status transition")
+	ErrTerminationBeforeHire   = errors.New("termination date is before hire date")
+	ErrRehireBeforeTermination = errors.New("rehire date is not after termination date")
+	ErrManagerNotFound         = errors.New("manager not found")
+	ErrInvalidManager          = errors.New("an employee cannot report to themselves or to someone who reports to them")
+	ErrInvalidSearchQuery      = errors.New("search query must contain letters or digits")
+	ErrHireDateOutOfRange      = errors.New("hire date is out of range")
+	ErrHireDateLocked          = errors.New("hire date cannot change after termination, rehire the employee instead")
 )
 
-func NewEmployeeUsecase(employeeRepository repositories.EmployeeRepository) EmployeeUsecase {
+func NewEmployeeUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	checklistRepository repositories.ChecklistRepository,
+	customFieldRepository repositories.CustomFieldRepository,
+	hireDatePolicy domain.HireDatePolicy,
+	endpointPrefix string,
+) EmployeeUsecase {
 	return &employeeUsecase{
-		employeeRepository: employeeRepository,
+		employeeRepository:    employeeRepository,
+		checklistRepository:   checklistRepository,
+		customFieldRepository: customFieldRepository,
+		hireDatePolicy:        hireDatePolicy,
+		endpointPrefix:        endpointPrefix,
 	}
 }
 
@@ -39,6 +74,10 @@ func (uc *employeeUsecase) CreateEmployee(req domain.EmployeeRequest) (domain.Em
 		return domain.EmployeeResponse{}, ErrInvalidDate
 	}
 
+	if err := uc.checkHireDate(parsedDate); err != nil {
+		return domain.EmployeeResponse{}, err
+	}
+
 	foundEmployee, err := uc.employeeRepository.FindByEmail(req.Email)
 	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
 		logger.Log.Error(err, "failed to find employee by email")
@@ -49,35 +88,50 @@ func (uc *employeeUsecase) CreateEmployee(req domain.EmployeeRequest) (domain.Em
 		return domain.EmployeeResponse{}, ErrDuplicateEmail
 	}
 
+	customFields, err := uc.validateCustomFields(req.CustomFields)
+	if err != nil {
+		return domain.EmployeeResponse{}, err
+	}
+
 	newEmployee := domain.Employee{
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Email:     req.Email,
-		HireDate:  parsedDate,
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		Email:        req.Email,
+		HireDate:     parsedDate,
+		Status:       statusForHireDate(parsedDate),
+		CustomFields: customFields,
 	}
+	applyNamePreferences(&newEmployee, req)
 
-	if err := uc.employeeRepository.Store(&newEmployee); err != nil {
-		logger.Log.Error(err, "failed to store new employee data")
+	tasks, err := checklistTasks(uc.checklistRepository, domain.ChecklistOnboarding, newEmployee.HireDate)
+	if err != nil {
 		return domain.EmployeeResponse{}, err
 	}
 
-	res := domain.EmployeeResponse{
-		Id:        newEmployee.ID,
-		FirstName: newEmployee.FirstName,
-		LastName:  newEmployee.LastName,
-		Email:     newEmployee.Email,
-		HireDate:  newEmployee.HireDate,
-		CreatedAt: newEmployee.CreatedAt,
-		UpdatedAt: newEmployee.UpdatedAt,
+	if err := uc.employeeRepository.Store(&newEmployee, tasks); err != nil {
+		logger.Log.Error(err, "failed to store new employee data")
+		return domain.EmployeeResponse{}, err
 	}
 
+	res := toEmployeeResponse(newEmployee, uc.endpointPrefix)
+
 	logger.Log.Info("successfully create employee with id : ", newEmployee.ID)
 	return res, nil
 }
 
-func (uc *employeeUsecase) GetAllEmployee(page, limit int, orderBy, sort string) (domain.PaginationResponse, error) {
+func (uc *employeeUsecase) GetAllEmployee(page, limit int, orderBy, sort string, filter domain.EmployeeFilter) (domain.PaginationResponse, error) {
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = domain.CurrentEmploymentStatuses
+	}
+
+	if strings.HasPrefix(orderBy, domain.CustomFieldOrderPrefix) || len(filter.CustomFields) > 0 {
+		if err := uc.resolveCustomFieldQuery(orderBy, &filter); err != nil {
+			return domain.PaginationResponse{}, err
+		}
+	}
+
 	offset := (page - 1) * limit
-	employees, count, err := uc.employeeRepository.FindAll(limit, offset, orderBy, sort)
+	employees, count, err := uc.employeeRepository.FindAll(limit, offset, orderBy, sort, filter)
 	if err != nil {
 		logger.Log.Error(err, "failed to find all employee data")
 		return domain.PaginationResponse{}, err
@@ -85,17 +139,7 @@ func (uc *employeeUsecase) GetAllEmployee(page, limit int, orderBy, sort string)
 
 	var employeeResponses []domain.EmployeeResponse
 	for _, e := range employees {
-		employee := domain.EmployeeResponse{
-			Id:        e.ID,
-			FirstName: e.FirstName,
-			LastName:  e.LastName,
-			Email:     e.Email,
-			HireDate:  e.HireDate,
-			CreatedAt: e.CreatedAt,
-			UpdatedAt: e.UpdatedAt,
-		}
-
-		employeeResponses = append(employeeResponses, employee)
+		employeeResponses = append(employeeResponses, toEmployeeResponse(e, uc.endpointPrefix))
 	}
 
 	totalPage := count / int64(limit)
@@ -120,17 +164,72 @@ func (uc *employeeUsecase) GetEmployeeById(id uint) (domain.EmployeeResponse, er
 		return domain.EmployeeResponse{}, err
 	}
 
-	employeeResponse := domain.EmployeeResponse{
-		Id:        employee.ID,
-		FirstName: employee.FirstName,
-		LastName:  employee.LastName,
-		Email:     employee.Email,
-		HireDate:  employee.HireDate,
-		CreatedAt: employee.CreatedAt,
-		UpdatedAt: employee.UpdatedAt,
+	return toEmployeeResponse(employee, uc.endpointPrefix), nil
+}
+
+// GetEmployeesByIds returns the employees with the given ids by id, ids
+// without an employee are missing from the map.
+func (uc *employeeUsecase) GetEmployeesByIds(ids []uint) (map[uint]domain.EmployeeResponse, error) {
+	employees, err := uc.employeeRepository.FindByIds(ids)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employees by ids")
+		return nil, err
+	}
+
+	res := make(map[uint]domain.EmployeeResponse, len(employees))
+	for _, e := range employees {
+		res[e.ID] = toEmployeeResponse(e, uc.endpointPrefix)
+	}
+
+	return res, nil
+}
+
+// GetDirectReports returns the employees reporting to each of the managers,
+// managers without reports are missing from the map.
+func (uc *employeeUsecase) GetDirectReports(managerIds []uint) (map[uint][]domain.EmployeeResponse, error) {
+	employees, err := uc.employeeRepository.FindByManagerIds(managerIds)
+	if err != nil {
+		logger.Log.Error(err, "failed to find direct reports")
+		return nil, err
+	}
+
+	res := make(map[uint][]domain.EmployeeResponse)
+	for _, e := range employees {
+		res[*e.ManagerID] = append(res[*e.ManagerID], toEmployeeResponse(e, uc.endpointPrefix))
+	}
+
+	return res, nil
+}
+
+func (uc *employeeUsecase) SearchEmployees(query string, limit int) ([]domain.EmployeeSearchResult, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil, ErrInvalidSearchQuery
+	}
+
+	matches, err := uc.employeeRepository.Search(terms, limit)
+	if err != nil {
+		logger.Log.Error(err, "failed to search employees")
+		return nil, err
+	}
+
+	res := make([]domain.EmployeeSearchResult, 0, len(matches))
+	for _, m := range matches {
+		res = append(res, domain.EmployeeSearchResult{
+			Id:             m.ID,
+			FirstName:      m.FirstName,
+			LastName:       m.LastName,
+			DisplayName:    domain.Employee{FirstName: m.FirstName, LastName: m.LastName, PreferredName: m.PreferredName, NameLocale: m.NameLocale}.DisplayName(),
+			Email:          m.Email,
+			Status:         m.Status,
+			PhotoUrl:       photoURL(uc.endpointPrefix, m.ID, m.PhotoVersion),
+			NameHighlight:  m.NameHighlight,
+			EmailHighlight: m.EmailHighlight,
+			Score:          m.Score,
+		})
 	}
 
-	return employeeResponse, nil
+	return res, nil
 }
 
 func (uc *employeeUsecase) UpdateEmployeeById(id uint, req domain.EmployeeRequest) (domain.EmployeeResponse, error) {
@@ -140,12 +239,24 @@ func (uc *employeeUsecase) UpdateEmployeeById(id uint, req domain.EmployeeReques
 		return domain.EmployeeResponse{}, ErrInvalidDate
 	}
 
-	_, err = uc.employeeRepository.FindById(id)
+	employee, err := uc.employeeRepository.FindById(id)
 	if err != nil {
 		logger.Log.Error(err, "failed to find employee by id")
 		return domain.EmployeeResponse{}, err
 	}
 
+	// Stored hire dates outside the policy stay valid as long as they are
+	// not changed.
+	if !parsedDate.Equal(utils.CivilDate(employee.HireDate)) {
+		if employee.Status == domain.StatusTerminated {
+			return domain.EmployeeResponse{}, ErrHireDateLocked
+		}
+
+		if err := uc.checkHireDate(parsedDate); err != nil {
+			return domain.EmployeeResponse{}, err
+		}
+	}
+
 	foundEmployee, err := uc.employeeRepository.FindByEmail(req.Email)
 	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
 		logger.Log.Error(err, "failed to find employee by email")
@@ -157,11 +268,22 @@ func (uc *employeeUsecase) UpdateEmployeeById(id uint, req domain.EmployeeReques
 	}
 
 	updatedEmployee := domain.Employee{
-		ID:        id,
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Email:     req.Email,
-		HireDate:  parsedDate,
+		ID:            id,
+		FirstName:     req.FirstName,
+		LastName:      req.LastName,
+		PreferredName: employee.PreferredName,
+		Pronouns:      employee.Pronouns,
+		NameLocale:    employee.NameLocale,
+		Email:         req.Email,
+		HireDate:      parsedDate,
+	}
+	applyNamePreferences(&updatedEmployee, req)
+
+	if req.CustomFields != nil {
+		updatedEmployee.CustomFields, err = uc.validateCustomFields(req.CustomFields)
+		if err != nil {
+			return domain.EmployeeResponse{}, err
+		}
 	}
 
 	if err := uc.employeeRepository.UpdateById(&updatedEmployee); err != nil {
@@ -169,21 +291,41 @@ func (uc *employeeUsecase) UpdateEmployeeById(id uint, req domain.EmployeeReques
 		return domain.EmployeeResponse{}, err
 	}
 
-	res := domain.EmployeeResponse{
-		Id:        updatedEmployee.ID,
-		FirstName: updatedEmployee.FirstName,
-		LastName:  updatedEmployee.LastName,
-		Email:     updatedEmployee.Email,
-		HireDate:  updatedEmployee.HireDate,
-		UpdatedAt: updatedEmployee.UpdatedAt,
+	updatedEmployee.Status = employee.Status
+	updatedEmployee.TerminationDate = employee.TerminationDate
+	updatedEmployee.TerminationReason = employee.TerminationReason
+	updatedEmployee.ManagerID = employee.ManagerID
+	updatedEmployee.CalendarID = employee.CalendarID
+	updatedEmployee.PhotoVersion = employee.PhotoVersion
+	updatedEmployee.Jobs = employee.Jobs
+	updatedEmployee.CreatedAt = employee.CreatedAt
+	if updatedEmployee.CustomFields == nil {
+		updatedEmployee.CustomFields = employee.CustomFields
 	}
+	res := toEmployeeResponse(updatedEmployee, uc.endpointPrefix)
 
 	logger.Log.Info("successfully update employee with id : ", updatedEmployee.ID)
 	return res, nil
 }
 
+// DeleteEmployeeById starts offboarding unless the employee was already
+// terminated, which started it then.
 func (uc *employeeUsecase) DeleteEmployeeById(id uint) error {
-	err := uc.employeeRepository.DeleteById(id)
+	employee, err := uc.employeeRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return err
+	}
+
+	var tasks []domain.EmployeeTask
+	if employee.Status != domain.StatusTerminated {
+		tasks, err = checklistTasks(uc.checklistRepository, domain.ChecklistOffboarding, utils.Today())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = uc.employeeRepository.DeleteById(id, tasks)
 	if err != nil {
 		logger.Log.Error(err, "failed to delete employee by id")
 		return err
@@ -192,3 +334,295 @@ func (uc *employeeUsecase) DeleteEmployeeById(id uint) error {
 	logger.Log.Info("successfully delete employee with id : ", id)
 	return nil
 }
+
+func (uc *employeeUsecase) ActivateEmployee(id uint) (domain.EmployeeResponse, error) {
+	return uc.changeEmploymentStatus(id, domain.StatusPendingStart, domain.StatusActive, nil, nil)
+}
+
+func (uc *employeeUsecase) PlaceEmployeeOnLeave(id uint) (domain.EmployeeResponse, error) {
+	return uc.changeEmploymentStatus(id, domain.StatusActive, domain.StatusOnLeave, nil, nil)
+}
+
+func (uc *employeeUsecase) ReturnEmployeeFromLeave(id uint) (domain.EmployeeResponse, error) {
+	return uc.changeEmploymentStatus(id, domain.StatusOnLeave, domain.StatusActive, nil, nil)
+}
+
+func (uc *employeeUsecase) TerminateEmployee(id uint, req domain.TerminationRequest) (domain.EmployeeResponse, error) {
+	terminationDate, err := utils.ParseDateString(req.TerminationDate)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse Termination Date")
+		return domain.EmployeeResponse{}, ErrInvalidDate
+	}
+
+	return uc.changeEmploymentStatus(id, "", domain.StatusTerminated, func(e *domain.Employee) error {
+		if terminationDate.Before(utils.CivilDate(e.HireDate)) {
+			return ErrTerminationBeforeHire
+		}
+
+		e.TerminationDate = &terminationDate
+		e.TerminationReason = strings.TrimSpace(req.Reason)
+		return nil
+	}, func() ([]domain.EmployeeTask, error) {
+		return checklistTasks(uc.checklistRepository, domain.ChecklistOffboarding, terminationDate)
+	})
+}
+
+func (uc *employeeUsecase) RehireEmployee(id uint, req domain.RehireRequest) (domain.EmployeeResponse, error) {
+	hireDate, err := utils.ParseDateString(req.HireDate)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse Hire Date")
+		return domain.EmployeeResponse{}, ErrInvalidDate
+	}
+
+	if err := uc.checkHireDate(hireDate); err != nil {
+		return domain.EmployeeResponse{}, err
+	}
+
+	status := statusForHireDate(hireDate)
+	return uc.changeEmploymentStatus(id, domain.StatusTerminated, status, func(e *domain.Employee) error {
+		if e.TerminationDate != nil && !hireDate.After(utils.CivilDate(*e.TerminationDate)) {
+			return ErrRehireBeforeTermination
+		}
+
+		e.HireDate = hireDate
+		e.TerminationDate = nil
+		e.TerminationReason = ""
+		return nil
+	}, nil)
+}
+
+func (uc *employeeUsecase) AssignManager(id uint, req domain.ManagerRequest) (domain.EmployeeResponse, error) {
+	employee, err := uc.employeeRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.EmployeeResponse{}, err
+	}
+
+	// Walk up the reporting line of the new manager, reaching the employee
+	// would create a cycle.
+	for managerId := req.ManagerId; managerId != nil; {
+		if *managerId == id {
+			return domain.EmployeeResponse{}, ErrInvalidManager
+		}
+
+		manager, err := uc.employeeRepository.FindById(*managerId)
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			return domain.EmployeeResponse{}, ErrManagerNotFound
+		}
+
+		if err != nil {
+			logger.Log.Error(err, "failed to find manager by id")
+			return domain.EmployeeResponse{}, err
+		}
+
+		managerId = manager.ManagerID
+	}
+
+	if err := uc.employeeRepository.UpdateManager(id, req.ManagerId); err != nil {
+		logger.Log.Error(err, "failed to update manager")
+		return domain.EmployeeResponse{}, err
+	}
+
+	employee.ManagerID = req.ManagerId
+
+	logger.Log.Info("successfully assign manager", "id", id)
+	return toEmployeeResponse(employee, uc.endpointPrefix), nil
+}
+
+// changeEmploymentStatus moves the employee to status to. When from is not
+// empty the employee must currently be in that status, apply can validate and
+// modify the employee before it is stored. The checklist tasks returned by
+// start are stored with the change.
+func (uc *employeeUsecase) changeEmploymentStatus(
+	id uint,
+	from, to string,
+	apply func(e *domain.Employee) error,
+	start func() ([]domain.EmployeeTask, error),
+) (domain.EmployeeResponse, error) {
+	employee, err := uc.employeeRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.EmployeeResponse{}, err
+	}
+
+	if (from != "" && employee.Status != from) || !domain.CanTransitionEmploymentStatus(employee.Status, to) {
+		return domain.EmployeeResponse{}, fmt.Errorf("%w from %s to %s", ErrInvalidStatusTransition, employee.Status, to)
+	}
+
+	if apply != nil {
+		if err := apply(&employee); err != nil {
+			return domain.EmployeeResponse{}, err
+		}
+	}
+
+	var tasks []domain.EmployeeTask
+	if start != nil {
+		if tasks, err = start(); err != nil {
+			return domain.EmployeeResponse{}, err
+		}
+	}
+
+	employee.Status = to
+	if err := uc.employeeRepository.UpdateEmploymentStatus(&employee, tasks); err != nil {
+		logger.Log.Error(err, "failed to update employment status")
+		return domain.EmployeeResponse{}, err
+	}
+
+	logger.Log.Info("successfully change employment status", "id", id, "status", to)
+	return toEmployeeResponse(employee, uc.endpointPrefix), nil
+}
+
+// validateCustomFields checks values against the field definitions and
+// returns them normalized. A nil value leaves the field unset.
+func (uc *employeeUsecase) validateCustomFields(values map[string]interface{}) (domain.CustomFieldValues, error) {
+	fields, err := customFieldsByName(uc.customFieldRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(domain.CustomFieldValues, len(values))
+	for name, value := range values {
+		field, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCustomField, name)
+		}
+
+		if value == nil {
+			continue
+		}
+
+		res[name], err = field.Normalize(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for name, field := range fields {
+		if _, ok := res[name]; field.Required && !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingCustomField, name)
+		}
+	}
+
+	return res, nil
+}
+
+// resolveCustomFieldQuery checks that orderBy and the filter refer to defined
+// fields and converts the filter values from their query string form.
+func (uc *employeeUsecase) resolveCustomFieldQuery(orderBy string, filter *domain.EmployeeFilter) error {
+	fields, err := customFieldsByName(uc.customFieldRepository)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(orderBy, domain.CustomFieldOrderPrefix) {
+		name := strings.TrimPrefix(orderBy, domain.CustomFieldOrderPrefix)
+		if _, ok := fields[name]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownCustomField, name)
+		}
+	}
+
+	values := make(map[string]interface{}, len(filter.CustomFields))
+	for name, raw := range filter.CustomFields {
+		field, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownCustomField, name)
+		}
+
+		s, _ := raw.(string)
+		if values[name], err = field.ParseQuery(s); err != nil {
+			return err
+		}
+	}
+
+	filter.CustomFields = values
+	return nil
+}
+
+// checkHireDate rejects hire dates outside of the hire date policy.
+func (uc *employeeUsecase) checkHireDate(hireDate time.Time) error {
+	latest := utils.Today().AddDate(0, 0, uc.hireDatePolicy.MaxFutureDays)
+	if hireDate.Before(uc.hireDatePolicy.MinDate) || hireDate.After(latest) {
+		return fmt.Errorf("%w, it must be between %s and %s", ErrHireDateOutOfRange,
+			uc.hireDatePolicy.MinDate.Format(utils.ISODate), latest.Format(utils.ISODate))
+	}
+
+	return nil
+}
+
+// applyNamePreferences copies the name preferences sent in the request, the
+// ones left out keep their value.
+func applyNamePreferences(e *domain.Employee, req domain.EmployeeRequest) {
+	if req.PreferredName != nil {
+		e.PreferredName = *req.PreferredName
+	}
+
+	if req.Pronouns != nil {
+		e.Pronouns = *req.Pronouns
+	}
+
+	if req.NameLocale != nil {
+		e.NameLocale = *req.NameLocale
+	}
+}
+
+func civilDatePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	date := utils.CivilDate(*t)
+	return &date
+}
+
+// searchTerms splits a query into lower case words of letters and digits,
+// anything else would be tsquery syntax.
+func searchTerms(query string) []string {
+	return strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// statusForHireDate returns pending_start for employees who join in the
+// future and active otherwise.
+func statusForHireDate(hireDate time.Time) string {
+	today := utils.Today()
+	if hireDate.After(today) {
+		return domain.StatusPendingStart
+	}
+
+	return domain.StatusActive
+}
+
+func toEmployeeResponse(e domain.Employee, endpointPrefix string) domain.EmployeeResponse {
+	res := domain.EmployeeResponse{
+		Id:                e.ID,
+		FirstName:         e.FirstName,
+		LastName:          e.LastName,
+		PreferredName:     e.PreferredName,
+		Pronouns:          e.Pronouns,
+		NameLocale:        e.NameLocale,
+		DisplayName:       e.DisplayName(),
+		Email:             e.Email,
+		HireDate:          utils.CivilDate(e.HireDate),
+		Status:            e.Status,
+		TerminationDate:   civilDatePtr(e.TerminationDate),
+		TerminationReason: e.TerminationReason,
+		ManagerId:         e.ManagerID,
+		CalendarId:        e.CalendarID,
+		CustomFields:      e.CustomFields,
+		PhotoUrl:          photoURL(endpointPrefix, e.ID, e.PhotoVersion),
+		CreatedAt:         e.CreatedAt,
+		UpdatedAt:         e.UpdatedAt,
+	}
+
+	// Jobs only holds the assignments covering today, the latest one wins
+	// when a new assignment starts today.
+	for _, job := range e.Jobs {
+		if res.CurrentJob == nil || job.StartDate.After(res.CurrentJob.StartDate) {
+			current := toJobAssignmentResponse(job)
+			res.CurrentJob = &current
+		}
+	}
+
+	return res
+}
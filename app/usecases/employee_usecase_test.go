@@ -3,6 +3,7 @@ package usecases
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/RuhullahReza/Employee-App/app/domain"
 	"github.com/RuhullahReza/Employee-App/app/mocks"
@@ -11,11 +12,19 @@ import (
 	"github.com/RuhullahReza/Employee-App/pkg/utils"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+var hireDatePolicy = domain.HireDatePolicy{
+	MinDate:       time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+	MaxFutureDays: 365,
+}
+
 func TestCreateEmployee(t *testing.T) {
 	er := mocks.NewEmployeeRepository(t)
-	uc := NewEmployeeUsecase(er)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
 	logger.Init()
 
 	req := domain.EmployeeRequest{
@@ -28,10 +37,12 @@ func TestCreateEmployee(t *testing.T) {
 	parsedDate, _ := utils.ParseDateString(req.HireDate)
 
 	newEmployee := domain.Employee{
-		FirstName: req.FirstName,
-		LastName:  req.LastName,
-		Email:     req.Email,
-		HireDate:  parsedDate,
+		FirstName:    req.FirstName,
+		LastName:     req.LastName,
+		Email:        req.Email,
+		HireDate:     parsedDate,
+		Status:       domain.StatusActive,
+		CustomFields: domain.CustomFieldValues{},
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -39,7 +50,19 @@ func TestCreateEmployee(t *testing.T) {
 			Return(domain.Employee{}, repositories.ErrRecordNotFound).
 			Once()
 
-		er.On("Store", &newEmployee).
+		fr.On("FindAll").Return(nil, nil).Once()
+
+		itemId := uint(1)
+		cr.On("FindItems", domain.ChecklistOnboarding).
+			Return([]domain.ChecklistItem{{ID: itemId, Kind: domain.ChecklistOnboarding, Title: "Prepare laptop", DueDays: -3}}, nil).
+			Once()
+
+		er.On("Store", &newEmployee, []domain.EmployeeTask{{
+			ItemID:  &itemId,
+			Kind:    domain.ChecklistOnboarding,
+			Title:   "Prepare laptop",
+			DueDate: parsedDate.AddDate(0, 0, -3),
+		}}).
 			Return(nil).
 			Once()
 
@@ -52,12 +75,26 @@ func TestCreateEmployee(t *testing.T) {
 		assert.Equal(t, newEmployee.HireDate, res.HireDate)
 	})
 
+	t.Run("hire date out of range", func(t *testing.T) {
+		for _, hireDate := range []string{"1969-12-31", utils.Today().AddDate(0, 0, 366).Format(utils.ISODate)} {
+			invalid := req
+			invalid.HireDate = hireDate
+
+			_, err := uc.CreateEmployee(invalid)
+			assert.ErrorIs(t, err, ErrHireDateOutOfRange)
+		}
+	})
+
 	t.Run("failed on store", func(t *testing.T) {
 		er.On("FindByEmail", req.Email).
 			Return(domain.Employee{}, repositories.ErrRecordNotFound).
 			Once()
 
-		er.On("Store", &newEmployee).
+		fr.On("FindAll").Return(nil, nil).Once()
+
+		cr.On("FindItems", domain.ChecklistOnboarding).Return(nil, nil).Once()
+
+		er.On("Store", &newEmployee, []domain.EmployeeTask{}).
 			Return(errors.New("error")).
 			Once()
 
@@ -65,6 +102,19 @@ func TestCreateEmployee(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("failed to find checklist items", func(t *testing.T) {
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		fr.On("FindAll").Return(nil, nil).Once()
+
+		cr.On("FindItems", domain.ChecklistOnboarding).Return(nil, errors.New("error")).Once()
+
+		_, err := uc.CreateEmployee(req)
+		assert.Error(t, err)
+	})
+
 	t.Run("duplicate email", func(t *testing.T) {
 		er.On("FindByEmail", req.Email).
 			Return(domain.Employee{ID: 1}, repositories.ErrRecordNotFound).
@@ -74,6 +124,72 @@ func TestCreateEmployee(t *testing.T) {
 		assert.ErrorIs(t, err, ErrDuplicateEmail)
 	})
 
+	fields := []domain.CustomField{
+		{ID: 1, Name: "cost_center", Type: domain.CustomFieldString, Required: true, Pattern: `^CC-\d+$`},
+		{ID: 2, Name: "shirt_size", Type: domain.CustomFieldEnum, Options: domain.StringList{"S", "M", "L"}},
+		{ID: 3, Name: "badge", Type: domain.CustomFieldNumber},
+	}
+
+	t.Run("success with custom fields", func(t *testing.T) {
+		withFields := req
+		withFields.CustomFields = map[string]interface{}{"cost_center": "CC-10", "badge": 7.0, "shirt_size": nil}
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		fr.On("FindAll").Return(fields, nil).Once()
+
+		stored := newEmployee
+		stored.CustomFields = domain.CustomFieldValues{"cost_center": "CC-10", "badge": 7.0}
+		cr.On("FindItems", domain.ChecklistOnboarding).Return(nil, nil).Once()
+
+		er.On("Store", &stored, []domain.EmployeeTask{}).Return(nil).Once()
+
+		res, err := uc.CreateEmployee(withFields)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"cost_center": "CC-10", "badge": 7.0}, res.CustomFields)
+	})
+
+	t.Run("missing required custom field", func(t *testing.T) {
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		fr.On("FindAll").Return(fields, nil).Once()
+
+		_, err := uc.CreateEmployee(req)
+		assert.ErrorIs(t, err, ErrMissingCustomField)
+	})
+
+	t.Run("unknown custom field", func(t *testing.T) {
+		withFields := req
+		withFields.CustomFields = map[string]interface{}{"cost_center": "CC-10", "team": "core"}
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		fr.On("FindAll").Return(fields, nil).Once()
+
+		_, err := uc.CreateEmployee(withFields)
+		assert.ErrorIs(t, err, ErrUnknownCustomField)
+	})
+
+	t.Run("invalid custom field value", func(t *testing.T) {
+		withFields := req
+		withFields.CustomFields = map[string]interface{}{"cost_center": "CC-10", "shirt_size": "XXL"}
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		fr.On("FindAll").Return(fields, nil).Once()
+
+		_, err := uc.CreateEmployee(withFields)
+		assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+	})
+
 	t.Run("failed to find email", func(t *testing.T) {
 		er.On("FindByEmail", req.Email).
 			Return(domain.Employee{}, errors.New("error")).
@@ -93,7 +209,9 @@ func TestCreateEmployee(t *testing.T) {
 
 func TestGetAllEmployee(t *testing.T) {
 	er := mocks.NewEmployeeRepository(t)
-	uc := NewEmployeeUsecase(er)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
 	logger.Init()
 
 	parsedDate, _ := utils.ParseDateString("2024-03-03")
@@ -106,11 +224,11 @@ func TestGetAllEmployee(t *testing.T) {
 	}
 
 	t.Run("success", func(t *testing.T) {
-		er.On("FindAll", 20, 0, "id", "ASC").
+		er.On("FindAll", 20, 0, "id", "ASC", domain.EmployeeFilter{Statuses: domain.CurrentEmploymentStatuses}).
 			Return([]domain.Employee{newEmployee}, int64(1), nil).
 			Once()
 
-		res, err := uc.GetAllEmployee(1, 20, "id", "ASC")
+		res, err := uc.GetAllEmployee(1, 20, "id", "ASC", domain.EmployeeFilter{})
 		assert.NoError(t, err)
 
 		assert.Equal(t, res.Data.([]domain.EmployeeResponse)[0].FirstName, newEmployee.FirstName)
@@ -119,19 +237,65 @@ func TestGetAllEmployee(t *testing.T) {
 		assert.Equal(t, res.Data.([]domain.EmployeeResponse)[0].HireDate, newEmployee.HireDate)
 	})
 
+	t.Run("success with requested statuses", func(t *testing.T) {
+		filter := domain.EmployeeFilter{Statuses: []string{domain.StatusTerminated}}
+		er.On("FindAll", 20, 0, "id", "ASC", filter).
+			Return([]domain.Employee{newEmployee}, int64(1), nil).
+			Once()
+
+		_, err := uc.GetAllEmployee(1, 20, "id", "ASC", filter)
+		assert.NoError(t, err)
+	})
+
 	t.Run("failed to find all", func(t *testing.T) {
-		er.On("FindAll", 20, 0, "id", "ASC").
+		er.On("FindAll", 20, 0, "id", "ASC", domain.EmployeeFilter{Statuses: domain.CurrentEmploymentStatuses}).
 			Return(nil, int64(-1), errors.New("error")).
 			Once()
 
-		_, err := uc.GetAllEmployee(1, 20, "id", "ASC")
+		_, err := uc.GetAllEmployee(1, 20, "id", "ASC", domain.EmployeeFilter{})
 		assert.Error(t, err)
 	})
+
+	fields := []domain.CustomField{
+		{ID: 1, Name: "badge", Type: domain.CustomFieldNumber},
+		{ID: 2, Name: "remote", Type: domain.CustomFieldBool},
+	}
+
+	t.Run("success with custom field filter and order", func(t *testing.T) {
+		fr.On("FindAll").Return(fields, nil).Once()
+		er.On("FindAll", 20, 0, "cf.badge", "ASC", domain.EmployeeFilter{
+			Statuses:     domain.CurrentEmploymentStatuses,
+			CustomFields: map[string]interface{}{"badge": 7.0, "remote": true},
+		}).Return([]domain.Employee{newEmployee}, int64(1), nil).Once()
+
+		_, err := uc.GetAllEmployee(1, 20, "cf.badge", "ASC", domain.EmployeeFilter{
+			CustomFields: map[string]interface{}{"badge": "7", "remote": "true"},
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown custom field order", func(t *testing.T) {
+		fr.On("FindAll").Return(fields, nil).Once()
+
+		_, err := uc.GetAllEmployee(1, 20, "cf.team", "ASC", domain.EmployeeFilter{})
+		assert.ErrorIs(t, err, ErrUnknownCustomField)
+	})
+
+	t.Run("invalid custom field filter", func(t *testing.T) {
+		fr.On("FindAll").Return(fields, nil).Once()
+
+		_, err := uc.GetAllEmployee(1, 20, "id", "ASC", domain.EmployeeFilter{
+			CustomFields: map[string]interface{}{"badge": "seven"},
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidCustomFieldValue)
+	})
 }
 
 func TestGetEmployeeById(t *testing.T) {
 	er := mocks.NewEmployeeRepository(t)
-	uc := NewEmployeeUsecase(er)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
 	logger.Init()
 
 	parsedDate, _ := utils.ParseDateString("2024-03-03")
@@ -169,9 +333,131 @@ func TestGetEmployeeById(t *testing.T) {
 	})
 }
 
+func TestGetEmployeesByIds(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindByIds", []uint{1, 2, 3}).
+			Return([]domain.Employee{{ID: 1, FirstName: "abc"}, {ID: 3, FirstName: "ghi"}}, nil).
+			Once()
+
+		res, err := uc.GetEmployeesByIds([]uint{1, 2, 3})
+		assert.NoError(t, err)
+
+		assert.Len(t, res, 2)
+		assert.Equal(t, "ghi", res[3].FirstName)
+	})
+
+	t.Run("failed to find", func(t *testing.T) {
+		er.On("FindByIds", []uint{1}).
+			Return(nil, errors.New("error")).
+			Once()
+
+		_, err := uc.GetEmployeesByIds([]uint{1})
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDirectReports(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	managerOne, managerTwo := uint(1), uint(2)
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindByManagerIds", []uint{1, 2, 3}).
+			Return([]domain.Employee{
+				{ID: 4, ManagerID: &managerOne},
+				{ID: 5, ManagerID: &managerTwo},
+				{ID: 6, ManagerID: &managerOne},
+			}, nil).
+			Once()
+
+		res, err := uc.GetDirectReports([]uint{1, 2, 3})
+		assert.NoError(t, err)
+
+		assert.Len(t, res[1], 2)
+		assert.Equal(t, uint(6), res[1][1].Id)
+		assert.Len(t, res[2], 1)
+		assert.Empty(t, res[3])
+	})
+
+	t.Run("failed to find", func(t *testing.T) {
+		er.On("FindByManagerIds", []uint{1}).
+			Return(nil, errors.New("error")).
+			Once()
+
+		_, err := uc.GetDirectReports([]uint{1})
+		assert.Error(t, err)
+	})
+}
+
+func TestSearchEmployees(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	t.Run("success", func(t *testing.T) {
+		match := domain.EmployeeSearchMatch{ID: 1, FirstName: "José", LastName: "Smith", NameHighlight: "<mark>José</mark> Smith", Score: 0.8}
+		er.On("Search", []string{"josé", "sm"}, 10).
+			Return([]domain.EmployeeSearchMatch{match}, nil).
+			Once()
+
+		res, err := uc.SearchEmployees(" José  SM:* ", 10)
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, match.NameHighlight, res[0].NameHighlight)
+		assert.Equal(t, match.Score, res[0].Score)
+	})
+
+	t.Run("no terms", func(t *testing.T) {
+		_, err := uc.SearchEmployees("& | !", 10)
+		assert.ErrorIs(t, err, ErrInvalidSearchQuery)
+	})
+
+	t.Run("failed to search", func(t *testing.T) {
+		er.On("Search", []string{"abc"}, 10).
+			Return(nil, errors.New("error")).
+			Once()
+
+		_, err := uc.SearchEmployees("abc", 10)
+		assert.Error(t, err)
+	})
+}
+
+func TestEmployeeDisplayName(t *testing.T) {
+	tests := []struct {
+		employee domain.Employee
+		want     string
+	}{
+		{domain.Employee{FirstName: "Elizabeth", LastName: "Smith"}, "Elizabeth Smith"},
+		{domain.Employee{FirstName: "Elizabeth", PreferredName: "Liz", LastName: "Smith"}, "Liz Smith"},
+		{domain.Employee{FirstName: "Sukarno"}, "Sukarno"},
+		{domain.Employee{FirstName: "太郎", LastName: "山田", NameLocale: "ja-JP"}, "山田太郎"},
+		{domain.Employee{FirstName: "Taro", LastName: "Yamada", NameLocale: "ja"}, "Yamada Taro"},
+		{domain.Employee{FirstName: "Văn An", LastName: "Nguyễn", NameLocale: "vi"}, "Nguyễn Văn An"},
+		{domain.Employee{FirstName: "Taro", LastName: "Yamada", NameLocale: "en-US"}, "Taro Yamada"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, toEmployeeResponse(tt.employee, "/api").DisplayName)
+	}
+}
+
 func TestUpdateEmployeeById(t *testing.T) {
 	er := mocks.NewEmployeeRepository(t)
-	uc := NewEmployeeUsecase(er)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
 	logger.Init()
 
 	id := uint(1)
@@ -193,8 +479,13 @@ func TestUpdateEmployeeById(t *testing.T) {
 	}
 
 	t.Run("success", func(t *testing.T) {
+		createdAt := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
 		er.On("FindById", id).
-			Return(domain.Employee{ID: id}, nil).
+			Return(domain.Employee{
+				ID:        id,
+				CreatedAt: &createdAt,
+				Jobs:      []domain.JobAssignment{{ID: 2, Title: "Engineer", StartDate: parsedDate}},
+			}, nil).
 			Once()
 
 		er.On("FindByEmail", req.Email).
@@ -212,6 +503,146 @@ func TestUpdateEmployeeById(t *testing.T) {
 		assert.Equal(t, updated.LastName, res.LastName)
 		assert.Equal(t, updated.Email, res.Email)
 		assert.Equal(t, updated.HireDate, res.HireDate)
+		assert.Equal(t, &createdAt, res.CreatedAt)
+		if assert.NotNil(t, res.CurrentJob) {
+			assert.Equal(t, "Engineer", res.CurrentJob.Title)
+		}
+	})
+
+	t.Run("success keeps stored hire date outside policy", func(t *testing.T) {
+		legacyDate, _ := utils.ParseDateString("1965-05-01")
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, HireDate: legacyDate}, nil).
+			Once()
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, nil).
+			Once()
+
+		er.On("UpdateById", mock.Anything).
+			Return(nil).
+			Once()
+
+		legacy := req
+		legacy.HireDate = "1965-05-01"
+
+		res, err := uc.UpdateEmployeeById(id, legacy)
+		assert.NoError(t, err)
+		assert.Equal(t, legacyDate, res.HireDate)
+	})
+
+	t.Run("hire date out of range", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id}, nil).
+			Once()
+
+		invalid := req
+		invalid.HireDate = "1969-12-31"
+
+		_, err := uc.UpdateEmployeeById(id, invalid)
+		assert.ErrorIs(t, err, ErrHireDateOutOfRange)
+	})
+
+	t.Run("hire date locked after termination", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusTerminated}, nil).
+			Once()
+
+		_, err := uc.UpdateEmployeeById(id, req)
+		assert.ErrorIs(t, err, ErrHireDateLocked)
+	})
+
+	t.Run("success keeps custom fields", func(t *testing.T) {
+		stored := domain.CustomFieldValues{"badge": 7.0}
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, CustomFields: stored}, nil).
+			Once()
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, nil).
+			Once()
+
+		er.On("UpdateById", &updated).
+			Return(nil).
+			Once()
+
+		res, err := uc.UpdateEmployeeById(id, req)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}(stored), res.CustomFields)
+	})
+
+	t.Run("success keeps name preferences", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, PreferredName: "Rez", Pronouns: "he/him"}, nil).
+			Once()
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, nil).
+			Once()
+
+		kept := updated
+		kept.PreferredName = "Rez"
+		kept.Pronouns = "he/him"
+		er.On("UpdateById", &kept).
+			Return(nil).
+			Once()
+
+		res, err := uc.UpdateEmployeeById(id, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Rez", res.PreferredName)
+		assert.Equal(t, "Rez ozza", res.DisplayName)
+	})
+
+	t.Run("success clears preferred name", func(t *testing.T) {
+		empty := ""
+		withPreferences := req
+		withPreferences.PreferredName = &empty
+
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, PreferredName: "Rez", Pronouns: "he/him"}, nil).
+			Once()
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, nil).
+			Once()
+
+		cleared := updated
+		cleared.Pronouns = "he/him"
+		er.On("UpdateById", &cleared).
+			Return(nil).
+			Once()
+
+		res, err := uc.UpdateEmployeeById(id, withPreferences)
+		assert.NoError(t, err)
+		assert.Equal(t, "", res.PreferredName)
+		assert.Equal(t, "reza ozza", res.DisplayName)
+	})
+
+	t.Run("success replaces custom fields", func(t *testing.T) {
+		withFields := req
+		withFields.CustomFields = map[string]interface{}{}
+
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, CustomFields: domain.CustomFieldValues{"badge": 7.0}}, nil).
+			Once()
+
+		er.On("FindByEmail", req.Email).
+			Return(domain.Employee{}, nil).
+			Once()
+
+		fr.On("FindAll").
+			Return([]domain.CustomField{{ID: 1, Name: "badge", Type: domain.CustomFieldNumber}}, nil).
+			Once()
+
+		cleared := updated
+		cleared.CustomFields = domain.CustomFieldValues{}
+		er.On("UpdateById", &cleared).
+			Return(nil).
+			Once()
+
+		res, err := uc.UpdateEmployeeById(id, withFields)
+		assert.NoError(t, err)
+		assert.Empty(t, res.CustomFields)
 	})
 
 	t.Run("fail to update by id", func(t *testing.T) {
@@ -276,13 +707,38 @@ func TestUpdateEmployeeById(t *testing.T) {
 
 func TestDeleteEmployeeById(t *testing.T) {
 	er := mocks.NewEmployeeRepository(t)
-	uc := NewEmployeeUsecase(er)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
 	logger.Init()
 
 	id := uint(1)
 
 	t.Run("success", func(t *testing.T) {
-		er.On("DeleteById", id).
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusActive}, nil).
+			Once()
+
+		cr.On("FindItems", domain.ChecklistOffboarding).
+			Return([]domain.ChecklistItem{{ID: 2, Kind: domain.ChecklistOffboarding, Title: "Revoke badge"}}, nil).
+			Once()
+
+		er.On("DeleteById", id, mock.MatchedBy(func(tasks []domain.EmployeeTask) bool {
+			return len(tasks) == 1 && tasks[0].DueDate.Equal(utils.Today())
+		})).
+			Return(nil).
+			Once()
+
+		err := uc.DeleteEmployeeById(id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("terminated employee is not offboarded again", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusTerminated}, nil).
+			Once()
+
+		er.On("DeleteById", id, []domain.EmployeeTask(nil)).
 			Return(nil).
 			Once()
 
@@ -290,8 +746,25 @@ func TestDeleteEmployeeById(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("not found", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		err := uc.DeleteEmployeeById(id)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+
 	t.Run("fail to delete", func(t *testing.T) {
-		er.On("DeleteById", id).
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusActive}, nil).
+			Once()
+
+		cr.On("FindItems", domain.ChecklistOffboarding).
+			Return(nil, nil).
+			Once()
+
+		er.On("DeleteById", id, []domain.EmployeeTask{}).
 			Return(errors.New("error")).
 			Once()
 
@@ -299,3 +772,248 @@ func TestDeleteEmployeeById(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestTerminateEmployee(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	id := uint(1)
+	hireDate, _ := utils.ParseDateString("2024-03-03")
+	terminationDate, _ := utils.ParseDateString("2024-06-30")
+	req := domain.TerminationRequest{
+		TerminationDate: "2024-06-30",
+		Reason:          " resigned ",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, HireDate: hireDate, Status: domain.StatusActive}, nil).
+			Once()
+
+		cr.On("FindItems", domain.ChecklistOffboarding).
+			Return([]domain.ChecklistItem{{ID: 2, Kind: domain.ChecklistOffboarding, Title: "Revoke badge"}}, nil).
+			Once()
+
+		er.On("UpdateEmploymentStatus", &domain.Employee{
+			ID:                id,
+			HireDate:          hireDate,
+			Status:            domain.StatusTerminated,
+			TerminationDate:   &terminationDate,
+			TerminationReason: "resigned",
+		}, mock.MatchedBy(func(tasks []domain.EmployeeTask) bool {
+			return len(tasks) == 1 && tasks[0].DueDate.Equal(terminationDate)
+		})).Return(nil).Once()
+
+		res, err := uc.TerminateEmployee(id, req)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusTerminated, res.Status)
+		assert.Equal(t, &terminationDate, res.TerminationDate)
+		assert.Equal(t, "resigned", res.TerminationReason)
+	})
+
+	t.Run("already terminated", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, HireDate: hireDate, Status: domain.StatusTerminated}, nil).
+			Once()
+
+		_, err := uc.TerminateEmployee(id, req)
+		assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+	})
+
+	t.Run("termination before hire date", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, HireDate: hireDate, Status: domain.StatusActive}, nil).
+			Once()
+
+		_, err := uc.TerminateEmployee(id, domain.TerminationRequest{TerminationDate: "2024-01-01"})
+		assert.ErrorIs(t, err, ErrTerminationBeforeHire)
+	})
+
+	t.Run("fail to parse date", func(t *testing.T) {
+		_, err := uc.TerminateEmployee(id, domain.TerminationRequest{TerminationDate: "abc"})
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+
+	t.Run("fail to find by id", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{}, repositories.ErrRecordNotFound).
+			Once()
+
+		_, err := uc.TerminateEmployee(id, req)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestRehireEmployee(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	id := uint(1)
+	hireDate, _ := utils.ParseDateString("2020-01-06")
+	terminationDate, _ := utils.ParseDateString("2022-12-31")
+	rehireDate, _ := utils.ParseDateString("2024-03-03")
+
+	terminated := domain.Employee{
+		ID:                id,
+		HireDate:          hireDate,
+		Status:            domain.StatusTerminated,
+		TerminationDate:   &terminationDate,
+		TerminationReason: "resigned",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(terminated, nil).
+			Once()
+
+		er.On("UpdateEmploymentStatus", &domain.Employee{
+			ID:       id,
+			HireDate: rehireDate,
+			Status:   domain.StatusActive,
+		}, []domain.EmployeeTask(nil)).Return(nil).Once()
+
+		res, err := uc.RehireEmployee(id, domain.RehireRequest{HireDate: "2024-03-03"})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusActive, res.Status)
+		assert.Nil(t, res.TerminationDate)
+	})
+
+	t.Run("rehire date out of range", func(t *testing.T) {
+		rehire := utils.Today().AddDate(0, 0, 366).Format(utils.ISODate)
+
+		_, err := uc.RehireEmployee(id, domain.RehireRequest{HireDate: rehire})
+		assert.ErrorIs(t, err, ErrHireDateOutOfRange)
+	})
+
+	t.Run("rehire before termination", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(terminated, nil).
+			Once()
+
+		_, err := uc.RehireEmployee(id, domain.RehireRequest{HireDate: "2022-12-31"})
+		assert.ErrorIs(t, err, ErrRehireBeforeTermination)
+	})
+
+	t.Run("not terminated", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusActive}, nil).
+			Once()
+
+		_, err := uc.RehireEmployee(id, domain.RehireRequest{HireDate: "2024-03-03"})
+		assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+	})
+
+	t.Run("fail to update", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(terminated, nil).
+			Once()
+
+		er.On("UpdateEmploymentStatus", mock.Anything, mock.Anything).
+			Return(errors.New("error")).
+			Once()
+
+		_, err := uc.RehireEmployee(id, domain.RehireRequest{HireDate: "2024-03-03"})
+		assert.Error(t, err)
+	})
+}
+
+func TestLeaveTransitions(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	id := uint(1)
+
+	t.Run("place on leave success", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusActive}, nil).
+			Once()
+
+		er.On("UpdateEmploymentStatus", &domain.Employee{ID: id, Status: domain.StatusOnLeave}, []domain.EmployeeTask(nil)).
+			Return(nil).
+			Once()
+
+		res, err := uc.PlaceEmployeeOnLeave(id)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusOnLeave, res.Status)
+	})
+
+	t.Run("return from leave when active", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusActive}, nil).
+			Once()
+
+		_, err := uc.ReturnEmployeeFromLeave(id)
+		assert.ErrorIs(t, err, ErrInvalidStatusTransition)
+	})
+
+	t.Run("activate pending start", func(t *testing.T) {
+		er.On("FindById", id).
+			Return(domain.Employee{ID: id, Status: domain.StatusPendingStart}, nil).
+			Once()
+
+		er.On("UpdateEmploymentStatus", &domain.Employee{ID: id, Status: domain.StatusActive}, []domain.EmployeeTask(nil)).
+			Return(nil).
+			Once()
+
+		res, err := uc.ActivateEmployee(id)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.StatusActive, res.Status)
+	})
+}
+
+func TestAssignManager(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	cr := mocks.NewChecklistRepository(t)
+	fr := mocks.NewCustomFieldRepository(t)
+	uc := NewEmployeeUsecase(er, cr, fr, hireDatePolicy, "/api")
+	logger.Init()
+
+	id := uint(1)
+	managerId := uint(2)
+	directorId := uint(3)
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", id).Return(domain.Employee{ID: id}, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId, ManagerID: &directorId}, nil).Once()
+		er.On("FindById", directorId).Return(domain.Employee{ID: directorId}, nil).Once()
+		er.On("UpdateManager", id, &managerId).Return(nil).Once()
+
+		res, err := uc.AssignManager(id, domain.ManagerRequest{ManagerId: &managerId})
+		assert.NoError(t, err)
+		assert.Equal(t, &managerId, res.ManagerId)
+	})
+
+	t.Run("remove manager", func(t *testing.T) {
+		er.On("FindById", id).Return(domain.Employee{ID: id, ManagerID: &managerId}, nil).Once()
+		er.On("UpdateManager", id, (*uint)(nil)).Return(nil).Once()
+
+		res, err := uc.AssignManager(id, domain.ManagerRequest{})
+		assert.NoError(t, err)
+		assert.Nil(t, res.ManagerId)
+	})
+
+	t.Run("reporting cycle", func(t *testing.T) {
+		er.On("FindById", id).Return(domain.Employee{ID: id}, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId, ManagerID: &id}, nil).Once()
+
+		_, err := uc.AssignManager(id, domain.ManagerRequest{ManagerId: &managerId})
+		assert.ErrorIs(t, err, ErrInvalidManager)
+	})
+
+	t.Run("manager not found", func(t *testing.T) {
+		er.On("FindById", id).Return(domain.Employee{ID: id}, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.AssignManager(id, domain.ManagerRequest{ManagerId: &managerId})
+		assert.ErrorIs(t, err, ErrManagerNotFound)
+	})
+}
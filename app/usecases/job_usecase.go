@@ -0,0 +1,154 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type JobUsecase interface {
+	AssignJob(employeeId uint, req domain.JobAssignmentRequest) (domain.JobAssignmentResponse, error)
+	GetJobHistory(employeeId uint, date string) ([]domain.JobAssignmentResponse, error)
+}
+
+type jobUsecase struct {
+	employeeRepository      repositories.EmployeeRepository
+	positionRepository      repositories.PositionRepository
+	jobAssignmentRepository repositories.JobAssignmentRepository
+}
+
+var (
+	ErrJobStartBeforeHire       = errors.New("job start date is before hire date")
+	ErrJobStartBeforeCurrentJob = errors.New("job start date must be after the start of the current job")
+	ErrEmployeeTerminated       = errors.New("employee is terminated")
+)
+
+func NewJobUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	positionRepository repositories.PositionRepository,
+	jobAssignmentRepository repositories.JobAssignmentRepository,
+) JobUsecase {
+	return &jobUsecase{
+		employeeRepository:      employeeRepository,
+		positionRepository:      positionRepository,
+		jobAssignmentRepository: jobAssignmentRepository,
+	}
+}
+
+func (uc *jobUsecase) AssignJob(employeeId uint, req domain.JobAssignmentRequest) (domain.JobAssignmentResponse, error) {
+	startDate, err := utils.ParseDateString(req.StartDate)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse Start Date")
+		return domain.JobAssignmentResponse{}, ErrInvalidDate
+	}
+
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.JobAssignmentResponse{}, err
+	}
+
+	if employee.Status == domain.StatusTerminated {
+		return domain.JobAssignmentResponse{}, ErrEmployeeTerminated
+	}
+
+	if startDate.Before(employee.HireDate) {
+		return domain.JobAssignmentResponse{}, ErrJobStartBeforeHire
+	}
+
+	position, err := uc.positionRepository.FindById(req.PositionID)
+	if err != nil {
+		logger.Log.Error(err, "failed to find position by id")
+		return domain.JobAssignmentResponse{}, err
+	}
+
+	history, err := uc.jobAssignmentRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find job history")
+		return domain.JobAssignmentResponse{}, err
+	}
+
+	for _, a := range history {
+		if a.EndDate == nil && !startDate.After(a.StartDate) {
+			return domain.JobAssignmentResponse{}, ErrJobStartBeforeCurrentJob
+		}
+	}
+
+	assignment := domain.JobAssignment{
+		EmployeeID: employeeId,
+		PositionID: position.ID,
+		Title:      position.Title,
+		Department: position.Department,
+		Grade:      position.Grade,
+		StartDate:  startDate,
+	}
+
+	if req.Department != "" {
+		assignment.Department = req.Department
+	}
+
+	if req.Grade != "" {
+		assignment.Grade = req.Grade
+	}
+
+	if err := uc.jobAssignmentRepository.Assign(&assignment); err != nil {
+		logger.Log.Error(err, "failed to assign job")
+		return domain.JobAssignmentResponse{}, err
+	}
+
+	logger.Log.Info("successfully assign job", "employeeId", employeeId, "positionId", position.ID)
+	return toJobAssignmentResponse(assignment), nil
+}
+
+// GetJobHistory returns the job timeline of the employee ordered by start
+// date. When date is set only the assignment covering that day is returned.
+func (uc *jobUsecase) GetJobHistory(employeeId uint, date string) ([]domain.JobAssignmentResponse, error) {
+	var onDate time.Time
+	if date != "" {
+		parsed, err := utils.ParseDateString(date)
+		if err != nil {
+			logger.Log.Error(err, "failed to parse date")
+			return nil, ErrInvalidDate
+		}
+
+		onDate = parsed
+	}
+
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return nil, err
+	}
+
+	history, err := uc.jobAssignmentRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find job history")
+		return nil, err
+	}
+
+	res := make([]domain.JobAssignmentResponse, 0, len(history))
+	for _, a := range history {
+		if !onDate.IsZero() && !a.ActiveOn(onDate) {
+			continue
+		}
+
+		res = append(res, toJobAssignmentResponse(a))
+	}
+
+	return res, nil
+}
+
+func toJobAssignmentResponse(a domain.JobAssignment) domain.JobAssignmentResponse {
+	return domain.JobAssignmentResponse{
+		Id:         a.ID,
+		PositionId: a.PositionID,
+		Title:      a.Title,
+		Department: a.Department,
+		Grade:      a.Grade,
+		StartDate:  a.StartDate,
+		EndDate:    a.EndDate,
+	}
+}
@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignJob(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	pr := mocks.NewPositionRepository(t)
+	jr := mocks.NewJobAssignmentRepository(t)
+	uc := NewJobUsecase(er, pr, jr)
+	logger.Init()
+
+	employeeId := uint(1)
+	hireDate, _ := utils.ParseDateString("2022-01-03")
+	currentStart, _ := utils.ParseDateString("2022-01-03")
+	startDate, _ := utils.ParseDateString("2024-04-01")
+
+	employee := domain.Employee{ID: employeeId, HireDate: hireDate, Status: domain.StatusActive}
+	position := domain.Position{ID: 2, Title: "Senior Engineer", Department: "Engineering", Grade: "G6"}
+	history := []domain.JobAssignment{{ID: 1, EmployeeID: employeeId, Title: "Engineer", StartDate: currentStart}}
+
+	req := domain.JobAssignmentRequest{PositionID: 2, StartDate: "2024-04-01", Grade: "G7"}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		pr.On("FindById", uint(2)).Return(position, nil).Once()
+		jr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+		jr.On("Assign", &domain.JobAssignment{
+			EmployeeID: employeeId,
+			PositionID: 2,
+			Title:      "Senior Engineer",
+			Department: "Engineering",
+			Grade:      "G7",
+			StartDate:  startDate,
+		}).Return(nil).Once()
+
+		res, err := uc.AssignJob(employeeId, req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Senior Engineer", res.Title)
+		assert.Equal(t, "G7", res.Grade)
+	})
+
+	t.Run("start before current job", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		pr.On("FindById", uint(2)).Return(position, nil).Once()
+		jr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		_, err := uc.AssignJob(employeeId, domain.JobAssignmentRequest{PositionID: 2, StartDate: "2022-01-03"})
+		assert.ErrorIs(t, err, ErrJobStartBeforeCurrentJob)
+	})
+
+	t.Run("start before hire date", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+
+		_, err := uc.AssignJob(employeeId, domain.JobAssignmentRequest{PositionID: 2, StartDate: "2021-01-01"})
+		assert.ErrorIs(t, err, ErrJobStartBeforeHire)
+	})
+
+	t.Run("employee terminated", func(t *testing.T) {
+		er.On("FindById", employeeId).
+			Return(domain.Employee{ID: employeeId, HireDate: hireDate, Status: domain.StatusTerminated}, nil).
+			Once()
+
+		_, err := uc.AssignJob(employeeId, req)
+		assert.ErrorIs(t, err, ErrEmployeeTerminated)
+	})
+
+	t.Run("position not found", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		pr.On("FindById", uint(2)).Return(domain.Position{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.AssignJob(employeeId, req)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := uc.AssignJob(employeeId, domain.JobAssignmentRequest{PositionID: 2, StartDate: "abc"})
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
+
+func TestGetJobHistory(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	pr := mocks.NewPositionRepository(t)
+	jr := mocks.NewJobAssignmentRepository(t)
+	uc := NewJobUsecase(er, pr, jr)
+	logger.Init()
+
+	employeeId := uint(1)
+	firstStart, _ := utils.ParseDateString("2020-01-06")
+	firstEnd, _ := utils.ParseDateString("2022-03-31")
+	secondStart, _ := utils.ParseDateString("2022-04-01")
+
+	history := []domain.JobAssignment{
+		{ID: 1, EmployeeID: employeeId, Title: "Engineer", StartDate: firstStart, EndDate: &firstEnd},
+		{ID: 2, EmployeeID: employeeId, Title: "Senior Engineer", StartDate: secondStart},
+	}
+
+	t.Run("full timeline", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		jr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		res, err := uc.GetJobHistory(employeeId, "")
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+	})
+
+	t.Run("title on date", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		jr.On("FindByEmployeeId", employeeId).Return(history, nil).Once()
+
+		res, err := uc.GetJobHistory(employeeId, "2022-03-31")
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+		assert.Equal(t, "Engineer", res[0].Title)
+	})
+
+	t.Run("employee not found", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.GetJobHistory(employeeId, "")
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		_, err := uc.GetJobHistory(employeeId, "abc")
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
+
+func TestEmployeeResponseCurrentJob(t *testing.T) {
+	start, _ := utils.ParseDateString("2022-04-01")
+	res := toEmployeeResponse(domain.Employee{
+		ID:   1,
+		Jobs: []domain.JobAssignment{{ID: 2, Title: "Senior Engineer", StartDate: start}},
+	}, "/api")
+
+	assert.NotNil(t, res.CurrentJob)
+	assert.Equal(t, "Senior Engineer", res.CurrentJob.Title)
+}
@@ -0,0 +1,472 @@
+package usecases
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type LeaveUsecase interface {
+	CreateLeaveType(req domain.LeaveTypeRequest) (domain.LeaveTypeResponse, error)
+	GetAllLeaveType() ([]domain.LeaveTypeResponse, error)
+	GetLeaveBalances(employeeId uint, year int) ([]domain.LeaveBalanceResponse, error)
+	RequestLeave(employeeId uint, req domain.LeaveRequest) (domain.LeaveResponse, error)
+	GetLeaveRequests(employeeId uint) ([]domain.LeaveResponse, error)
+	ApproveLeave(id, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error)
+	RejectLeave(id, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error)
+}
+
+type leaveUsecase struct {
+	employeeRepository  repositories.EmployeeRepository
+	leaveTypeRepository repositories.LeaveTypeRepository
+	leaveRepository     repositories.LeaveRepository
+	calendarRepository  repositories.CalendarRepository
+}
+
+var (
+	ErrDuplicateLeaveType       = errors.New("leave type code already exists")
+	ErrLeaveTypeNotFound        = errors.New("leave type not found")
+	ErrLeaveEndBeforeStart      = errors.New("end date is before start date")
+	ErrLeaveSpansYears          = errors.New("leave must start and end in the same year")
+	ErrLeaveBeforeHire          = errors.New("leave starts before hire date")
+	ErrNoWorkingDays            = errors.New("leave does not cover any working day")
+	ErrLeaveOverlap             = errors.New("leave overlaps another pending or approved leave")
+	ErrInsufficientLeaveBalance = errors.New("insufficient leave balance")
+	ErrLeaveNotPending          = errors.New("leave request is not pending")
+	ErrNotEmployeeManager       = errors.New("only the manager of the employee can decide on leave requests")
+)
+
+func NewLeaveUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	leaveTypeRepository repositories.LeaveTypeRepository,
+	leaveRepository repositories.LeaveRepository,
+	calendarRepository repositories.CalendarRepository,
+) LeaveUsecase {
+	return &leaveUsecase{
+		employeeRepository:  employeeRepository,
+		leaveTypeRepository: leaveTypeRepository,
+		leaveRepository:     leaveRepository,
+		calendarRepository:  calendarRepository,
+	}
+}
+
+func (uc *leaveUsecase) CreateLeaveType(req domain.LeaveTypeRequest) (domain.LeaveTypeResponse, error) {
+	found, err := uc.leaveTypeRepository.FindByCode(req.Code)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find leave type by code")
+		return domain.LeaveTypeResponse{}, err
+	}
+
+	if found.ID != 0 {
+		return domain.LeaveTypeResponse{}, ErrDuplicateLeaveType
+	}
+
+	leaveType := domain.LeaveType{
+		Code:          req.Code,
+		Name:          req.Name,
+		AnnualDays:    req.AnnualDays,
+		CarryOverDays: req.CarryOverDays,
+	}
+
+	if err := uc.leaveTypeRepository.Store(&leaveType); err != nil {
+		logger.Log.Error(err, "failed to store leave type")
+		return domain.LeaveTypeResponse{}, err
+	}
+
+	logger.Log.Info("successfully create leave type", "id", leaveType.ID, "code", leaveType.Code)
+	return toLeaveTypeResponse(leaveType), nil
+}
+
+func (uc *leaveUsecase) GetAllLeaveType() ([]domain.LeaveTypeResponse, error) {
+	leaveTypes, err := uc.leaveTypeRepository.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all leave type")
+		return nil, err
+	}
+
+	res := make([]domain.LeaveTypeResponse, 0, len(leaveTypes))
+	for _, t := range leaveTypes {
+		res = append(res, toLeaveTypeResponse(t))
+	}
+
+	return res, nil
+}
+
+// GetLeaveBalances returns the balance of every leave type in year. Balances
+// are only stored when leave is requested, until then they are computed.
+func (uc *leaveUsecase) GetLeaveBalances(employeeId uint, year int) ([]domain.LeaveBalanceResponse, error) {
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return nil, err
+	}
+
+	leaveTypes, err := uc.leaveTypeRepository.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all leave type")
+		return nil, err
+	}
+
+	leaves, err := uc.leaveRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave requests")
+		return nil, err
+	}
+
+	res := make([]domain.LeaveBalanceResponse, 0, len(leaveTypes))
+	for _, t := range leaveTypes {
+		balance, err := uc.findBalance(employee, t, year)
+		if err != nil {
+			return nil, err
+		}
+
+		pending := pendingDays(leaves, t.ID, year)
+		res = append(res, domain.LeaveBalanceResponse{
+			LeaveTypeId:   t.ID,
+			LeaveTypeCode: t.Code,
+			Year:          year,
+			Entitled:      balance.Entitled,
+			CarriedOver:   balance.CarriedOver,
+			Used:          balance.Used,
+			Pending:       pending,
+			Available:     balance.Remaining() - pending,
+		})
+	}
+
+	return res, nil
+}
+
+func (uc *leaveUsecase) RequestLeave(employeeId uint, req domain.LeaveRequest) (domain.LeaveResponse, error) {
+	startDate, err := utils.ParseDateString(req.StartDate)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse Start Date")
+		return domain.LeaveResponse{}, ErrInvalidDate
+	}
+
+	endDate, err := utils.ParseDateString(req.EndDate)
+	if err != nil {
+		logger.Log.Error(err, "failed to parse End Date")
+		return domain.LeaveResponse{}, ErrInvalidDate
+	}
+
+	if endDate.Before(startDate) {
+		return domain.LeaveResponse{}, ErrLeaveEndBeforeStart
+	}
+
+	if startDate.Year() != endDate.Year() {
+		return domain.LeaveResponse{}, ErrLeaveSpansYears
+	}
+
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.LeaveResponse{}, err
+	}
+
+	if employee.Status == domain.StatusTerminated {
+		return domain.LeaveResponse{}, ErrEmployeeTerminated
+	}
+
+	if startDate.Before(employee.HireDate) {
+		return domain.LeaveResponse{}, ErrLeaveBeforeHire
+	}
+
+	days, err := uc.countWorkingDays(employee, startDate, endDate)
+	if err != nil {
+		return domain.LeaveResponse{}, err
+	}
+
+	if days == 0 {
+		return domain.LeaveResponse{}, ErrNoWorkingDays
+	}
+
+	leaveType, err := uc.findLeaveType(req.LeaveTypeID)
+	if err != nil {
+		return domain.LeaveResponse{}, err
+	}
+
+	leaves, err := uc.leaveRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave requests")
+		return domain.LeaveResponse{}, err
+	}
+
+	for _, l := range leaves {
+		if l.Status != domain.LeaveStatusRejected && l.Overlaps(startDate, endDate) {
+			return domain.LeaveResponse{}, ErrLeaveOverlap
+		}
+	}
+
+	balance, err := uc.ensureBalance(employee, leaveType, startDate.Year())
+	if err != nil {
+		return domain.LeaveResponse{}, err
+	}
+
+	if balance.Remaining()-pendingDays(leaves, leaveType.ID, startDate.Year()) < days {
+		return domain.LeaveResponse{}, ErrInsufficientLeaveBalance
+	}
+
+	leave := domain.Leave{
+		EmployeeID:  employeeId,
+		LeaveTypeID: leaveType.ID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Days:        days,
+		Reason:      strings.TrimSpace(req.Reason),
+		Status:      domain.LeaveStatusPending,
+	}
+
+	if err := uc.leaveRepository.Store(&leave); err != nil {
+		logger.Log.Error(err, "failed to store leave request")
+		return domain.LeaveResponse{}, err
+	}
+
+	logger.Log.Info("successfully request leave", "employeeId", employeeId, "days", days)
+	return toLeaveResponse(leave), nil
+}
+
+func (uc *leaveUsecase) GetLeaveRequests(employeeId uint) ([]domain.LeaveResponse, error) {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return nil, err
+	}
+
+	leaves, err := uc.leaveRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave requests")
+		return nil, err
+	}
+
+	res := make([]domain.LeaveResponse, 0, len(leaves))
+	for _, l := range leaves {
+		res = append(res, toLeaveResponse(l))
+	}
+
+	return res, nil
+}
+
+func (uc *leaveUsecase) ApproveLeave(id, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error) {
+	return uc.decideLeave(id, approverId, req, func(leave *domain.Leave, employee domain.Employee) error {
+		return uc.leaveRepository.Approve(leave)
+	})
+}
+
+func (uc *leaveUsecase) RejectLeave(id, approverId uint, req domain.LeaveDecisionRequest) (domain.LeaveResponse, error) {
+	return uc.decideLeave(id, approverId, req, func(leave *domain.Leave, employee domain.Employee) error {
+		return uc.leaveRepository.Reject(leave)
+	})
+}
+
+// decideLeave checks that the leave is pending and that the approver is the
+// current manager of the employee before store is called.
+func (uc *leaveUsecase) decideLeave(id, approverId uint, req domain.LeaveDecisionRequest, store func(leave *domain.Leave, employee domain.Employee) error) (domain.LeaveResponse, error) {
+	leave, err := uc.leaveRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave request by id")
+		return domain.LeaveResponse{}, err
+	}
+
+	if leave.Status != domain.LeaveStatusPending {
+		return domain.LeaveResponse{}, ErrLeaveNotPending
+	}
+
+	employee, err := uc.employeeRepository.FindById(leave.EmployeeID)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.LeaveResponse{}, err
+	}
+
+	if employee.ManagerID == nil || *employee.ManagerID != approverId {
+		return domain.LeaveResponse{}, ErrNotEmployeeManager
+	}
+
+	if _, err := uc.employeeRepository.FindById(approverId); err != nil {
+		logger.Log.Error(err, "failed to find approver by id")
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			return domain.LeaveResponse{}, ErrNotEmployeeManager
+		}
+
+		return domain.LeaveResponse{}, err
+	}
+
+	now := time.Now()
+	leave.ApproverID = &approverId
+	leave.DecisionNote = strings.TrimSpace(req.Note)
+	leave.DecidedAt = &now
+
+	if err := store(&leave, employee); err != nil {
+		if errors.Is(err, repositories.ErrLeaveAlreadyDecided) {
+			return domain.LeaveResponse{}, ErrLeaveNotPending
+		}
+
+		if errors.Is(err, repositories.ErrInsufficientBalance) {
+			return domain.LeaveResponse{}, ErrInsufficientLeaveBalance
+		}
+
+		logger.Log.Error(err, "failed to decide leave request")
+		return domain.LeaveResponse{}, err
+	}
+
+	logger.Log.Info("successfully decide leave request", "id", id, "status", leave.Status)
+	return toLeaveResponse(leave), nil
+}
+
+// countWorkingDays uses the work calendar of the employee, employees without
+// one work Monday to Friday.
+func (uc *leaveUsecase) countWorkingDays(employee domain.Employee, start, end time.Time) (int, error) {
+	if err := checkWorkingDaysRange(start, end); err != nil {
+		return 0, err
+	}
+
+	if employee.CalendarID == nil {
+		return utils.CountWeekdays(start, end), nil
+	}
+
+	calendar, err := uc.calendarRepository.FindById(*employee.CalendarID)
+	if err != nil {
+		logger.Log.Error(err, "failed to find calendar by id")
+		return 0, err
+	}
+
+	return calendar.WorkingDaysBetween(start, end), nil
+}
+
+func (uc *leaveUsecase) findLeaveType(id uint) (domain.LeaveType, error) {
+	leaveType, err := uc.leaveTypeRepository.FindById(id)
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return domain.LeaveType{}, ErrLeaveTypeNotFound
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave type by id")
+		return domain.LeaveType{}, err
+	}
+
+	return leaveType, nil
+}
+
+// findBalance returns the stored balance of the employee for year, or the
+// one accrueBalance would store.
+func (uc *leaveUsecase) findBalance(employee domain.Employee, leaveType domain.LeaveType, year int) (domain.LeaveBalance, error) {
+	balance, err := uc.leaveRepository.FindBalance(employee.ID, leaveType.ID, year)
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return uc.accrueBalance(employee, leaveType, year)
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave balance")
+		return domain.LeaveBalance{}, err
+	}
+
+	return balance, nil
+}
+
+// ensureBalance returns the balance of the employee for year, storing it
+// first when it does not exist yet. Requests accruing the same balance at
+// the same time all read the one that was stored.
+func (uc *leaveUsecase) ensureBalance(employee domain.Employee, leaveType domain.LeaveType, year int) (domain.LeaveBalance, error) {
+	balance, err := uc.leaveRepository.FindBalance(employee.ID, leaveType.ID, year)
+	if err == nil {
+		return balance, nil
+	}
+
+	if !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find leave balance")
+		return domain.LeaveBalance{}, err
+	}
+
+	balance, err = uc.accrueBalance(employee, leaveType, year)
+	if err != nil {
+		return domain.LeaveBalance{}, err
+	}
+
+	if err := uc.leaveRepository.StoreBalance(&balance); err != nil {
+		logger.Log.Error(err, "failed to store leave balance")
+		return domain.LeaveBalance{}, err
+	}
+
+	balance, err = uc.leaveRepository.FindBalance(employee.ID, leaveType.ID, year)
+	if err != nil {
+		logger.Log.Error(err, "failed to find leave balance")
+		return domain.LeaveBalance{}, err
+	}
+
+	return balance, nil
+}
+
+// accrueBalance builds the balance of the employee for year from the accrual
+// rules of the leave type. Unused days of the previous year, stored or
+// accrued the same way, are carried over up to the limit of the leave type.
+func (uc *leaveUsecase) accrueBalance(employee domain.Employee, leaveType domain.LeaveType, year int) (domain.LeaveBalance, error) {
+	balance := domain.LeaveBalance{
+		EmployeeID:  employee.ID,
+		LeaveTypeID: leaveType.ID,
+		Year:        year,
+		Entitled:    leaveType.EntitlementFor(employee.HireDate, year),
+	}
+
+	if year <= employee.HireDate.Year() {
+		return balance, nil
+	}
+
+	previous, err := uc.findBalance(employee, leaveType, year-1)
+	if err != nil {
+		return domain.LeaveBalance{}, err
+	}
+
+	balance.CarriedOver = previous.Remaining()
+	if balance.CarriedOver > leaveType.CarryOverDays {
+		balance.CarriedOver = leaveType.CarryOverDays
+	}
+
+	if balance.CarriedOver < 0 {
+		balance.CarriedOver = 0
+	}
+
+	return balance, nil
+}
+
+// pendingDays sums the days of leave of one type and year waiting for a
+// decision, they are reserved so requests cannot overdraw the balance.
+func pendingDays(leaves []domain.Leave, leaveTypeId uint, year int) int {
+	days := 0
+	for _, l := range leaves {
+		if l.Status == domain.LeaveStatusPending && l.LeaveTypeID == leaveTypeId && l.StartDate.Year() == year {
+			days += l.Days
+		}
+	}
+
+	return days
+}
+
+func toLeaveTypeResponse(t domain.LeaveType) domain.LeaveTypeResponse {
+	return domain.LeaveTypeResponse{
+		Id:            t.ID,
+		Code:          t.Code,
+		Name:          t.Name,
+		AnnualDays:    t.AnnualDays,
+		CarryOverDays: t.CarryOverDays,
+	}
+}
+
+func toLeaveResponse(l domain.Leave) domain.LeaveResponse {
+	return domain.LeaveResponse{
+		Id:           l.ID,
+		EmployeeId:   l.EmployeeID,
+		LeaveTypeId:  l.LeaveTypeID,
+		StartDate:    l.StartDate,
+		EndDate:      l.EndDate,
+		Days:         l.Days,
+		Reason:       l.Reason,
+		Status:       l.Status,
+		ApproverId:   l.ApproverID,
+		DecisionNote: l.DecisionNote,
+		DecidedAt:    l.DecidedAt,
+		CreatedAt:    l.CreatedAt,
+	}
+}
@@ -0,0 +1,273 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestLeave(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	tr := mocks.NewLeaveTypeRepository(t)
+	lr := mocks.NewLeaveRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewLeaveUsecase(er, tr, lr, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+	hireDate, _ := utils.ParseDateString("2022-01-03")
+	startDate, _ := utils.ParseDateString("2024-05-02")
+	endDate, _ := utils.ParseDateString("2024-05-08")
+
+	employee := domain.Employee{ID: employeeId, HireDate: hireDate, Status: domain.StatusActive}
+	annual := domain.LeaveType{ID: 1, Code: "annual", AnnualDays: 12, CarryOverDays: 5}
+	req := domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-05-02", EndDate: "2024-05-08", Reason: " holiday "}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		tr.On("FindById", uint(1)).Return(annual, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(nil, nil).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2024).
+			Return(domain.LeaveBalance{EmployeeID: employeeId, LeaveTypeID: 1, Year: 2024, Entitled: 12}, nil).
+			Once()
+		lr.On("Store", &domain.Leave{
+			EmployeeID:  employeeId,
+			LeaveTypeID: 1,
+			StartDate:   startDate,
+			EndDate:     endDate,
+			Days:        5,
+			Reason:      "holiday",
+			Status:      domain.LeaveStatusPending,
+		}).Return(nil).Once()
+
+		res, err := uc.RequestLeave(employeeId, req)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res.Days)
+		assert.Equal(t, domain.LeaveStatusPending, res.Status)
+	})
+
+	t.Run("balance accrued with carry over", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		tr.On("FindById", uint(1)).Return(annual, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(nil, nil).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2024).Return(domain.LeaveBalance{}, repositories.ErrRecordNotFound).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2023).
+			Return(domain.LeaveBalance{Entitled: 12, Used: 4}, nil).
+			Once()
+		lr.On("StoreBalance", &domain.LeaveBalance{
+			EmployeeID:  employeeId,
+			LeaveTypeID: 1,
+			Year:        2024,
+			Entitled:    12,
+			CarriedOver: 5,
+		}).Return(nil).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2024).
+			Return(domain.LeaveBalance{EmployeeID: employeeId, LeaveTypeID: 1, Year: 2024, Entitled: 12, CarriedOver: 5}, nil).
+			Once()
+		lr.On("Store", mock.Anything).Return(nil).Once()
+
+		_, err := uc.RequestLeave(employeeId, req)
+		assert.NoError(t, err)
+	})
+
+	t.Run("insufficient balance with pending requests", func(t *testing.T) {
+		pendingStart, _ := utils.ParseDateString("2024-03-04")
+		pendingEnd, _ := utils.ParseDateString("2024-03-08")
+		pending := []domain.Leave{{ID: 9, LeaveTypeID: 1, StartDate: pendingStart, EndDate: pendingEnd, Days: 5, Status: domain.LeaveStatusPending}}
+
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		tr.On("FindById", uint(1)).Return(annual, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(pending, nil).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2024).
+			Return(domain.LeaveBalance{Entitled: 12, Used: 3}, nil).
+			Once()
+
+		_, err := uc.RequestLeave(employeeId, req)
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+	})
+
+	t.Run("overlapping leave", func(t *testing.T) {
+		existing := []domain.Leave{{ID: 9, LeaveTypeID: 1, StartDate: endDate, EndDate: endDate, Days: 1, Status: domain.LeaveStatusApproved}}
+
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		tr.On("FindById", uint(1)).Return(annual, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(existing, nil).Once()
+
+		_, err := uc.RequestLeave(employeeId, req)
+		assert.ErrorIs(t, err, ErrLeaveOverlap)
+	})
+
+	t.Run("holidays in employee calendar", func(t *testing.T) {
+		calendarId := uint(4)
+		holiday, _ := utils.ParseDateString("2024-05-09")
+		calendar := domain.WorkCalendar{ID: calendarId, WorkingDays: "mon,tue,wed,thu,fri", Holidays: []domain.Holiday{{Date: holiday}}}
+
+		er.On("FindById", employeeId).
+			Return(domain.Employee{ID: employeeId, HireDate: hireDate, Status: domain.StatusActive, CalendarID: &calendarId}, nil).
+			Once()
+		cr.On("FindById", calendarId).Return(calendar, nil).Once()
+		tr.On("FindById", uint(1)).Return(annual, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(nil, nil).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2024).Return(domain.LeaveBalance{Entitled: 12}, nil).Once()
+		lr.On("Store", mock.Anything).Return(nil).Once()
+
+		res, err := uc.RequestLeave(employeeId, domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-05-08", EndDate: "2024-05-10"})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, res.Days)
+	})
+
+	t.Run("weekend only", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+
+		_, err := uc.RequestLeave(employeeId, domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-05-04", EndDate: "2024-05-05"})
+		assert.ErrorIs(t, err, ErrNoWorkingDays)
+	})
+
+	t.Run("spans years", func(t *testing.T) {
+		_, err := uc.RequestLeave(employeeId, domain.LeaveRequest{LeaveTypeID: 1, StartDate: "2024-12-30", EndDate: "2025-01-02"})
+		assert.ErrorIs(t, err, ErrLeaveSpansYears)
+	})
+
+	t.Run("deleted employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.RequestLeave(employeeId, req)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestGetLeaveBalances(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	tr := mocks.NewLeaveTypeRepository(t)
+	lr := mocks.NewLeaveRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewLeaveUsecase(er, tr, lr, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+	hireDate, _ := utils.ParseDateString("2022-01-03")
+	employee := domain.Employee{ID: employeeId, HireDate: hireDate}
+	annual := domain.LeaveType{ID: 1, Code: "annual", AnnualDays: 12, CarryOverDays: 5}
+
+	t.Run("balance not accrued yet is not stored", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		tr.On("FindAll").Return([]domain.LeaveType{annual}, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(nil, nil).Once()
+		for year := 2030; year >= 2022; year-- {
+			lr.On("FindBalance", employeeId, uint(1), year).Return(domain.LeaveBalance{}, repositories.ErrRecordNotFound).Once()
+		}
+
+		res, err := uc.GetLeaveBalances(employeeId, 2030)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res[0].CarriedOver)
+		assert.Equal(t, 17, res[0].Available)
+		lr.AssertNotCalled(t, "StoreBalance", mock.Anything)
+	})
+
+	t.Run("carries over from a year without stored balance", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		tr.On("FindAll").Return([]domain.LeaveType{annual}, nil).Once()
+		lr.On("FindByEmployeeId", employeeId).Return(nil, nil).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2024).Return(domain.LeaveBalance{}, repositories.ErrRecordNotFound).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2023).Return(domain.LeaveBalance{}, repositories.ErrRecordNotFound).Once()
+		lr.On("FindBalance", employeeId, uint(1), 2022).Return(domain.LeaveBalance{Entitled: 12, Used: 10}, nil).Once()
+
+		res, err := uc.GetLeaveBalances(employeeId, 2024)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, res[0].CarriedOver)
+		assert.Equal(t, 17, res[0].Available)
+	})
+}
+
+func TestApproveLeave(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	tr := mocks.NewLeaveTypeRepository(t)
+	lr := mocks.NewLeaveRepository(t)
+	cr := mocks.NewCalendarRepository(t)
+	uc := NewLeaveUsecase(er, tr, lr, cr)
+	logger.Init()
+
+	employeeId := uint(1)
+	managerId := uint(2)
+	startDate, _ := utils.ParseDateString("2024-05-02")
+
+	leave := domain.Leave{ID: 7, EmployeeID: employeeId, LeaveTypeID: 1, StartDate: startDate, EndDate: startDate, Days: 1, Status: domain.LeaveStatusPending}
+	employee := domain.Employee{ID: employeeId, ManagerID: &managerId}
+
+	t.Run("success", func(t *testing.T) {
+		lr.On("FindById", uint(7)).Return(leave, nil).Once()
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId}, nil).Once()
+		lr.On("Approve", mock.AnythingOfType("*domain.Leave")).
+			Run(func(args mock.Arguments) { args.Get(0).(*domain.Leave).Status = domain.LeaveStatusApproved }).
+			Return(nil).
+			Once()
+
+		res, err := uc.ApproveLeave(7, managerId, domain.LeaveDecisionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, domain.LeaveStatusApproved, res.Status)
+		assert.Equal(t, &managerId, res.ApproverId)
+	})
+
+	t.Run("balance used up by another approval", func(t *testing.T) {
+		lr.On("FindById", uint(7)).Return(leave, nil).Once()
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId}, nil).Once()
+		lr.On("Approve", mock.AnythingOfType("*domain.Leave")).Return(repositories.ErrInsufficientBalance).Once()
+
+		_, err := uc.ApproveLeave(7, managerId, domain.LeaveDecisionRequest{})
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+	})
+
+	t.Run("not the manager", func(t *testing.T) {
+		lr.On("FindById", uint(7)).Return(leave, nil).Once()
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+
+		_, err := uc.ApproveLeave(7, 3, domain.LeaveDecisionRequest{})
+		assert.ErrorIs(t, err, ErrNotEmployeeManager)
+	})
+
+	t.Run("deleted manager", func(t *testing.T) {
+		lr.On("FindById", uint(7)).Return(leave, nil).Once()
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.ApproveLeave(7, managerId, domain.LeaveDecisionRequest{})
+		assert.ErrorIs(t, err, ErrNotEmployeeManager)
+	})
+
+	t.Run("already decided", func(t *testing.T) {
+		decided := leave
+		decided.Status = domain.LeaveStatusRejected
+		lr.On("FindById", uint(7)).Return(decided, nil).Once()
+
+		_, err := uc.ApproveLeave(7, managerId, domain.LeaveDecisionRequest{})
+		assert.ErrorIs(t, err, ErrLeaveNotPending)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		lr.On("FindById", uint(7)).Return(leave, nil).Once()
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId}, nil).Once()
+		lr.On("Reject", mock.AnythingOfType("*domain.Leave")).Return(repositories.ErrLeaveAlreadyDecided).Once()
+
+		_, err := uc.RejectLeave(7, managerId, domain.LeaveDecisionRequest{Note: "busy"})
+		assert.ErrorIs(t, err, ErrLeaveNotPending)
+	})
+}
+
+func TestLeaveEntitlement(t *testing.T) {
+	annual := domain.LeaveType{AnnualDays: 12}
+	hireDate, _ := utils.ParseDateString("2024-04-15")
+
+	assert.Equal(t, 0, annual.EntitlementFor(hireDate, 2023))
+	assert.Equal(t, 9, annual.EntitlementFor(hireDate, 2024))
+	assert.Equal(t, 12, annual.EntitlementFor(hireDate, 2025))
+}
@@ -0,0 +1,182 @@
+package usecases
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/imaging"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/storage"
+)
+
+type PhotoUsecase interface {
+	UploadPhoto(employeeId uint, content io.Reader, size int64) (domain.PhotoResponse, error)
+	GetPhoto(employeeId uint, size string) (domain.PhotoResponse, io.ReadCloser, error)
+}
+
+type photoUsecase struct {
+	employeeRepository repositories.EmployeeRepository
+	storage            storage.Storage
+	maxSize            int64
+	endpointPrefix     string
+}
+
+var (
+	ErrPhotoNotFound    = errors.New("photo not found")
+	ErrPhotoTooLarge    = errors.New("photo is too large")
+	ErrInvalidPhoto     = errors.New("invalid photo")
+	ErrUnsupportedPhoto = errors.New("photo must be JPEG, PNG or WebP")
+	ErrInvalidPhotoSize = errors.New("photo size must be small or large")
+)
+
+func NewPhotoUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	storage storage.Storage,
+	maxSize int64,
+	endpointPrefix string,
+) PhotoUsecase {
+	return &photoUsecase{
+		employeeRepository: employeeRepository,
+		storage:            storage,
+		maxSize:            maxSize,
+		endpointPrefix:     endpointPrefix,
+	}
+}
+
+// UploadPhoto stores re-encoded thumbnails only, so metadata such as the EXIF
+// location of the original never leaves this function. Thumbnails are stored
+// under a content version, their URLs can be cached forever. size is -1 when
+// it is not known up front.
+func (uc *photoUsecase) UploadPhoto(employeeId uint, content io.Reader, size int64) (domain.PhotoResponse, error) {
+	if size > uc.maxSize {
+		return domain.PhotoResponse{}, fmt.Errorf("%w, the limit is %d bytes", ErrPhotoTooLarge, uc.maxSize)
+	}
+
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.PhotoResponse{}, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(content, uc.maxSize+1))
+	if err != nil {
+		logger.Log.Error(err, "failed to read photo")
+		return domain.PhotoResponse{}, err
+	}
+
+	if int64(len(data)) > uc.maxSize {
+		return domain.PhotoResponse{}, fmt.Errorf("%w, the limit is %d bytes", ErrPhotoTooLarge, uc.maxSize)
+	}
+
+	img, err := imaging.Decode(bytes.NewReader(data), domain.PhotoMinDimension, domain.PhotoMaxDimension)
+	if errors.Is(err, imaging.ErrUnsupportedFormat) {
+		return domain.PhotoResponse{}, ErrUnsupportedPhoto
+	}
+
+	if err != nil {
+		return domain.PhotoResponse{}, fmt.Errorf("%w: %s", ErrInvalidPhoto, err)
+	}
+
+	thumbnails := make(map[string][]byte, len(domain.PhotoSizes))
+	hash := sha256.New()
+	for _, name := range []string{domain.PhotoSizeSmall, domain.PhotoSizeLarge} {
+		var buf bytes.Buffer
+		if err := imaging.EncodeJPEG(&buf, img.Thumbnail(domain.PhotoSizes[name])); err != nil {
+			logger.Log.Error(err, "failed to encode thumbnail", "size", name)
+			return domain.PhotoResponse{}, err
+		}
+
+		thumbnails[name] = buf.Bytes()
+		hash.Write(buf.Bytes())
+	}
+
+	version := hex.EncodeToString(hash.Sum(nil))[:16]
+	for name, thumbnail := range thumbnails {
+		key := photoKey(employeeId, version, name)
+		if err := uc.storage.Put(key, bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg"); err != nil {
+			logger.Log.Error(err, "failed to store thumbnail", "key", key)
+			return domain.PhotoResponse{}, err
+		}
+	}
+
+	if err := uc.employeeRepository.UpdatePhotoVersion(employeeId, version); err != nil {
+		logger.Log.Error(err, "failed to update photo version")
+		uc.deletePhoto(employeeId, version)
+		return domain.PhotoResponse{}, err
+	}
+
+	if employee.PhotoVersion != "" && employee.PhotoVersion != version {
+		uc.deletePhoto(employeeId, employee.PhotoVersion)
+	}
+
+	logger.Log.Info("successfully upload photo", "employeeId", employeeId, "version", version)
+	return toPhotoResponse(uc.endpointPrefix, employeeId, version), nil
+}
+
+// GetPhoto returns the current thumbnail of the given size, the caller closes
+// the content.
+func (uc *photoUsecase) GetPhoto(employeeId uint, size string) (domain.PhotoResponse, io.ReadCloser, error) {
+	if !domain.IsValidPhotoSize(size) {
+		return domain.PhotoResponse{}, nil, ErrInvalidPhotoSize
+	}
+
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.PhotoResponse{}, nil, err
+	}
+
+	if employee.PhotoVersion == "" {
+		return domain.PhotoResponse{}, nil, ErrPhotoNotFound
+	}
+
+	key := photoKey(employeeId, employee.PhotoVersion, size)
+	content, err := uc.storage.Open(key)
+	if err != nil {
+		logger.Log.Error(err, "failed to open photo", "key", key)
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return domain.PhotoResponse{}, nil, ErrPhotoNotFound
+		}
+
+		return domain.PhotoResponse{}, nil, err
+	}
+
+	return toPhotoResponse(uc.endpointPrefix, employeeId, employee.PhotoVersion), content, nil
+}
+
+func (uc *photoUsecase) deletePhoto(employeeId uint, version string) {
+	for name := range domain.PhotoSizes {
+		key := photoKey(employeeId, version, name)
+		if err := uc.storage.Delete(key); err != nil {
+			logger.Log.Error(err, "failed to delete photo", "key", key)
+		}
+	}
+}
+
+func photoKey(employeeId uint, version, size string) string {
+	return fmt.Sprintf("employees/%d/photo/%s/%s.jpg", employeeId, version, size)
+}
+
+// photoURL points at the large thumbnail below the API prefix, the version
+// makes the URL change whenever the photo does.
+func photoURL(prefix string, employeeId uint, version string) string {
+	if version == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/employees/%d/photo?size=%s&v=%s", prefix, employeeId, domain.PhotoSizeLarge, version)
+}
+
+func toPhotoResponse(prefix string, employeeId uint, version string) domain.PhotoResponse {
+	return domain.PhotoResponse{
+		EmployeeId: employeeId,
+		Version:    version,
+		Url:        photoURL(prefix, employeeId, version),
+	}
+}
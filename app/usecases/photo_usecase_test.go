@@ -0,0 +1,125 @@
+package usecases
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestPhoto(t *testing.T, w, h int) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, w, h)), nil))
+	return buf.Bytes()
+}
+
+func TestUploadPhoto(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	st := mocks.NewStorage(t)
+	uc := NewPhotoUsecase(er, st, 1<<20, "/api")
+	logger.Init()
+
+	employeeId := uint(1)
+	photo := newTestPhoto(t, 300, 200)
+
+	isPhotoKey := func(size string) interface{} {
+		return mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "employees/1/photo/") && strings.HasSuffix(key, "/"+size+".jpg")
+		})
+	}
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId, PhotoVersion: "old"}, nil).Once()
+		st.On("Put", isPhotoKey(domain.PhotoSizeSmall), mock.Anything, mock.Anything, "image/jpeg").Return(nil).Once()
+		st.On("Put", isPhotoKey(domain.PhotoSizeLarge), mock.Anything, mock.Anything, "image/jpeg").Return(nil).Once()
+		er.On("UpdatePhotoVersion", employeeId, mock.AnythingOfType("string")).Return(nil).Once()
+		st.On("Delete", "employees/1/photo/old/small.jpg").Return(nil).Once()
+		st.On("Delete", "employees/1/photo/old/large.jpg").Return(nil).Once()
+
+		res, err := uc.UploadPhoto(employeeId, bytes.NewReader(photo), int64(len(photo)))
+		assert.NoError(t, err)
+		assert.Len(t, res.Version, 16)
+		assert.Equal(t, "/api/employees/1/photo?size=large&v="+res.Version, res.Url)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := uc.UploadPhoto(employeeId, bytes.NewReader(photo), 1<<20+1)
+		assert.ErrorIs(t, err, ErrPhotoTooLarge)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+
+		_, err := uc.UploadPhoto(employeeId, strings.NewReader("%PDF-1.7"), 8)
+		assert.ErrorIs(t, err, ErrUnsupportedPhoto)
+	})
+
+	t.Run("invalid dimensions", func(t *testing.T) {
+		small := newTestPhoto(t, 64, 64)
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+
+		_, err := uc.UploadPhoto(employeeId, bytes.NewReader(small), int64(len(small)))
+		assert.ErrorIs(t, err, ErrInvalidPhoto)
+	})
+
+	t.Run("failed to update employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+		st.On("Put", mock.Anything, mock.Anything, mock.Anything, "image/jpeg").Return(nil).Twice()
+		er.On("UpdatePhotoVersion", employeeId, mock.AnythingOfType("string")).Return(errors.New("db down")).Once()
+		st.On("Delete", isPhotoKey(domain.PhotoSizeSmall)).Return(nil).Once()
+		st.On("Delete", isPhotoKey(domain.PhotoSizeLarge)).Return(nil).Once()
+
+		_, err := uc.UploadPhoto(employeeId, bytes.NewReader(photo), int64(len(photo)))
+		assert.Error(t, err)
+	})
+}
+
+func TestGetPhoto(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	st := mocks.NewStorage(t)
+	uc := NewPhotoUsecase(er, st, 1<<20, "/api")
+	logger.Init()
+
+	employeeId := uint(1)
+
+	t.Run("success", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId, PhotoVersion: "abc"}, nil).Once()
+		st.On("Open", "employees/1/photo/abc/small.jpg").Return(io.NopCloser(strings.NewReader("jpeg")), nil).Once()
+
+		res, content, err := uc.GetPhoto(employeeId, domain.PhotoSizeSmall)
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", res.Version)
+		content.Close()
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		_, _, err := uc.GetPhoto(employeeId, "huge")
+		assert.ErrorIs(t, err, ErrInvalidPhotoSize)
+	})
+
+	t.Run("no photo", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId}, nil).Once()
+
+		_, _, err := uc.GetPhoto(employeeId, domain.PhotoSizeLarge)
+		assert.ErrorIs(t, err, ErrPhotoNotFound)
+	})
+
+	t.Run("missing content", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId, PhotoVersion: "abc"}, nil).Once()
+		st.On("Open", "employees/1/photo/abc/large.jpg").Return(nil, storage.ErrObjectNotFound).Once()
+
+		_, _, err := uc.GetPhoto(employeeId, domain.PhotoSizeLarge)
+		assert.ErrorIs(t, err, ErrPhotoNotFound)
+	})
+}
@@ -0,0 +1,136 @@
+package usecases
+
+import (
+	"errors"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+type PositionUsecase interface {
+	CreatePosition(req domain.PositionRequest) (domain.PositionResponse, error)
+	GetAllPosition() ([]domain.PositionResponse, error)
+	GetPositionById(id uint) (domain.PositionResponse, error)
+	UpdatePositionById(id uint, req domain.PositionRequest) (domain.PositionResponse, error)
+	DeletePositionById(id uint) error
+}
+
+type positionUsecase struct {
+	positionRepository repositories.PositionRepository
+}
+
+var ErrDuplicatePositionTitle = errors.New("duplicate position title")
+
+func NewPositionUsecase(positionRepository repositories.PositionRepository) PositionUsecase {
+	return &positionUsecase{
+		positionRepository: positionRepository,
+	}
+}
+
+func (uc *positionUsecase) CreatePosition(req domain.PositionRequest) (domain.PositionResponse, error) {
+	if err := uc.checkDuplicateTitle(0, req.Title); err != nil {
+		return domain.PositionResponse{}, err
+	}
+
+	newPosition := domain.Position{
+		Title:       req.Title,
+		Department:  req.Department,
+		Grade:       req.Grade,
+		Description: req.Description,
+	}
+
+	if err := uc.positionRepository.Store(&newPosition); err != nil {
+		logger.Log.Error(err, "failed to store new position")
+		return domain.PositionResponse{}, err
+	}
+
+	logger.Log.Info("successfully create position", "id", newPosition.ID)
+	return toPositionResponse(newPosition), nil
+}
+
+func (uc *positionUsecase) GetAllPosition() ([]domain.PositionResponse, error) {
+	positions, err := uc.positionRepository.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all positions")
+		return nil, err
+	}
+
+	res := make([]domain.PositionResponse, 0, len(positions))
+	for _, p := range positions {
+		res = append(res, toPositionResponse(p))
+	}
+
+	return res, nil
+}
+
+func (uc *positionUsecase) GetPositionById(id uint) (domain.PositionResponse, error) {
+	position, err := uc.positionRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find position by id")
+		return domain.PositionResponse{}, err
+	}
+
+	return toPositionResponse(position), nil
+}
+
+func (uc *positionUsecase) UpdatePositionById(id uint, req domain.PositionRequest) (domain.PositionResponse, error) {
+	position, err := uc.positionRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find position by id")
+		return domain.PositionResponse{}, err
+	}
+
+	if err := uc.checkDuplicateTitle(id, req.Title); err != nil {
+		return domain.PositionResponse{}, err
+	}
+
+	position.Title = req.Title
+	position.Department = req.Department
+	position.Grade = req.Grade
+	position.Description = req.Description
+
+	if err := uc.positionRepository.UpdateById(&position); err != nil {
+		logger.Log.Error(err, "failed to update position by id")
+		return domain.PositionResponse{}, err
+	}
+
+	logger.Log.Info("successfully update position", "id", id)
+	return toPositionResponse(position), nil
+}
+
+func (uc *positionUsecase) DeletePositionById(id uint) error {
+	if err := uc.positionRepository.DeleteById(id); err != nil {
+		logger.Log.Error(err, "failed to delete position by id")
+		return err
+	}
+
+	logger.Log.Info("successfully delete position", "id", id)
+	return nil
+}
+
+func (uc *positionUsecase) checkDuplicateTitle(id uint, title string) error {
+	found, err := uc.positionRepository.FindByTitle(title)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find position by title")
+		return err
+	}
+
+	if found.ID != 0 && found.ID != id {
+		return ErrDuplicatePositionTitle
+	}
+
+	return nil
+}
+
+func toPositionResponse(p domain.Position) domain.PositionResponse {
+	return domain.PositionResponse{
+		Id:          p.ID,
+		Title:       p.Title,
+		Department:  p.Department,
+		Grade:       p.Grade,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
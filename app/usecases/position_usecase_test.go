@@ -0,0 +1,129 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreatePosition(t *testing.T) {
+	pr := mocks.NewPositionRepository(t)
+	uc := NewPositionUsecase(pr)
+	logger.Init()
+
+	req := domain.PositionRequest{
+		Title:      "Software Engineer",
+		Department: "Engineering",
+		Grade:      "G5",
+	}
+
+	newPosition := domain.Position{
+		Title:      req.Title,
+		Department: req.Department,
+		Grade:      req.Grade,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		pr.On("FindByTitle", req.Title).
+			Return(domain.Position{}, repositories.ErrRecordNotFound).
+			Once()
+
+		pr.On("Store", &newPosition).
+			Return(nil).
+			Once()
+
+		res, err := uc.CreatePosition(req)
+		assert.NoError(t, err)
+		assert.Equal(t, req.Title, res.Title)
+		assert.Equal(t, req.Grade, res.Grade)
+	})
+
+	t.Run("duplicate title", func(t *testing.T) {
+		pr.On("FindByTitle", req.Title).
+			Return(domain.Position{ID: 2}, nil).
+			Once()
+
+		_, err := uc.CreatePosition(req)
+		assert.ErrorIs(t, err, ErrDuplicatePositionTitle)
+	})
+
+	t.Run("failed on store", func(t *testing.T) {
+		pr.On("FindByTitle", req.Title).
+			Return(domain.Position{}, repositories.ErrRecordNotFound).
+			Once()
+
+		pr.On("Store", &newPosition).
+			Return(errors.New("error")).
+			Once()
+
+		_, err := uc.CreatePosition(req)
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdatePositionById(t *testing.T) {
+	pr := mocks.NewPositionRepository(t)
+	uc := NewPositionUsecase(pr)
+	logger.Init()
+
+	id := uint(1)
+	req := domain.PositionRequest{Title: "Senior Software Engineer", Grade: "G6"}
+
+	t.Run("success", func(t *testing.T) {
+		pr.On("FindById", id).
+			Return(domain.Position{ID: id, Title: "Software Engineer"}, nil).
+			Once()
+
+		pr.On("FindByTitle", req.Title).
+			Return(domain.Position{}, repositories.ErrRecordNotFound).
+			Once()
+
+		pr.On("UpdateById", &domain.Position{ID: id, Title: req.Title, Grade: req.Grade}).
+			Return(nil).
+			Once()
+
+		res, err := uc.UpdatePositionById(id, req)
+		assert.NoError(t, err)
+		assert.Equal(t, req.Title, res.Title)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		pr.On("FindById", id).
+			Return(domain.Position{}, repositories.ErrRecordNotFound).
+			Once()
+
+		_, err := uc.UpdatePositionById(id, req)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestGetAllPosition(t *testing.T) {
+	pr := mocks.NewPositionRepository(t)
+	uc := NewPositionUsecase(pr)
+	logger.Init()
+
+	t.Run("success", func(t *testing.T) {
+		pr.On("FindAll").
+			Return([]domain.Position{{ID: 1, Title: "Software Engineer"}}, nil).
+			Once()
+
+		res, err := uc.GetAllPosition()
+		assert.NoError(t, err)
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		pr.On("FindAll").
+			Return(nil, errors.New("error")).
+			Once()
+
+		_, err := uc.GetAllPosition()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,404 @@
+package usecases
+
+import (
+	"errors"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+type ReviewUsecase interface {
+	CreateReviewCycle(req domain.ReviewCycleRequest) (domain.ReviewCycleResponse, error)
+	GetAllReviewCycle() ([]domain.ReviewCycleResponse, error)
+	StartReview(employeeId uint, req domain.StartReviewRequest) (domain.ReviewResponse, error)
+	GetReviews(employeeId uint) ([]domain.ReviewResponse, error)
+	WriteSelfAssessment(employeeId, reviewId, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error)
+	WriteManagerAssessment(employeeId, reviewId, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error)
+	SubmitReview(employeeId, reviewId, actorId uint) (domain.ReviewResponse, error)
+	AcknowledgeReview(employeeId, reviewId, actorId uint) (domain.ReviewResponse, error)
+}
+
+type reviewUsecase struct {
+	employeeRepository repositories.EmployeeRepository
+	reviewRepository   repositories.ReviewRepository
+	today              func() time.Time
+}
+
+var (
+	ErrDuplicateReviewCycle     = errors.New("review cycle name already exists")
+	ErrReviewCycleNotFound      = errors.New("review cycle not found")
+	ErrInvalidReviewPeriod      = errors.New("period end is before period start")
+	ErrInvalidReviewDueDates    = errors.New("self review due must be on or after period start and on or before manager review due")
+	ErrNoReviewer               = errors.New("employee has no manager, reviewer_id is required")
+	ErrReviewerNotFound         = errors.New("reviewer not found")
+	ErrInvalidReviewer          = errors.New("employee cannot review themselves")
+	ErrDuplicateReview          = errors.New("employee already has a review in this cycle")
+	ErrInvalidRating            = errors.New("rating is outside the scale of the review cycle")
+	ErrNotReviewer              = errors.New("only the reviewer can write the manager assessment and submit the review")
+	ErrNotReviewee              = errors.New("only the employee can write the self assessment and acknowledge the review")
+	ErrReviewOverdue            = errors.New("the due date of the review cycle has passed")
+	ErrReviewNotDraft           = errors.New("review is not a draft")
+	ErrReviewNotSubmitted       = errors.New("review is not submitted")
+	ErrMissingManagerAssessment = errors.New("manager assessment and rating are required before submitting")
+)
+
+func NewReviewUsecase(
+	employeeRepository repositories.EmployeeRepository,
+	reviewRepository repositories.ReviewRepository,
+) ReviewUsecase {
+	return &reviewUsecase{
+		employeeRepository: employeeRepository,
+		reviewRepository:   reviewRepository,
+		today:              utils.Today,
+	}
+}
+
+func (uc *reviewUsecase) CreateReviewCycle(req domain.ReviewCycleRequest) (domain.ReviewCycleResponse, error) {
+	dates := make([]time.Time, 4)
+	for i, s := range []string{req.PeriodStart, req.PeriodEnd, req.SelfReviewDue, req.ManagerReviewDue} {
+		date, err := utils.ParseDateString(s)
+		if err != nil {
+			logger.Log.Error(err, "failed to parse review cycle date")
+			return domain.ReviewCycleResponse{}, ErrInvalidDate
+		}
+
+		dates[i] = date
+	}
+
+	cycle := domain.ReviewCycle{
+		Name:             req.Name,
+		PeriodStart:      dates[0],
+		PeriodEnd:        dates[1],
+		SelfReviewDue:    dates[2],
+		ManagerReviewDue: dates[3],
+		RatingMin:        req.RatingMin,
+		RatingMax:        req.RatingMax,
+	}
+
+	if cycle.PeriodEnd.Before(cycle.PeriodStart) {
+		return domain.ReviewCycleResponse{}, ErrInvalidReviewPeriod
+	}
+
+	if cycle.SelfReviewDue.Before(cycle.PeriodStart) || cycle.ManagerReviewDue.Before(cycle.SelfReviewDue) {
+		return domain.ReviewCycleResponse{}, ErrInvalidReviewDueDates
+	}
+
+	found, err := uc.reviewRepository.FindCycleByName(req.Name)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find review cycle by name")
+		return domain.ReviewCycleResponse{}, err
+	}
+
+	if found.ID != 0 {
+		return domain.ReviewCycleResponse{}, ErrDuplicateReviewCycle
+	}
+
+	if err := uc.reviewRepository.StoreCycle(&cycle); err != nil {
+		logger.Log.Error(err, "failed to store review cycle")
+		return domain.ReviewCycleResponse{}, err
+	}
+
+	logger.Log.Info("successfully create review cycle", "id", cycle.ID, "name", cycle.Name)
+	return toReviewCycleResponse(cycle), nil
+}
+
+func (uc *reviewUsecase) GetAllReviewCycle() ([]domain.ReviewCycleResponse, error) {
+	cycles, err := uc.reviewRepository.FindAllCycle()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all review cycle")
+		return nil, err
+	}
+
+	res := make([]domain.ReviewCycleResponse, 0, len(cycles))
+	for _, c := range cycles {
+		res = append(res, toReviewCycleResponse(c))
+	}
+
+	return res, nil
+}
+
+// StartReview opens a draft review of the employee in a cycle. The reviewer
+// defaults to the manager of the employee.
+func (uc *reviewUsecase) StartReview(employeeId uint, req domain.StartReviewRequest) (domain.ReviewResponse, error) {
+	employee, err := uc.employeeRepository.FindById(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return domain.ReviewResponse{}, err
+	}
+
+	if employee.Status == domain.StatusTerminated {
+		return domain.ReviewResponse{}, ErrEmployeeTerminated
+	}
+
+	if _, err := uc.findCycle(req.CycleId); err != nil {
+		return domain.ReviewResponse{}, err
+	}
+
+	reviewerId := req.ReviewerId
+	if reviewerId == nil {
+		reviewerId = employee.ManagerID
+	}
+
+	if reviewerId == nil {
+		return domain.ReviewResponse{}, ErrNoReviewer
+	}
+
+	if *reviewerId == employeeId {
+		return domain.ReviewResponse{}, ErrInvalidReviewer
+	}
+
+	if _, err := uc.employeeRepository.FindById(*reviewerId); err != nil {
+		if errors.Is(err, repositories.ErrRecordNotFound) {
+			return domain.ReviewResponse{}, ErrReviewerNotFound
+		}
+
+		logger.Log.Error(err, "failed to find reviewer by id")
+		return domain.ReviewResponse{}, err
+	}
+
+	found, err := uc.reviewRepository.FindByCycleAndEmployee(req.CycleId, employeeId)
+	if err != nil && !errors.Is(err, repositories.ErrRecordNotFound) {
+		logger.Log.Error(err, "failed to find review by cycle and employee")
+		return domain.ReviewResponse{}, err
+	}
+
+	if found.ID != 0 {
+		return domain.ReviewResponse{}, ErrDuplicateReview
+	}
+
+	review := domain.Review{
+		CycleID:    req.CycleId,
+		EmployeeID: employeeId,
+		ReviewerID: *reviewerId,
+		Status:     domain.ReviewStatusDraft,
+	}
+
+	if err := uc.reviewRepository.Store(&review); err != nil {
+		logger.Log.Error(err, "failed to store review")
+		return domain.ReviewResponse{}, err
+	}
+
+	logger.Log.Info("successfully start review", "id", review.ID, "employeeId", employeeId)
+	return toReviewResponse(review), nil
+}
+
+func (uc *reviewUsecase) GetReviews(employeeId uint) ([]domain.ReviewResponse, error) {
+	if _, err := uc.employeeRepository.FindById(employeeId); err != nil {
+		logger.Log.Error(err, "failed to find employee by id")
+		return nil, err
+	}
+
+	reviews, err := uc.reviewRepository.FindByEmployeeId(employeeId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find reviews")
+		return nil, err
+	}
+
+	res := make([]domain.ReviewResponse, 0, len(reviews))
+	for _, r := range reviews {
+		res = append(res, toReviewResponse(r))
+	}
+
+	return res, nil
+}
+
+// WriteSelfAssessment is done by the employee until the self review is due.
+func (uc *reviewUsecase) WriteSelfAssessment(employeeId, reviewId, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error) {
+	review, cycle, err := uc.findDraftReview(employeeId, reviewId)
+	if err != nil {
+		return domain.ReviewResponse{}, err
+	}
+
+	if review.EmployeeID != actorId {
+		return domain.ReviewResponse{}, ErrNotReviewee
+	}
+
+	if uc.today().After(cycle.SelfReviewDue) {
+		return domain.ReviewResponse{}, ErrReviewOverdue
+	}
+
+	if req.Rating != nil && !cycle.ValidRating(*req.Rating) {
+		return domain.ReviewResponse{}, ErrInvalidRating
+	}
+
+	review.SelfAssessment = req.Assessment
+	review.SelfRating = req.Rating
+
+	return uc.update(review, domain.ReviewStatusDraft, "self_assessment", "self_rating")
+}
+
+// WriteManagerAssessment is done by the reviewer until the manager review is
+// due.
+func (uc *reviewUsecase) WriteManagerAssessment(employeeId, reviewId, actorId uint, req domain.AssessmentRequest) (domain.ReviewResponse, error) {
+	review, cycle, err := uc.findDraftReview(employeeId, reviewId)
+	if err != nil {
+		return domain.ReviewResponse{}, err
+	}
+
+	if review.ReviewerID != actorId {
+		return domain.ReviewResponse{}, ErrNotReviewer
+	}
+
+	if uc.today().After(cycle.ManagerReviewDue) {
+		return domain.ReviewResponse{}, ErrReviewOverdue
+	}
+
+	if req.Rating != nil && !cycle.ValidRating(*req.Rating) {
+		return domain.ReviewResponse{}, ErrInvalidRating
+	}
+
+	review.ManagerAssessment = req.Assessment
+	review.ManagerRating = req.Rating
+
+	return uc.update(review, domain.ReviewStatusDraft, "manager_assessment", "manager_rating")
+}
+
+func (uc *reviewUsecase) SubmitReview(employeeId, reviewId, actorId uint) (domain.ReviewResponse, error) {
+	review, cycle, err := uc.findDraftReview(employeeId, reviewId)
+	if err != nil {
+		return domain.ReviewResponse{}, err
+	}
+
+	if review.ReviewerID != actorId {
+		return domain.ReviewResponse{}, ErrNotReviewer
+	}
+
+	if uc.today().After(cycle.ManagerReviewDue) {
+		return domain.ReviewResponse{}, ErrReviewOverdue
+	}
+
+	if review.ManagerAssessment == "" || review.ManagerRating == nil {
+		return domain.ReviewResponse{}, ErrMissingManagerAssessment
+	}
+
+	now := time.Now().UTC()
+	review.Status = domain.ReviewStatusSubmitted
+	review.SubmittedAt = &now
+
+	return uc.update(review, domain.ReviewStatusDraft, "status", "submitted_at")
+}
+
+func (uc *reviewUsecase) AcknowledgeReview(employeeId, reviewId, actorId uint) (domain.ReviewResponse, error) {
+	review, err := uc.findReview(employeeId, reviewId)
+	if err != nil {
+		return domain.ReviewResponse{}, err
+	}
+
+	if review.EmployeeID != actorId {
+		return domain.ReviewResponse{}, ErrNotReviewee
+	}
+
+	if review.Status != domain.ReviewStatusSubmitted {
+		return domain.ReviewResponse{}, ErrReviewNotSubmitted
+	}
+
+	now := time.Now().UTC()
+	review.Status = domain.ReviewStatusAcknowledged
+	review.AcknowledgedAt = &now
+
+	return uc.update(review, domain.ReviewStatusSubmitted, "status", "acknowledged_at")
+}
+
+// findReview only finds reviews of the employee so one employee cannot act
+// on the review of another through its path.
+func (uc *reviewUsecase) findReview(employeeId, reviewId uint) (domain.Review, error) {
+	review, err := uc.reviewRepository.FindById(reviewId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find review by id")
+		return domain.Review{}, err
+	}
+
+	if review.EmployeeID != employeeId {
+		return domain.Review{}, repositories.ErrRecordNotFound
+	}
+
+	return review, nil
+}
+
+// findDraftReview finds a review that can still be edited and its cycle,
+// which holds the due dates and the rating scale.
+func (uc *reviewUsecase) findDraftReview(employeeId, reviewId uint) (domain.Review, domain.ReviewCycle, error) {
+	review, err := uc.findReview(employeeId, reviewId)
+	if err != nil {
+		return domain.Review{}, domain.ReviewCycle{}, err
+	}
+
+	if review.Status != domain.ReviewStatusDraft {
+		return domain.Review{}, domain.ReviewCycle{}, ErrReviewNotDraft
+	}
+
+	cycle, err := uc.findCycle(review.CycleID)
+	if err != nil {
+		return domain.Review{}, domain.ReviewCycle{}, err
+	}
+
+	return review, cycle, nil
+}
+
+func (uc *reviewUsecase) findCycle(id uint) (domain.ReviewCycle, error) {
+	cycle, err := uc.reviewRepository.FindCycleById(id)
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return domain.ReviewCycle{}, ErrReviewCycleNotFound
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to find review cycle by id")
+		return domain.ReviewCycle{}, err
+	}
+
+	return cycle, nil
+}
+
+// update writes columns of a review that was read with status. When the
+// status changed in the meantime the write is refused as if it had been read
+// with the new status.
+func (uc *reviewUsecase) update(review domain.Review, status string, columns ...string) (domain.ReviewResponse, error) {
+	err := uc.reviewRepository.Update(&review, status, columns...)
+	if errors.Is(err, repositories.ErrReviewStatusChanged) {
+		if status == domain.ReviewStatusDraft {
+			return domain.ReviewResponse{}, ErrReviewNotDraft
+		}
+
+		return domain.ReviewResponse{}, ErrReviewNotSubmitted
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to update review")
+		return domain.ReviewResponse{}, err
+	}
+
+	logger.Log.Info("successfully update review", "id", review.ID, "status", review.Status)
+	return toReviewResponse(review), nil
+}
+
+func toReviewCycleResponse(c domain.ReviewCycle) domain.ReviewCycleResponse {
+	return domain.ReviewCycleResponse{
+		Id:               c.ID,
+		Name:             c.Name,
+		PeriodStart:      c.PeriodStart,
+		PeriodEnd:        c.PeriodEnd,
+		SelfReviewDue:    c.SelfReviewDue,
+		ManagerReviewDue: c.ManagerReviewDue,
+		RatingMin:        c.RatingMin,
+		RatingMax:        c.RatingMax,
+	}
+}
+
+func toReviewResponse(r domain.Review) domain.ReviewResponse {
+	return domain.ReviewResponse{
+		Id:                r.ID,
+		CycleId:           r.CycleID,
+		EmployeeId:        r.EmployeeID,
+		ReviewerId:        r.ReviewerID,
+		SelfAssessment:    r.SelfAssessment,
+		SelfRating:        r.SelfRating,
+		ManagerAssessment: r.ManagerAssessment,
+		ManagerRating:     r.ManagerRating,
+		Status:            r.Status,
+		SubmittedAt:       r.SubmittedAt,
+		AcknowledgedAt:    r.AcknowledgedAt,
+	}
+}
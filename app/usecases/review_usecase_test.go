@@ -0,0 +1,315 @@
+package usecases
+
+import (
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateReviewCycle(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	rr := mocks.NewReviewRepository(t)
+	uc := NewReviewUsecase(er, rr)
+	logger.Init()
+
+	req := domain.ReviewCycleRequest{
+		Name:             "2024 Annual Review",
+		PeriodStart:      "2024-01-01",
+		PeriodEnd:        "2024-12-31",
+		SelfReviewDue:    "2025-01-15",
+		ManagerReviewDue: "2025-01-31",
+		RatingMin:        1,
+		RatingMax:        5,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		rr.On("FindCycleByName", req.Name).Return(domain.ReviewCycle{}, repositories.ErrRecordNotFound).Once()
+		rr.On("StoreCycle", mock.AnythingOfType("*domain.ReviewCycle")).Return(nil).Once()
+
+		res, err := uc.CreateReviewCycle(req)
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC), res.ManagerReviewDue)
+		assert.Equal(t, 5, res.RatingMax)
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		rr.On("FindCycleByName", req.Name).Return(domain.ReviewCycle{ID: 1}, nil).Once()
+
+		_, err := uc.CreateReviewCycle(req)
+		assert.ErrorIs(t, err, ErrDuplicateReviewCycle)
+	})
+
+	t.Run("period end before start", func(t *testing.T) {
+		invalid := req
+		invalid.PeriodEnd = "2023-12-31"
+
+		_, err := uc.CreateReviewCycle(invalid)
+		assert.ErrorIs(t, err, ErrInvalidReviewPeriod)
+	})
+
+	t.Run("manager due before self due", func(t *testing.T) {
+		invalid := req
+		invalid.ManagerReviewDue = "2025-01-10"
+
+		_, err := uc.CreateReviewCycle(invalid)
+		assert.ErrorIs(t, err, ErrInvalidReviewDueDates)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		invalid := req
+		invalid.SelfReviewDue = "15-01-2025"
+
+		_, err := uc.CreateReviewCycle(invalid)
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
+
+func TestStartReview(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	rr := mocks.NewReviewRepository(t)
+	uc := NewReviewUsecase(er, rr)
+	logger.Init()
+
+	employeeId, managerId, cycleId := uint(1), uint(2), uint(3)
+	employee := domain.Employee{ID: employeeId, Status: domain.StatusActive, ManagerID: &managerId}
+
+	t.Run("success defaults reviewer to manager", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(domain.ReviewCycle{ID: cycleId}, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId}, nil).Once()
+		rr.On("FindByCycleAndEmployee", cycleId, employeeId).Return(domain.Review{}, repositories.ErrRecordNotFound).Once()
+		rr.On("Store", &domain.Review{
+			CycleID:    cycleId,
+			EmployeeID: employeeId,
+			ReviewerID: managerId,
+			Status:     domain.ReviewStatusDraft,
+		}).Return(nil).Once()
+
+		res, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId})
+		assert.NoError(t, err)
+		assert.Equal(t, managerId, res.ReviewerId)
+		assert.Equal(t, domain.ReviewStatusDraft, res.Status)
+	})
+
+	t.Run("no manager and no reviewer", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId, Status: domain.StatusActive}, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(domain.ReviewCycle{ID: cycleId}, nil).Once()
+
+		_, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId})
+		assert.ErrorIs(t, err, ErrNoReviewer)
+	})
+
+	t.Run("self review", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(domain.ReviewCycle{ID: cycleId}, nil).Once()
+
+		_, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId, ReviewerId: &employeeId})
+		assert.ErrorIs(t, err, ErrInvalidReviewer)
+	})
+
+	t.Run("reviewer not found", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(domain.ReviewCycle{ID: cycleId}, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId})
+		assert.ErrorIs(t, err, ErrReviewerNotFound)
+	})
+
+	t.Run("cycle not found", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(domain.ReviewCycle{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId})
+		assert.ErrorIs(t, err, ErrReviewCycleNotFound)
+	})
+
+	t.Run("duplicate review", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(employee, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(domain.ReviewCycle{ID: cycleId}, nil).Once()
+		er.On("FindById", managerId).Return(domain.Employee{ID: managerId}, nil).Once()
+		rr.On("FindByCycleAndEmployee", cycleId, employeeId).Return(domain.Review{ID: 9}, nil).Once()
+
+		_, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId})
+		assert.ErrorIs(t, err, ErrDuplicateReview)
+	})
+
+	t.Run("terminated employee", func(t *testing.T) {
+		er.On("FindById", employeeId).Return(domain.Employee{ID: employeeId, Status: domain.StatusTerminated}, nil).Once()
+
+		_, err := uc.StartReview(employeeId, domain.StartReviewRequest{CycleId: cycleId})
+		assert.ErrorIs(t, err, ErrEmployeeTerminated)
+	})
+}
+
+func TestReviewWorkflow(t *testing.T) {
+	er := mocks.NewEmployeeRepository(t)
+	rr := mocks.NewReviewRepository(t)
+	uc := NewReviewUsecase(er, rr).(*reviewUsecase)
+	logger.Init()
+
+	selfDue, _ := utils.ParseDateString("2025-01-15")
+	managerDue, _ := utils.ParseDateString("2025-01-31")
+	uc.today = func() time.Time { return selfDue }
+
+	employeeId, reviewerId, cycleId, reviewId := uint(1), uint(2), uint(3), uint(4)
+	cycle := domain.ReviewCycle{ID: cycleId, SelfReviewDue: selfDue, ManagerReviewDue: managerDue, RatingMin: 1, RatingMax: 5}
+	draft := domain.Review{ID: reviewId, CycleID: cycleId, EmployeeID: employeeId, ReviewerID: reviewerId, Status: domain.ReviewStatusDraft}
+	rating, outOfScale := 4, 6
+
+	t.Run("write self assessment", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+		rr.On("Update", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.SelfAssessment == "shipped the app" && *r.SelfRating == rating
+		}), domain.ReviewStatusDraft, "self_assessment", "self_rating").Return(nil).Once()
+
+		res, err := uc.WriteSelfAssessment(employeeId, reviewId, employeeId, domain.AssessmentRequest{Assessment: "shipped the app", Rating: &rating})
+		assert.NoError(t, err)
+		assert.Equal(t, &rating, res.SelfRating)
+	})
+
+	t.Run("self assessment of review submitted since it was read", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+		rr.On("Update", mock.AnythingOfType("*domain.Review"), domain.ReviewStatusDraft, "self_assessment", "self_rating").
+			Return(repositories.ErrReviewStatusChanged).Once()
+
+		_, err := uc.WriteSelfAssessment(employeeId, reviewId, employeeId, domain.AssessmentRequest{Assessment: "stale"})
+		assert.ErrorIs(t, err, ErrReviewNotDraft)
+	})
+
+	t.Run("rating outside scale", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+
+		_, err := uc.WriteSelfAssessment(employeeId, reviewId, employeeId, domain.AssessmentRequest{Rating: &outOfScale})
+		assert.ErrorIs(t, err, ErrInvalidRating)
+	})
+
+	t.Run("self assessment by other employee", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+
+		_, err := uc.WriteSelfAssessment(employeeId, reviewId, reviewerId, domain.AssessmentRequest{Assessment: "great"})
+		assert.ErrorIs(t, err, ErrNotReviewee)
+	})
+
+	t.Run("self assessment after due date", func(t *testing.T) {
+		uc.today = func() time.Time { return selfDue.AddDate(0, 0, 1) }
+		defer func() { uc.today = func() time.Time { return selfDue } }()
+
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+
+		_, err := uc.WriteSelfAssessment(employeeId, reviewId, employeeId, domain.AssessmentRequest{Assessment: "late"})
+		assert.ErrorIs(t, err, ErrReviewOverdue)
+	})
+
+	t.Run("review of another employee", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+
+		_, err := uc.WriteSelfAssessment(5, reviewId, 5, domain.AssessmentRequest{})
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+
+	t.Run("manager assessment by other employee", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+
+		_, err := uc.WriteManagerAssessment(employeeId, reviewId, 7, domain.AssessmentRequest{Assessment: "good"})
+		assert.ErrorIs(t, err, ErrNotReviewer)
+	})
+
+	t.Run("submit without manager assessment", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+
+		_, err := uc.SubmitReview(employeeId, reviewId, reviewerId)
+		assert.ErrorIs(t, err, ErrMissingManagerAssessment)
+	})
+
+	t.Run("submit", func(t *testing.T) {
+		assessed := draft
+		assessed.ManagerAssessment = "exceeded expectations"
+		assessed.ManagerRating = &rating
+
+		rr.On("FindById", reviewId).Return(assessed, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+		rr.On("Update", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.Status == domain.ReviewStatusSubmitted && r.SubmittedAt != nil
+		}), domain.ReviewStatusDraft, "status", "submitted_at").Return(nil).Once()
+
+		res, err := uc.SubmitReview(employeeId, reviewId, reviewerId)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReviewStatusSubmitted, res.Status)
+	})
+
+	t.Run("submit after due date", func(t *testing.T) {
+		uc.today = func() time.Time { return managerDue.AddDate(0, 0, 1) }
+		defer func() { uc.today = func() time.Time { return selfDue } }()
+
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+		rr.On("FindCycleById", cycleId).Return(cycle, nil).Once()
+
+		_, err := uc.SubmitReview(employeeId, reviewId, reviewerId)
+		assert.ErrorIs(t, err, ErrReviewOverdue)
+	})
+
+	t.Run("edit submitted review", func(t *testing.T) {
+		submitted := draft
+		submitted.Status = domain.ReviewStatusSubmitted
+		rr.On("FindById", reviewId).Return(submitted, nil).Once()
+
+		_, err := uc.WriteSelfAssessment(employeeId, reviewId, employeeId, domain.AssessmentRequest{Assessment: "late edit"})
+		assert.ErrorIs(t, err, ErrReviewNotDraft)
+	})
+
+	t.Run("acknowledge", func(t *testing.T) {
+		submitted := draft
+		submitted.Status = domain.ReviewStatusSubmitted
+		rr.On("FindById", reviewId).Return(submitted, nil).Once()
+		rr.On("Update", mock.MatchedBy(func(r *domain.Review) bool {
+			return r.Status == domain.ReviewStatusAcknowledged && r.AcknowledgedAt != nil
+		}), domain.ReviewStatusSubmitted, "status", "acknowledged_at").Return(nil).Once()
+
+		res, err := uc.AcknowledgeReview(employeeId, reviewId, employeeId)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReviewStatusAcknowledged, res.Status)
+	})
+
+	t.Run("acknowledge twice at once", func(t *testing.T) {
+		submitted := draft
+		submitted.Status = domain.ReviewStatusSubmitted
+		rr.On("FindById", reviewId).Return(submitted, nil).Once()
+		rr.On("Update", mock.AnythingOfType("*domain.Review"), domain.ReviewStatusSubmitted, "status", "acknowledged_at").
+			Return(repositories.ErrReviewStatusChanged).Once()
+
+		_, err := uc.AcknowledgeReview(employeeId, reviewId, employeeId)
+		assert.ErrorIs(t, err, ErrReviewNotSubmitted)
+	})
+
+	t.Run("acknowledge draft", func(t *testing.T) {
+		rr.On("FindById", reviewId).Return(draft, nil).Once()
+
+		_, err := uc.AcknowledgeReview(employeeId, reviewId, employeeId)
+		assert.ErrorIs(t, err, ErrReviewNotSubmitted)
+	})
+
+	t.Run("acknowledge by reviewer", func(t *testing.T) {
+		submitted := draft
+		submitted.Status = domain.ReviewStatusSubmitted
+		rr.On("FindById", reviewId).Return(submitted, nil).Once()
+
+		_, err := uc.AcknowledgeReview(employeeId, reviewId, reviewerId)
+		assert.ErrorIs(t, err, ErrNotReviewee)
+	})
+}
@@ -0,0 +1,256 @@
+package usecases
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+type WebhookUsecase interface {
+	CreateWebhook(req domain.WebhookRequest) (domain.WebhookResponse, error)
+	GetAllWebhook() ([]domain.WebhookResponse, error)
+	GetWebhookById(id uint) (domain.WebhookResponse, error)
+	UpdateWebhook(id uint, req domain.WebhookRequest) (domain.WebhookResponse, error)
+	DeleteWebhook(id uint) error
+	GetDeliveries(webhookId uint, limit int) ([]domain.WebhookDeliveryResponse, error)
+	Redeliver(webhookId uint, deliveryId uint64) (domain.WebhookDeliveryResponse, error)
+}
+
+type webhookUsecase struct {
+	webhookRepository repositories.WebhookRepository
+}
+
+var (
+	ErrDeliveryNotFound = errors.New("delivery not found")
+	ErrWebhookDisabled  = errors.New("webhook is disabled, enable it before redelivering")
+)
+
+// webhookSecretBytes is the size of generated secrets, they are hex encoded.
+const webhookSecretBytes = 32
+
+func NewWebhookUsecase(webhookRepository repositories.WebhookRepository) WebhookUsecase {
+	return &webhookUsecase{
+		webhookRepository: webhookRepository,
+	}
+}
+
+// CreateWebhook returns the secret once, later responses leave it out.
+func (uc *webhookUsecase) CreateWebhook(req domain.WebhookRequest) (domain.WebhookResponse, error) {
+	secret := req.Secret
+	if secret == "" {
+		generated, err := generateSecret()
+		if err != nil {
+			logger.Log.Error(err, "failed to generate webhook secret")
+			return domain.WebhookResponse{}, err
+		}
+
+		secret = generated
+	}
+
+	webhook := domain.Webhook{
+		Url:        req.Url,
+		EventTypes: req.EventTypes,
+		Secret:     secret,
+		Active:     req.Active == nil || *req.Active,
+	}
+
+	if !webhook.Active {
+		now := time.Now()
+		webhook.DisabledAt = &now
+	}
+
+	if err := uc.webhookRepository.Store(&webhook); err != nil {
+		logger.Log.Error(err, "failed to store webhook")
+		return domain.WebhookResponse{}, err
+	}
+
+	logger.Log.Info("successfully create webhook", "id", webhook.ID, "eventTypes", req.EventTypes)
+
+	res := toWebhookResponse(webhook)
+	res.Secret = secret
+	return res, nil
+}
+
+func (uc *webhookUsecase) GetAllWebhook() ([]domain.WebhookResponse, error) {
+	webhooks, err := uc.webhookRepository.FindAll()
+	if err != nil {
+		logger.Log.Error(err, "failed to find all webhook")
+		return nil, err
+	}
+
+	res := make([]domain.WebhookResponse, 0, len(webhooks))
+	for _, w := range webhooks {
+		res = append(res, toWebhookResponse(w))
+	}
+
+	return res, nil
+}
+
+func (uc *webhookUsecase) GetWebhookById(id uint) (domain.WebhookResponse, error) {
+	webhook, err := uc.webhookRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find webhook by id")
+		return domain.WebhookResponse{}, err
+	}
+
+	return toWebhookResponse(webhook), nil
+}
+
+// UpdateWebhook keeps the secret when none is sent. Enabling a webhook
+// resets its failure count.
+func (uc *webhookUsecase) UpdateWebhook(id uint, req domain.WebhookRequest) (domain.WebhookResponse, error) {
+	webhook, err := uc.webhookRepository.FindById(id)
+	if err != nil {
+		logger.Log.Error(err, "failed to find webhook by id")
+		return domain.WebhookResponse{}, err
+	}
+
+	webhook.Url = req.Url
+	webhook.EventTypes = req.EventTypes
+	if req.Secret != "" {
+		webhook.Secret = req.Secret
+	}
+
+	if req.Active != nil && *req.Active != webhook.Active {
+		webhook.Active = *req.Active
+		webhook.ConsecutiveFailures = 0
+		webhook.DisabledAt = nil
+
+		if !webhook.Active {
+			now := time.Now()
+			webhook.DisabledAt = &now
+		}
+	}
+
+	if err := uc.webhookRepository.Update(&webhook); err != nil {
+		logger.Log.Error(err, "failed to update webhook")
+		return domain.WebhookResponse{}, err
+	}
+
+	logger.Log.Info("successfully update webhook", "id", id, "active", webhook.Active)
+	return toWebhookResponse(webhook), nil
+}
+
+func (uc *webhookUsecase) DeleteWebhook(id uint) error {
+	if err := uc.webhookRepository.DeleteById(id); err != nil {
+		logger.Log.Error(err, "failed to delete webhook")
+		return err
+	}
+
+	logger.Log.Info("successfully delete webhook", "id", id)
+	return nil
+}
+
+func (uc *webhookUsecase) GetDeliveries(webhookId uint, limit int) ([]domain.WebhookDeliveryResponse, error) {
+	if _, err := uc.webhookRepository.FindById(webhookId); err != nil {
+		logger.Log.Error(err, "failed to find webhook by id")
+		return nil, err
+	}
+
+	deliveries, err := uc.webhookRepository.FindDeliveries(webhookId, limit)
+	if err != nil {
+		logger.Log.Error(err, "failed to find webhook deliveries")
+		return nil, err
+	}
+
+	res := make([]domain.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, toWebhookDeliveryResponse(d))
+	}
+
+	return res, nil
+}
+
+// Redeliver sends the payload of a past delivery again as a new delivery,
+// with a new delivery id and the original event id.
+func (uc *webhookUsecase) Redeliver(webhookId uint, deliveryId uint64) (domain.WebhookDeliveryResponse, error) {
+	webhook, err := uc.webhookRepository.FindById(webhookId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find webhook by id")
+		return domain.WebhookDeliveryResponse{}, err
+	}
+
+	if !webhook.Active {
+		return domain.WebhookDeliveryResponse{}, ErrWebhookDisabled
+	}
+
+	original, err := uc.webhookRepository.FindDeliveryById(webhookId, deliveryId)
+	if errors.Is(err, repositories.ErrRecordNotFound) {
+		return domain.WebhookDeliveryResponse{}, ErrDeliveryNotFound
+	}
+
+	if err != nil {
+		logger.Log.Error(err, "failed to find webhook delivery")
+		return domain.WebhookDeliveryResponse{}, err
+	}
+
+	deliveries := []domain.WebhookDelivery{{
+		WebhookID:     webhookId,
+		EventId:       original.EventId,
+		EventType:     original.EventType,
+		EmployeeID:    original.EmployeeID,
+		Payload:       original.Payload,
+		Redelivery:    true,
+		Status:        domain.DeliveryPending,
+		NextAttemptAt: time.Now(),
+	}}
+
+	if err := uc.webhookRepository.StoreDeliveries(deliveries); err != nil {
+		logger.Log.Error(err, "failed to store webhook delivery")
+		return domain.WebhookDeliveryResponse{}, err
+	}
+
+	logger.Log.Info("successfully schedule webhook redelivery", "webhookId", webhookId, "deliveryId", deliveryId)
+	return toWebhookDeliveryResponse(deliveries[0]), nil
+}
+
+func generateSecret() (string, error) {
+	b := make([]byte, webhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func toWebhookResponse(w domain.Webhook) domain.WebhookResponse {
+	return domain.WebhookResponse{
+		Id:                  w.ID,
+		Url:                 w.Url,
+		EventTypes:          w.EventTypes,
+		Active:              w.Active,
+		ConsecutiveFailures: w.ConsecutiveFailures,
+		DisabledAt:          w.DisabledAt,
+		CreatedAt:           w.CreatedAt,
+		UpdatedAt:           w.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(d domain.WebhookDelivery) domain.WebhookDeliveryResponse {
+	res := domain.WebhookDeliveryResponse{
+		Id:           d.ID,
+		WebhookId:    d.WebhookID,
+		EventId:      d.EventId,
+		EventType:    d.EventType,
+		EmployeeId:   d.EmployeeID,
+		Redelivery:   d.Redelivery,
+		Status:       d.Status,
+		Attempts:     d.Attempts,
+		ResponseCode: d.ResponseCode,
+		LastError:    d.LastError,
+		DeliveredAt:  d.DeliveredAt,
+		CreatedAt:    d.CreatedAt,
+	}
+
+	if d.Status == domain.DeliveryPending {
+		next := d.NextAttemptAt
+		res.NextAttemptAt = &next
+	}
+
+	return res
+}
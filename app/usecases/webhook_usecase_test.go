@@ -0,0 +1,158 @@
+package usecases
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	wr := mocks.NewWebhookRepository(t)
+	uc := NewWebhookUsecase(wr)
+	logger.Init()
+
+	t.Run("generates secret", func(t *testing.T) {
+		var stored *domain.Webhook
+		wr.On("Store", mock.AnythingOfType("*domain.Webhook")).
+			Run(func(args mock.Arguments) { stored = args.Get(0).(*domain.Webhook) }).
+			Return(nil).Once()
+
+		res, err := uc.CreateWebhook(domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{domain.EventEmployeeCreated}})
+		assert.NoError(t, err)
+		assert.Len(t, res.Secret, 2*webhookSecretBytes)
+		assert.Equal(t, stored.Secret, res.Secret)
+		assert.True(t, stored.Active)
+		assert.Nil(t, stored.DisabledAt)
+	})
+
+	t.Run("inactive with given secret", func(t *testing.T) {
+		active := false
+		wr.On("Store", mock.MatchedBy(func(w *domain.Webhook) bool {
+			return w.Secret == "0123456789abcdef" && !w.Active && w.DisabledAt != nil
+		})).Return(nil).Once()
+
+		res, err := uc.CreateWebhook(domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{domain.EventEmployeeCreated}, Secret: "0123456789abcdef", Active: &active})
+		assert.NoError(t, err)
+		assert.False(t, res.Active)
+	})
+
+	t.Run("failed to store", func(t *testing.T) {
+		wr.On("Store", mock.Anything).Return(errors.New("error")).Once()
+
+		_, err := uc.CreateWebhook(domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{domain.EventEmployeeCreated}})
+		assert.Error(t, err)
+	})
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	wr := mocks.NewWebhookRepository(t)
+	uc := NewWebhookUsecase(wr)
+	logger.Init()
+
+	req := domain.WebhookRequest{Url: "https://hooks.example.com/v2", EventTypes: []string{domain.EventEmployeeDeleted}}
+
+	t.Run("keeps secret", func(t *testing.T) {
+		wr.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Secret: "stored-secret-value", Active: true}, nil).Once()
+		wr.On("Update", &domain.Webhook{ID: 1, Url: req.Url, EventTypes: domain.StringList{domain.EventEmployeeDeleted}, Secret: "stored-secret-value", Active: true}).
+			Return(nil).Once()
+
+		res, err := uc.UpdateWebhook(1, req)
+		assert.NoError(t, err)
+		assert.Empty(t, res.Secret)
+	})
+
+	t.Run("enable resets failures", func(t *testing.T) {
+		active := true
+		req := req
+		req.Active = &active
+
+		wr.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Secret: "stored-secret-value", ConsecutiveFailures: 5}, nil).Once()
+		wr.On("Update", &domain.Webhook{ID: 1, Url: req.Url, EventTypes: domain.StringList{domain.EventEmployeeDeleted}, Secret: "stored-secret-value", Active: true}).
+			Return(nil).Once()
+
+		res, err := uc.UpdateWebhook(1, req)
+		assert.NoError(t, err)
+		assert.True(t, res.Active)
+		assert.Zero(t, res.ConsecutiveFailures)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		wr.On("FindById", uint(2)).Return(domain.Webhook{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.UpdateWebhook(2, req)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	wr := mocks.NewWebhookRepository(t)
+	uc := NewWebhookUsecase(wr)
+	logger.Init()
+
+	t.Run("success", func(t *testing.T) {
+		wr.On("FindById", uint(1)).Return(domain.Webhook{ID: 1}, nil).Once()
+		wr.On("FindDeliveries", uint(1), 50).Return([]domain.WebhookDelivery{
+			{ID: 2, WebhookID: 1, Status: domain.DeliveryPending},
+			{ID: 1, WebhookID: 1, Status: domain.DeliveryFailed, ResponseCode: 500},
+		}, nil).Once()
+
+		res, err := uc.GetDeliveries(1, 50)
+		assert.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.NotNil(t, res[0].NextAttemptAt)
+		assert.Nil(t, res[1].NextAttemptAt)
+		assert.Equal(t, 500, res[1].ResponseCode)
+	})
+
+	t.Run("webhook not found", func(t *testing.T) {
+		wr.On("FindById", uint(2)).Return(domain.Webhook{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.GetDeliveries(2, 50)
+		assert.ErrorIs(t, err, repositories.ErrRecordNotFound)
+	})
+}
+
+func TestRedeliver(t *testing.T) {
+	wr := mocks.NewWebhookRepository(t)
+	uc := NewWebhookUsecase(wr)
+	logger.Init()
+
+	original := domain.WebhookDelivery{ID: 3, WebhookID: 1, EventId: "42", EventType: domain.EventEmployeeUpdated, EmployeeID: 7, Payload: `{"id":"42"}`, Status: domain.DeliveryFailed}
+
+	t.Run("success", func(t *testing.T) {
+		wr.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Active: true}, nil).Once()
+		wr.On("FindDeliveryById", uint(1), uint64(3)).Return(original, nil).Once()
+		wr.On("StoreDeliveries", mock.MatchedBy(func(d []domain.WebhookDelivery) bool {
+			return len(d) == 1 && d[0].Redelivery && d[0].EventId == "42" && d[0].Payload == original.Payload && d[0].Status == domain.DeliveryPending
+		})).Run(func(args mock.Arguments) {
+			args.Get(0).([]domain.WebhookDelivery)[0].ID = 4
+		}).Return(nil).Once()
+
+		res, err := uc.Redeliver(1, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), res.Id)
+		assert.True(t, res.Redelivery)
+	})
+
+	t.Run("webhook disabled", func(t *testing.T) {
+		wr.On("FindById", uint(1)).Return(domain.Webhook{ID: 1}, nil).Once()
+
+		_, err := uc.Redeliver(1, 3)
+		assert.ErrorIs(t, err, ErrWebhookDisabled)
+	})
+
+	t.Run("delivery not found", func(t *testing.T) {
+		wr.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Active: true}, nil).Once()
+		wr.On("FindDeliveryById", uint(1), uint64(9)).Return(domain.WebhookDelivery{}, repositories.ErrRecordNotFound).Once()
+
+		_, err := uc.Redeliver(1, 9)
+		assert.ErrorIs(t, err, ErrDeliveryNotFound)
+	})
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+	"github.com/RuhullahReza/Employee-App/pkg/database"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"gorm.io/gorm"
+)
+
+var ErrUsage = errors.New("invalid usage")
+
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
+
+var stdout io.Writer = os.Stdout
+
+func commands() []command {
+	return []command{
+		{name: "serve", description: "start the HTTP server (default)", run: runServe},
+		{name: "migrate", description: "apply the database schema", run: runMigrate},
+		{name: "seed", description: "insert fake employees for local development", run: runSeed},
+		{name: "employees import", description: "create employees from a CSV file", run: runEmployeesImport},
+		{name: "employees export", description: "write all employees as CSV", run: runEmployeesExport},
+		{name: "config check", description: "validate the configuration", run: runConfigCheck},
+		{name: "config print", description: "show the effective configuration with secrets masked", run: runConfigPrint},
+		{name: "db ping", description: "check the database connection", run: runDbPing},
+	}
+}
+
+// Execute runs the command named by args and returns the process exit code.
+// Without arguments the server is started.
+func Execute(args []string) int {
+	if len(args) == 0 {
+		args = []string{"serve"}
+	}
+
+	for _, c := range commands() {
+		words := strings.Fields(c.name)
+		if len(args) < len(words) || strings.Join(args[:len(words)], " ") != c.name {
+			continue
+		}
+
+		err := c.run(args[len(words):])
+		if errors.Is(err, flag.ErrHelp) {
+			return 2
+		}
+
+		if errors.Is(err, ErrUsage) {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+
+		if err != nil {
+			logger.Log.Error(err, "command failed", "command", c.name)
+			return 1
+		}
+
+		return 0
+	}
+
+	printUsage(os.Stderr)
+	return 2
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: employee-app <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, c := range commands() {
+		fmt.Fprintf(w, "  %-18s %s\n", c.name, c.description)
+	}
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+	return fs
+}
+
+func noArgs(name string, args []string) error {
+	fs := newFlagSet(name)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: %s takes no arguments", ErrUsage, name)
+	}
+
+	return nil
+}
+
+func openDatabase() (*config.Config, *gorm.DB, error) {
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := database.Init(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cfg, db, nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+)
+
+func runConfigCheck(args []string) error {
+	if err := noArgs("config check", args); err != nil {
+		return err
+	}
+
+	if _, err := config.NewConfig(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, "config is valid")
+	return nil
+}
+
+func runConfigPrint(args []string) error {
+	if err := noArgs("config print", args); err != nil {
+		return err
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+
+	return cfg.Print(stdout)
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/pkg/database"
+)
+
+func runMigrate(args []string) error {
+	if err := noArgs("migrate", args); err != nil {
+		return err
+	}
+
+	_, db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	defer database.Close(db)
+
+	if err := database.AutoMigrate(db); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, "migration complete")
+	return nil
+}
+
+func runDbPing(args []string) error {
+	fs := newFlagSet("db ping")
+	timeout := fs.Duration("timeout", 5*time.Second, "maximum time to wait for the ping")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	_, db, err := openDatabase()
+	if err != nil {
+		return err
+	}
+	defer database.Close(db)
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "database reachable in %s\n", time.Since(start).Round(time.Millisecond))
+	return nil
+}
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/database"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+var (
+	ErrInvalidCSVHeader = errors.New("invalid CSV header")
+	ErrImportIncomplete = errors.New("some rows were not imported")
+)
+
+var (
+	importHeader = []string{"first_name", "last_name", "email", "hire_date"}
+	exportHeader = []string{"id", "first_name", "last_name", "email", "hire_date", "status", "termination_date", "created_at", "updated_at"}
+)
+
+const exportPageSize = 500
+
+func openEmployeeUsecase() (usecases.EmployeeUsecase, func(), error) {
+	cfg, db, err := openDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Imports parse and check hire dates the same way the API does.
+	layouts, err := cfg.DateLayoutList()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loc, err := time.LoadLocation(cfg.AppTimeZone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	utils.SetDateLayouts(layouts)
+	utils.SetTimeZone(loc)
+
+	employeeRepository := repositories.NewEmployeeRepository(db)
+	checklistRepository := repositories.NewChecklistRepository(db)
+	customFieldRepository := repositories.NewCustomFieldRepository(db)
+	closeDB := func() {
+		database.Close(db)
+	}
+
+	policy := domain.HireDatePolicy{
+		MinDate:       cfg.HireDateMinTime(),
+		MaxFutureDays: cfg.HireMaxFutureDays,
+	}
+
+	return usecases.NewEmployeeUsecase(employeeRepository, checklistRepository, customFieldRepository, policy, cfg.EndpointPrefix), closeDB, nil
+}
+
+func runEmployeesImport(args []string) error {
+	fs := newFlagSet("employees import")
+	file := fs.String("file", "", "CSV file with the columns "+strings.Join(importHeader, ","))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return fmt.Errorf("%w: employees import requires -file", ErrUsage)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	uc, closeDB, err := openEmployeeUsecase()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	imported, failed, err := importEmployees(f, uc)
+	fmt.Fprintf(stdout, "imported %d employees, %d failed\n", imported, failed)
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return ErrImportIncomplete
+	}
+
+	return nil
+}
+
+// importEmployees creates one employee per CSV row, rows that fail the
+// validation are reported and skipped.
+func importEmployees(r io.Reader, uc usecases.EmployeeUsecase) (int, int, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if strings.Join(header, ",") != strings.Join(importHeader, ",") {
+		return 0, 0, fmt.Errorf("%w, expected %s", ErrInvalidCSVHeader, strings.Join(importHeader, ","))
+	}
+
+	imported, failed := 0, 0
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return imported, failed, err
+		}
+
+		req := domain.EmployeeRequest{
+			FirstName: record[0],
+			LastName:  record[1],
+			Email:     record[2],
+			HireDate:  record[3],
+		}
+
+		if err := utils.ValidateAndSanitizeRequest(&req); err != nil {
+			fmt.Fprintf(stdout, "line %d: %s\n", line, err)
+			failed++
+			continue
+		}
+
+		if _, err := uc.CreateEmployee(req); err != nil {
+			fmt.Fprintf(stdout, "line %d: %s\n", line, err)
+			failed++
+			continue
+		}
+
+		imported++
+	}
+
+	return imported, failed, nil
+}
+
+func runEmployeesExport(args []string) error {
+	fs := newFlagSet("employees export")
+	file := fs.String("file", "", "output CSV file, defaults to stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var w io.Writer = stdout
+	if *file != "" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		w = f
+	}
+
+	uc, closeDB, err := openEmployeeUsecase()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	return exportEmployees(w, uc)
+}
+
+func exportEmployees(w io.Writer, uc usecases.EmployeeUsecase) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportHeader); err != nil {
+		return err
+	}
+
+	for page := 1; ; page++ {
+		res, err := uc.GetAllEmployee(page, exportPageSize, "id", "ASC", domain.EmployeeFilter{Statuses: domain.EmploymentStatuses})
+		if err != nil {
+			return err
+		}
+
+		employees, _ := res.Data.([]domain.EmployeeResponse)
+		for _, e := range employees {
+			record := []string{
+				strconv.FormatUint(uint64(e.Id), 10),
+				e.FirstName,
+				e.LastName,
+				e.Email,
+				e.HireDate.Format("2006-01-02"),
+				e.Status,
+				formatDate(e.TerminationDate),
+				formatTime(e.CreatedAt),
+				formatTime(e.UpdatedAt),
+			}
+
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+
+		if int64(page) >= res.TotalPage {
+			break
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
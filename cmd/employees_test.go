@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImportEmployees(t *testing.T) {
+	logger.Init()
+	stdout = &bytes.Buffer{}
+
+	t.Run("success with invalid rows skipped", func(t *testing.T) {
+		uc := mocks.NewEmployeeUsecase(t)
+		input := "first_name,last_name,email,hire_date\n" +
+			"reza,ozza,reza.ozza@gmail.com,2024-03-03\n" +
+			"r3za,ozza,bad.name@gmail.com,2024-03-03\n"
+
+		uc.On("CreateEmployee", domain.EmployeeRequest{
+			FirstName: "Reza",
+			LastName:  "Ozza",
+			Email:     "reza.ozza@gmail.com",
+			HireDate:  "2024-03-03",
+		}).Return(domain.EmployeeResponse{Id: 1}, nil).Once()
+
+		imported, failed, err := importEmployees(strings.NewReader(input), uc)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, imported)
+		assert.Equal(t, 1, failed)
+	})
+
+	t.Run("failed on usecase", func(t *testing.T) {
+		uc := mocks.NewEmployeeUsecase(t)
+		input := "first_name,last_name,email,hire_date\n" +
+			"reza,ozza,reza.ozza@gmail.com,2024-03-03\n"
+
+		uc.On("CreateEmployee", mock.Anything).
+			Return(domain.EmployeeResponse{}, errors.New("error")).
+			Once()
+
+		imported, failed, err := importEmployees(strings.NewReader(input), uc)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, imported)
+		assert.Equal(t, 1, failed)
+	})
+
+	t.Run("invalid header", func(t *testing.T) {
+		uc := mocks.NewEmployeeUsecase(t)
+
+		_, _, err := importEmployees(strings.NewReader("name,email\n"), uc)
+		assert.ErrorIs(t, err, ErrInvalidCSVHeader)
+	})
+}
+
+func TestExportEmployees(t *testing.T) {
+	logger.Init()
+	uc := mocks.NewEmployeeUsecase(t)
+
+	hireDate := time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)
+	uc.On("GetAllEmployee", 1, exportPageSize, "id", "ASC", domain.EmployeeFilter{Statuses: domain.EmploymentStatuses}).
+		Return(domain.PaginationResponse{
+			PageNum:   1,
+			PageSize:  exportPageSize,
+			TotalPage: 1,
+			Data: []domain.EmployeeResponse{
+				{Id: 1, FirstName: "Reza", LastName: "Ozza", Email: "reza.ozza@gmail.com", HireDate: hireDate, Status: domain.StatusActive},
+			},
+		}, nil).
+		Once()
+
+	var buf bytes.Buffer
+	err := exportEmployees(&buf, uc)
+	assert.NoError(t, err)
+	assert.Equal(t, "id,first_name,last_name,email,hire_date,status,termination_date,created_at,updated_at\n1,Reza,Ozza,reza.ozza@gmail.com,2024-03-03,active,,,\n", buf.String())
+}
+
+func TestSeedEmployees(t *testing.T) {
+	logger.Init()
+	uc := mocks.NewEmployeeUsecase(t)
+
+	uc.On("CreateEmployee", mock.Anything).
+		Return(domain.EmployeeResponse{}, nil).
+		Times(3)
+
+	created, err := seedEmployees(uc, 3, rand.New(rand.NewSource(1)))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, created)
+}
+
+func TestExecute(t *testing.T) {
+	logger.Init()
+
+	assert.Equal(t, 2, Execute([]string{"unknown"}))
+	assert.Equal(t, 2, Execute([]string{"config", "print", "extra"}))
+}
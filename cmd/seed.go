@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/usecases"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+)
+
+var (
+	seedFirstNames = []string{"Adi", "Budi", "Citra", "Dewi", "Eko", "Fajar", "Gita", "Hadi", "Indah", "Joko", "Kartika", "Lestari", "Made", "Nina", "Putri", "Rizky", "Sari", "Tono", "Wulan", "Yusuf"}
+	seedLastNames  = []string{"Pratama", "Saputra", "Wijaya", "Santoso", "Hidayat", "Kusuma", "Nugroho", "Lestari", "Setiawan", "Rahman", "Halim", "Susanto"}
+)
+
+func runSeed(args []string) error {
+	fs := newFlagSet("seed")
+	count := fs.Int("count", 10, "number of employees to create")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *count < 1 {
+		return fmt.Errorf("%w: -count must be at least 1", ErrUsage)
+	}
+
+	uc, closeDB, err := openEmployeeUsecase()
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	created, err := seedEmployees(uc, *count, rand.New(rand.NewSource(time.Now().UnixNano())))
+	fmt.Fprintf(stdout, "created %d employees\n", created)
+	return err
+}
+
+func seedEmployees(uc usecases.EmployeeUsecase, count int, rnd *rand.Rand) (int, error) {
+	batch := time.Now().Unix()
+	for i := 0; i < count; i++ {
+		firstName := seedFirstNames[rnd.Intn(len(seedFirstNames))]
+		lastName := seedLastNames[rnd.Intn(len(seedLastNames))]
+		hireDate := utils.Today().AddDate(0, 0, -rnd.Intn(10*365))
+
+		req := domain.EmployeeRequest{
+			FirstName: firstName,
+			LastName:  lastName,
+			Email:     strings.ToLower(fmt.Sprintf("%s.%s.%d.%d@example.com", firstName, lastName, batch, i)),
+			HireDate:  hireDate.Format("2006-01-02"),
+		}
+
+		if _, err := uc.CreateEmployee(req); err != nil {
+			return i, err
+		}
+	}
+
+	return count, nil
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	app "github.com/RuhullahReza/Employee-App/app"
+	config "github.com/RuhullahReza/Employee-App/config"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+var ErrServerStopped = errors.New("server stopped unexpectedly")
+
+func runServe(args []string) error {
+	if err := noArgs("serve", args); err != nil {
+		return err
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+
+	server, err := app.NewServer(cfg)
+	if err != nil {
+		return err
+	}
+
+	q := make(chan os.Signal, 1)
+	signal.Notify(q, syscall.SIGINT, syscall.SIGTERM)
+
+	listenErr := make(chan error, 1)
+	go func() {
+		logger.Log.Info("starting server")
+		listenErr <- server.Listen()
+	}()
+
+	var result error
+	select {
+	case err := <-listenErr:
+		if err != nil {
+			logger.Log.Error(err, "failed to start server")
+		}
+		result = ErrServerStopped
+	case sig := <-q:
+		logger.Log.Info("Shutting down ....", "signal", sig.String(), "timeout", cfg.ShutdownTimeout.String())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		return err
+	}
+
+	return result
+}
@@ -3,7 +3,12 @@ package config
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/RuhullahReza/Employee-App/pkg/logger"
 
@@ -11,54 +16,342 @@ import (
 )
 
 type Config struct {
-	DbHost         string `mapstructure:"DB_HOST"         default:"localhost"`
-	DbPort         string `mapstructure:"DB_PORT"         default:"5433"`
-	DbName         string `mapstructure:"DB_NAME"         default:"employee-service-db"`
-	DbUsername     string `mapstructure:"DB_USERNAME"     default:"root"`
-	DbPassword     string `mapstructure:"DB_PASSWORD"     default:""`
-	AppName        string `mapstructure:"APP_NAME"        default:"employee-service"`
-	AppHost        string `mapstructure:"APP_HOST"        default:":8080"`
-	EndpointPrefix string `mapstructure:"ENDPOINT_PREFIX" default:"/api"`
+	DbHost              string        `mapstructure:"DB_HOST"                default:"localhost"`
+	DbPort              string        `mapstructure:"DB_PORT"                default:"5433"`
+	DbName              string        `mapstructure:"DB_NAME"                default:"employee-service-db"`
+	DbUsername          string        `mapstructure:"DB_USERNAME"            default:"root"`
+	DbPassword          string        `mapstructure:"DB_PASSWORD"            default:""                    secret:"true"`
+	DbSSLMode           string        `mapstructure:"DB_SSL_MODE"            default:"disable"`
+	DbSSLRootCert       string        `mapstructure:"DB_SSL_ROOT_CERT"       default:""`
+	DbTimeZone          string        `mapstructure:"DB_TIMEZONE"            default:"UTC"`
+	DbApplicationName   string        `mapstructure:"DB_APPLICATION_NAME"    default:""`
+	DbStatementTimeout  time.Duration `mapstructure:"DB_STATEMENT_TIMEOUT"   default:"0s"`
+	DbMaxOpenConns      int           `mapstructure:"DB_MAX_OPEN_CONNS"      default:"25"`
+	DbMaxIdleConns      int           `mapstructure:"DB_MAX_IDLE_CONNS"      default:"5"`
+	DbConnMaxLifetime   time.Duration `mapstructure:"DB_CONN_MAX_LIFETIME"   default:"30m"`
+	DbConnMaxIdleTime   time.Duration `mapstructure:"DB_CONN_MAX_IDLE_TIME"  default:"5m"`
+	DbConnectRetries    int           `mapstructure:"DB_CONNECT_RETRIES"     default:"5"`
+	DbConnectBackoff    time.Duration `mapstructure:"DB_CONNECT_BACKOFF"     default:"1s"`
+	DbConnectMaxBackoff time.Duration `mapstructure:"DB_CONNECT_MAX_BACKOFF" default:"30s"`
+	AppProfile          string        `mapstructure:"APP_PROFILE"            default:""`
+	AppName             string        `mapstructure:"APP_NAME"               default:"employee-service"`
+	AppHost             string        `mapstructure:"APP_HOST"               default:":8080"`
+	EndpointPrefix      string        `mapstructure:"ENDPOINT_PREFIX"        default:"/api"`
+	ShutdownTimeout     time.Duration `mapstructure:"SHUTDOWN_TIMEOUT"       default:"30s"`
+	ShutdownDrainDelay  time.Duration `mapstructure:"SHUTDOWN_DRAIN_DELAY"   default:"0s"`
+	LogLevel            string        `mapstructure:"LOG_LEVEL"              default:"info"                reload:"true"`
+	RateLimitMax        int           `mapstructure:"RATE_LIMIT_MAX"         default:"0"                   reload:"true"`
+	RateLimitWindow     time.Duration `mapstructure:"RATE_LIMIT_WINDOW"      default:"1m"                  reload:"true"`
+	CorsOrigins         string        `mapstructure:"CORS_ORIGINS"           default:""                    reload:"true"`
+	ApiKeys             string        `mapstructure:"API_KEYS"               default:""                    reload:"true" secret:"true"`
+	FeatureFlags        string        `mapstructure:"FEATURE_FLAGS"          default:""                    reload:"true"`
+	AppTimeZone         string        `mapstructure:"APP_TIMEZONE"           default:"UTC"                 reload:"true"`
+	DateFormats         string        `mapstructure:"DATE_FORMATS"           default:"YYYY-MM-DD"          reload:"true"`
+	HireDateMin         string        `mapstructure:"HIRE_DATE_MIN"          default:"1970-01-01"`
+	HireMaxFutureDays   int           `mapstructure:"HIRE_MAX_FUTURE_DAYS"   default:"365"`
+	NameCasing          string        `mapstructure:"NAME_CASING"            default:"smart"               reload:"true"`
+	StorageDriver       string        `mapstructure:"STORAGE_DRIVER"         default:"local"`
+	StorageLocalDir     string        `mapstructure:"STORAGE_LOCAL_DIR"      default:"data/documents"`
+	S3Endpoint          string        `mapstructure:"S3_ENDPOINT"            default:""`
+	S3Region            string        `mapstructure:"S3_REGION"              default:"us-east-1"`
+	S3Bucket            string        `mapstructure:"S3_BUCKET"              default:""`
+	S3AccessKey         string        `mapstructure:"S3_ACCESS_KEY"          default:""`
+	S3SecretKey         string        `mapstructure:"S3_SECRET_KEY"          default:""                    secret:"true"`
+	DocumentMaxSize     int64         `mapstructure:"DOCUMENT_MAX_SIZE"      default:"10485760"`
+	DocumentTypes       string        `mapstructure:"DOCUMENT_TYPES"         default:"application/pdf,image/jpeg,image/png"`
+	PhotoMaxSize        int64         `mapstructure:"PHOTO_MAX_SIZE"         default:"5242880"`
+	OutboxPollInterval  time.Duration `mapstructure:"OUTBOX_POLL_INTERVAL"   default:"1s"`
+	OutboxBatchSize     int           `mapstructure:"OUTBOX_BATCH_SIZE"      default:"100"`
+	OutboxMaxAttempts   int           `mapstructure:"OUTBOX_MAX_ATTEMPTS"    default:"10"`
+	OutboxRetryBackoff  time.Duration `mapstructure:"OUTBOX_RETRY_BACKOFF"   default:"1s"`
+	OutboxMaxBackoff    time.Duration `mapstructure:"OUTBOX_MAX_BACKOFF"     default:"5m"`
+	OutboxRetention     time.Duration `mapstructure:"OUTBOX_RETENTION"       default:"168h"`
+	WebhookMaxAttempts  int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"   default:"8"`
+	WebhookRetryBackoff time.Duration `mapstructure:"WEBHOOK_RETRY_BACKOFF"  default:"10s"`
+	WebhookMaxBackoff   time.Duration `mapstructure:"WEBHOOK_MAX_BACKOFF"    default:"1h"`
+	WebhookTimeout      time.Duration `mapstructure:"WEBHOOK_TIMEOUT"        default:"10s"`
+	WebhookFailureLimit int           `mapstructure:"WEBHOOK_FAILURE_LIMIT"  default:"5"`
+	WebhookConcurrency  int           `mapstructure:"WEBHOOK_CONCURRENCY"    default:"8"`
+	StreamPollInterval  time.Duration `mapstructure:"STREAM_POLL_INTERVAL"   default:"1s"`
+	StreamHeartbeat     time.Duration `mapstructure:"STREAM_HEARTBEAT"       default:"15s"`
+	StreamBufferSize    int           `mapstructure:"STREAM_BUFFER_SIZE"     default:"64"`
+	StreamMaxClients    int           `mapstructure:"STREAM_MAX_CLIENTS"     default:"10000"`
+	GqlMaxDepth         int           `mapstructure:"GRAPHQL_MAX_DEPTH"      default:"10"`
+	GqlMaxComplexity    int           `mapstructure:"GRAPHQL_MAX_COMPLEXITY" default:"5000"`
 }
 
+const (
+	configDir      = "."
+	baseConfigFile = "env.yaml"
+	fileEnvSuffix  = "_FILE"
+)
+
 var config *Config
-var ErrFailUnmarshal = errors.New("failed to unmarshal config")
+var (
+	ErrFailUnmarshal  = errors.New("failed to unmarshal config")
+	ErrFailReadConfig = errors.New("failed to read config file")
+	ErrInvalidAPIKey  = errors.New("API_KEYS entries must look like <name>[@<employee id>]:<key>=<permission>|<permission>")
+	ErrInvalidDateFmt = errors.New("DATE_FORMATS entries must combine YYYY, MM or M, DD or D and the separators - / . or space, e.g. DD/MM/YYYY")
+)
 
 func NewConfig() (*Config, error) {
 	if config == nil {
-		config = new(Config)
+		cfg, err := load(configDir)
+		if err != nil {
+			return nil, err
+		}
+
+		config = cfg
+	}
+
+	return config, nil
+}
 
-		viper.SetConfigType("yaml")
-		viper.SetConfigFile("env.yaml")
-		viper.AddConfigPath(".")
-		viper.AutomaticEnv()
+// load reads env.yaml from dir, merges env.<APP_PROFILE>.yaml on top of it,
+// applies environment variables and <KEY>_FILE secrets, fills the defaults
+// and validates the result.
+func load(dir string) (*Config, error) {
+	cfg := new(Config)
+	v := viper.New()
 
-		_ = viper.ReadInConfig()
+	v.SetConfigType("yaml")
+	v.SetConfigFile(filepath.Join(dir, baseConfigFile))
+	v.AutomaticEnv()
 
-		e := reflect.ValueOf(config).Elem()
-		t := e.Type()
-		for i := 0; i < e.NumField(); i++ {
-			key := t.Field(i).Tag.Get("mapstructure")
-			value := t.Field(i).Tag.Get("default")
-			viper.SetDefault(key, value)
+	if err := v.ReadInConfig(); err != nil && !os.IsNotExist(err) {
+		logger.Log.Error(err, "failed to read config file", "file", baseConfigFile)
+		return nil, fmt.Errorf("%w %s: %s", ErrFailReadConfig, baseConfigFile, err)
+	}
+
+	e := reflect.ValueOf(cfg).Elem()
+	t := e.Type()
+	for i := 0; i < e.NumField(); i++ {
+		key := t.Field(i).Tag.Get("mapstructure")
+		value := t.Field(i).Tag.Get("default")
+		v.SetDefault(key, value)
+	}
+
+	if profile := v.GetString("APP_PROFILE"); profile != "" {
+		profileFile := fmt.Sprintf("env.%s.yaml", profile)
+		v.SetConfigFile(filepath.Join(dir, profileFile))
+		if err := v.MergeInConfig(); err != nil && !os.IsNotExist(err) {
+			logger.Log.Error(err, "failed to read profile config file", "file", profileFile)
+			return nil, fmt.Errorf("%w %s: %s", ErrFailReadConfig, profileFile, err)
+		}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("mapstructure")
+		path := os.Getenv(key + fileEnvSuffix)
+		if path == "" {
+			continue
 		}
 
-		err := viper.Unmarshal(config)
+		content, err := os.ReadFile(path)
 		if err != nil {
-			logger.Log.Error(err, "failed to unmarshal config")
-			return nil, ErrFailUnmarshal
+			logger.Log.Error(err, "failed to read secret file", "key", key+fileEnvSuffix)
+			return nil, fmt.Errorf("%w %s: %s", ErrFailReadConfig, key+fileEnvSuffix, err)
 		}
+
+		v.Set(key, strings.TrimRight(string(content), "\r\n"))
 	}
 
-	return config, nil
+	err := v.Unmarshal(cfg)
+	if err != nil {
+		logger.Log.Error(err, "failed to unmarshal config")
+		return nil, ErrFailUnmarshal
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
 }
 
 func (cfg Config) DSN() string {
-	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable TimeZone=Asia/Jakarta",
-		cfg.DbHost,
-		cfg.DbPort,
-		cfg.DbUsername,
-		cfg.DbPassword,
-		cfg.DbName,
-	)
+	applicationName := cfg.DbApplicationName
+	if applicationName == "" {
+		applicationName = cfg.AppName
+	}
+
+	params := [][2]string{
+		{"host", cfg.DbHost},
+		{"port", cfg.DbPort},
+		{"user", cfg.DbUsername},
+		{"password", cfg.DbPassword},
+		{"dbname", cfg.DbName},
+		{"sslmode", cfg.DbSSLMode},
+		{"sslrootcert", cfg.DbSSLRootCert},
+		{"TimeZone", cfg.DbTimeZone},
+		{"application_name", applicationName},
+	}
+
+	if cfg.DbStatementTimeout > 0 {
+		params = append(params, [2]string{"statement_timeout", strconv.FormatInt(cfg.DbStatementTimeout.Milliseconds(), 10)})
+	}
+
+	var pairs []string
+	for _, p := range params {
+		if p[1] == "" {
+			continue
+		}
+
+		pairs = append(pairs, fmt.Sprintf("%s=%s", p[0], quoteDSNValue(p[1])))
+	}
+
+	return strings.Join(pairs, " ")
+}
+
+// quoteDSNValue quotes a keyword/value connection string value when it
+// contains characters that would otherwise break the parsing.
+func quoteDSNValue(value string) string {
+	if !strings.ContainsAny(value, " '\\") {
+		return value
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + replacer.Replace(value) + "'"
+}
+
+// CorsOriginList returns the allowed CORS origins from the comma separated
+// CORS_ORIGINS setting.
+func (cfg Config) CorsOriginList() []string {
+	return splitList(cfg.CorsOrigins)
+}
+
+// FeatureFlagList returns the enabled feature flags from the comma separated
+// FEATURE_FLAGS setting.
+func (cfg Config) FeatureFlagList() []string {
+	return splitList(cfg.FeatureFlags)
+}
+
+// DocumentTypeList returns the accepted document content types from the comma
+// separated DOCUMENT_TYPES setting.
+func (cfg Config) DocumentTypeList() []string {
+	return splitList(cfg.DocumentTypes)
+}
+
+func splitList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
+
+// APIKey is a client credential parsed from API_KEYS. Keys of people acting
+// for themselves, e.g. managers approving leave, carry their employee id.
+type APIKey struct {
+	Name        string
+	Key         string
+	EmployeeID  uint
+	Permissions []string
+}
+
+// APIKeyList parses API_KEYS, a comma separated list of
+// <name>[@<employee id>]:<key>=<permission>|<permission> entries.
+func (cfg Config) APIKeyList() ([]APIKey, error) {
+	var keys []APIKey
+	for _, entry := range splitList(cfg.ApiKeys) {
+		credential, permissions, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, ErrInvalidAPIKey
+		}
+
+		name, key, ok := strings.Cut(credential, ":")
+		if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(key) == "" {
+			return nil, ErrInvalidAPIKey
+		}
+
+		apiKey := APIKey{Name: strings.TrimSpace(name), Key: strings.TrimSpace(key)}
+		if name, employeeId, ok := strings.Cut(apiKey.Name, "@"); ok {
+			id, err := strconv.ParseUint(employeeId, 10, 64)
+			if err != nil || id == 0 || name == "" {
+				return nil, ErrInvalidAPIKey
+			}
+
+			apiKey.Name = name
+			apiKey.EmployeeID = uint(id)
+		}
+		for _, p := range strings.Split(permissions, "|") {
+			if p = strings.TrimSpace(p); p != "" {
+				apiKey.Permissions = append(apiKey.Permissions, p)
+			}
+		}
+
+		keys = append(keys, apiKey)
+	}
+
+	return keys, nil
+}
+
+// dateTokens maps the tokens of DATE_FORMATS to Go layout elements, longer
+// tokens first.
+var dateTokens = []struct {
+	token, layout string
+	part          byte
+}{
+	{"YYYY", "2006", 'y'},
+	{"MM", "01", 'm'},
+	{"M", "1", 'm'},
+	{"DD", "02", 'd'},
+	{"D", "2", 'd'},
+}
+
+// HireDateMinTime parses HIRE_DATE_MIN, Validate rejects values that do not
+// parse.
+func (cfg Config) HireDateMinTime() time.Time {
+	t, _ := time.Parse("2006-01-02", cfg.HireDateMin)
+	return t
+}
+
+// DateLayoutList converts DATE_FORMATS, a comma separated list of formats
+// such as YYYY-MM-DD or DD/MM/YYYY, to Go time layouts.
+func (cfg Config) DateLayoutList() ([]string, error) {
+	var layouts []string
+	for _, format := range splitList(cfg.DateFormats) {
+		var layout strings.Builder
+		seen := map[byte]bool{}
+
+		for rest := format; rest != ""; {
+			matched := false
+			for _, t := range dateTokens {
+				if strings.HasPrefix(rest, t.token) {
+					if seen[t.part] {
+						return nil, ErrInvalidDateFmt
+					}
+
+					seen[t.part] = true
+					layout.WriteString(t.layout)
+					rest = rest[len(t.token):]
+					matched = true
+					break
+				}
+			}
+
+			if matched {
+				continue
+			}
+
+			if !strings.ContainsRune("-/. ", rune(rest[0])) {
+				return nil, ErrInvalidDateFmt
+			}
+
+			layout.WriteByte(rest[0])
+			rest = rest[1:]
+		}
+
+		if len(seen) != 3 {
+			return nil, ErrInvalidDateFmt
+		}
+
+		layouts = append(layouts, layout.String())
+	}
+
+	return layouts, nil
 }
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDSN(t *testing.T) {
+	cfg := Config{
+		DbHost:     "localhost",
+		DbPort:     "5432",
+		DbName:     "employee_service_db",
+		DbUsername: "postgres",
+		DbPassword: "rahasia",
+		DbSSLMode:  "disable",
+		DbTimeZone: "UTC",
+		AppName:    "employee-service",
+	}
+
+	t.Run("Test DSN default", func(t *testing.T) {
+		expected := "host=localhost port=5432 user=postgres password=rahasia dbname=employee_service_db sslmode=disable TimeZone=UTC application_name=employee-service"
+		assert.Equal(t, expected, cfg.DSN())
+	})
+
+	t.Run("Test DSN with TLS and statement timeout", func(t *testing.T) {
+		c := cfg
+		c.DbSSLMode = "verify-full"
+		c.DbSSLRootCert = "/etc/ssl/root.crt"
+		c.DbApplicationName = "employee-api"
+		c.DbStatementTimeout = 5 * time.Second
+
+		expected := "host=localhost port=5432 user=postgres password=rahasia dbname=employee_service_db sslmode=verify-full sslrootcert=/etc/ssl/root.crt TimeZone=UTC application_name=employee-api statement_timeout=5000"
+		assert.Equal(t, expected, c.DSN())
+	})
+
+	t.Run("Test DSN quotes special characters", func(t *testing.T) {
+		c := cfg
+		c.DbPassword = `it's a s\\ecret`
+
+		assert.Contains(t, c.DSN(), `password='it\'s a s\\\\ecret'`)
+	})
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	logger.Init()
+
+	t.Run("Test Load profile overrides base file", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "env.yaml"), "DB_HOST: base\nDB_NAME: base_db\n")
+		writeFile(t, filepath.Join(dir, "env.prod.yaml"), "DB_HOST: prod\n")
+		t.Setenv("APP_PROFILE", "prod")
+
+		cfg, err := load(dir)
+		assert.NoError(t, err)
+		assert.Equal(t, "prod", cfg.DbHost)
+		assert.Equal(t, "base_db", cfg.DbName)
+		assert.Equal(t, "prod", cfg.AppProfile)
+	})
+
+	t.Run("Test Load secret from file", func(t *testing.T) {
+		dir := t.TempDir()
+		secret := filepath.Join(dir, "db_password")
+		writeFile(t, filepath.Join(dir, "env.yaml"), "DB_PASSWORD: plain\n")
+		writeFile(t, secret, "from-file\n")
+		t.Setenv("DB_PASSWORD_FILE", secret)
+
+		cfg, err := load(dir)
+		assert.NoError(t, err)
+		assert.Equal(t, "from-file", cfg.DbPassword)
+	})
+
+	t.Run("Test Load missing secret file", func(t *testing.T) {
+		t.Setenv("DB_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+
+		_, err := load(t.TempDir())
+		assert.ErrorIs(t, err, ErrFailReadConfig)
+	})
+
+	t.Run("Test Load invalid config", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "env.yaml"), "DB_PORT: abc\nDB_SSL_MODE: maybe\nNAME_CASING: shout\n")
+
+		_, err := load(dir)
+		assert.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "DB_PORT")
+		assert.Contains(t, err.Error(), "DB_SSL_MODE")
+		assert.Contains(t, err.Error(), "NAME_CASING")
+	})
+
+	t.Run("Test Load s3 storage without credentials", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "env.yaml"), "STORAGE_DRIVER: s3\nS3_ENDPOINT: http://localhost:9000\n")
+
+		_, err := load(dir)
+		assert.ErrorIs(t, err, ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "S3_BUCKET")
+	})
+
+	t.Run("Test Load ambiguous date formats", func(t *testing.T) {
+		for _, formats := range []string{"DD/MM/YYYY, MM/DD/YYYY", "D.M.YYYY, MM.DD.YYYY", "YYYY-DD-MM"} {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "env.yaml"), "DATE_FORMATS: "+formats+"\n")
+
+			_, err := load(dir)
+			assert.ErrorIs(t, err, ErrInvalidConfig, formats)
+			assert.Contains(t, err.Error(), "DATE_FORMATS", formats)
+		}
+
+		dir := t.TempDir()
+		writeFile(t, filepath.Join(dir, "env.yaml"), "DATE_FORMATS: DD/MM/YYYY, MM-DD-YYYY\n")
+
+		_, err := load(dir)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPrint(t *testing.T) {
+	cfg := Config{DbHost: "localhost", DbPassword: "rahasia"}
+
+	var buf bytes.Buffer
+	err := cfg.Print(&buf)
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), "DB_HOST=localhost\n")
+	assert.Contains(t, buf.String(), "DB_PASSWORD=******\n")
+	assert.NotContains(t, buf.String(), "rahasia")
+}
+
+func TestAPIKeyList(t *testing.T) {
+	t.Run("Test APIKeyList parses entries", func(t *testing.T) {
+		cfg := Config{ApiKeys: "payroll:s3cret=compensation.read|compensation.write, ops:k2=*"}
+
+		keys, err := cfg.APIKeyList()
+		assert.NoError(t, err)
+		assert.Equal(t, []APIKey{
+			{Name: "payroll", Key: "s3cret", Permissions: []string{"compensation.read", "compensation.write"}},
+			{Name: "ops", Key: "k2", Permissions: []string{"*"}},
+		}, keys)
+	})
+
+	t.Run("Test APIKeyList parses employee id", func(t *testing.T) {
+		cfg := Config{ApiKeys: "jane@12:s3cret=leave.approve"}
+
+		keys, err := cfg.APIKeyList()
+		assert.NoError(t, err)
+		assert.Equal(t, []APIKey{
+			{Name: "jane", Key: "s3cret", EmployeeID: 12, Permissions: []string{"leave.approve"}},
+		}, keys)
+	})
+
+	t.Run("Test APIKeyList invalid entry", func(t *testing.T) {
+		for _, keys := range []string{"payroll=compensation.read", "jane@:k=*", "jane@abc:k=*", "@12:k=*"} {
+			cfg := Config{ApiKeys: keys}
+
+			_, err := cfg.APIKeyList()
+			assert.ErrorIs(t, err, ErrInvalidAPIKey, keys)
+		}
+	})
+}
+
+func TestDateLayoutList(t *testing.T) {
+	t.Run("Test DateLayoutList converts formats", func(t *testing.T) {
+		cfg := Config{DateFormats: "YYYY-MM-DD, DD/MM/YYYY, D.M.YYYY"}
+
+		layouts, err := cfg.DateLayoutList()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"2006-01-02", "02/01/2006", "2.1.2006"}, layouts)
+	})
+
+	t.Run("Test DateLayoutList invalid format", func(t *testing.T) {
+		for _, formats := range []string{"YYYY-MM", "DD/MM/YY", "YYYY-MM-DD-DD", "MM_DD_YYYY"} {
+			cfg := Config{DateFormats: formats}
+
+			_, err := cfg.DateLayoutList()
+			assert.ErrorIs(t, err, ErrInvalidDateFmt, formats)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+const maskedValue = "******"
+
+// Print writes the effective configuration as KEY=value lines, values of
+// fields tagged with secret:"true" are masked.
+func (cfg Config) Print(w io.Writer) error {
+	e := reflect.ValueOf(cfg)
+	t := e.Type()
+	for i := 0; i < e.NumField(); i++ {
+		field := t.Field(i)
+		key := field.Tag.Get("mapstructure")
+
+		value := displayValue(field, e.Field(i))
+		if _, err := fmt.Fprintf(w, "%s=%s\n", key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func displayValue(field reflect.StructField, value reflect.Value) string {
+	s := fmt.Sprint(value.Interface())
+	if field.Tag.Get("secret") == "true" && s != "" {
+		return maskedValue
+	}
+
+	return s
+}
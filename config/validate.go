@@ -0,0 +1,266 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidConfig = errors.New("invalid config")
+
+var (
+	validProfiles = map[string]bool{
+		"":     true,
+		"dev":  true,
+		"test": true,
+		"prod": true,
+	}
+
+	validLogLevels = map[string]bool{
+		"trace": true,
+		"debug": true,
+		"info":  true,
+		"warn":  true,
+		"error": true,
+	}
+
+	validSSLModes = map[string]bool{
+		"disable":     true,
+		"allow":       true,
+		"prefer":      true,
+		"require":     true,
+		"verify-ca":   true,
+		"verify-full": true,
+	}
+)
+
+// ambiguousDateFormats returns two formats that only differ in the order of
+// day and month, such as DD/MM/YYYY and MM/DD/YYYY. A date like 03/04/2024
+// would parse with whichever is tried first. ISO dates are always accepted,
+// so YYYY-MM-DD counts as well.
+func ambiguousDateFormats(formats []string) (string, string, bool) {
+	all := append([]string{"YYYY-MM-DD"}, formats...)
+	for i, a := range all {
+		swapped := dateFormatShape(a, true)
+		for _, b := range all[i+1:] {
+			if swapped == dateFormatShape(b, false) {
+				return a, b, true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// dateFormatShape reduces a format to the order of its parts and its
+// separators, ignoring the width of day and month. With swap day and month
+// trade places.
+func dateFormatShape(format string, swap bool) string {
+	var shape strings.Builder
+	for rest := format; rest != ""; {
+		matched := false
+		for _, t := range dateTokens {
+			if strings.HasPrefix(rest, t.token) {
+				part := t.part
+				if swap && part != 'y' {
+					part = 'd' + 'm' - part
+				}
+
+				shape.WriteByte(part)
+				rest = rest[len(t.token):]
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			shape.WriteByte(rest[0])
+			rest = rest[1:]
+		}
+	}
+
+	return shape.String()
+}
+
+// Validate checks every setting and reports all problems at once, so a broken
+// deployment fails at startup with a message naming the offending keys.
+func (cfg Config) Validate() error {
+	var problems []string
+
+	if strings.TrimSpace(cfg.DbHost) == "" {
+		problems = append(problems, "DB_HOST must not be empty")
+	}
+
+	port, err := strconv.Atoi(cfg.DbPort)
+	if err != nil || port < 1 || port > 65535 {
+		problems = append(problems, fmt.Sprintf("DB_PORT must be a number between 1 and 65535, got %q", cfg.DbPort))
+	}
+
+	if strings.TrimSpace(cfg.DbName) == "" {
+		problems = append(problems, "DB_NAME must not be empty")
+	}
+
+	if strings.TrimSpace(cfg.DbUsername) == "" {
+		problems = append(problems, "DB_USERNAME must not be empty")
+	}
+
+	if !validSSLModes[cfg.DbSSLMode] {
+		problems = append(problems, fmt.Sprintf("DB_SSL_MODE must be one of disable, allow, prefer, require, verify-ca, verify-full, got %q", cfg.DbSSLMode))
+	}
+
+	if _, err := time.LoadLocation(cfg.DbTimeZone); err != nil {
+		problems = append(problems, fmt.Sprintf("DB_TIMEZONE is not a known time zone, got %q", cfg.DbTimeZone))
+	}
+
+	if cfg.DbStatementTimeout < 0 {
+		problems = append(problems, "DB_STATEMENT_TIMEOUT must not be negative")
+	}
+
+	if cfg.DbMaxOpenConns < 0 {
+		problems = append(problems, "DB_MAX_OPEN_CONNS must not be negative")
+	}
+
+	if cfg.DbMaxIdleConns < 0 {
+		problems = append(problems, "DB_MAX_IDLE_CONNS must not be negative")
+	}
+
+	if cfg.DbMaxOpenConns > 0 && cfg.DbMaxIdleConns > cfg.DbMaxOpenConns {
+		problems = append(problems, "DB_MAX_IDLE_CONNS must not be greater than DB_MAX_OPEN_CONNS")
+	}
+
+	if cfg.DbConnectRetries < 0 {
+		problems = append(problems, "DB_CONNECT_RETRIES must not be negative")
+	}
+
+	if !validProfiles[cfg.AppProfile] {
+		problems = append(problems, fmt.Sprintf("APP_PROFILE must be one of dev, test, prod, got %q", cfg.AppProfile))
+	}
+
+	if _, _, err := net.SplitHostPort(cfg.AppHost); err != nil {
+		problems = append(problems, fmt.Sprintf("APP_HOST must be in host:port form, got %q", cfg.AppHost))
+	}
+
+	if cfg.EndpointPrefix != "" && !strings.HasPrefix(cfg.EndpointPrefix, "/") {
+		problems = append(problems, fmt.Sprintf("ENDPOINT_PREFIX must start with '/', got %q", cfg.EndpointPrefix))
+	}
+
+	if cfg.ShutdownTimeout <= 0 {
+		problems = append(problems, "SHUTDOWN_TIMEOUT must be greater than zero")
+	}
+
+	if cfg.ShutdownDrainDelay < 0 {
+		problems = append(problems, "SHUTDOWN_DRAIN_DELAY must not be negative")
+	}
+
+	if !validLogLevels[cfg.LogLevel] {
+		problems = append(problems, fmt.Sprintf("LOG_LEVEL must be one of trace, debug, info, warn, error, got %q", cfg.LogLevel))
+	}
+
+	if cfg.RateLimitMax < 0 {
+		problems = append(problems, "RATE_LIMIT_MAX must not be negative")
+	}
+
+	if cfg.RateLimitWindow <= 0 {
+		problems = append(problems, "RATE_LIMIT_WINDOW must be greater than zero")
+	}
+
+	switch cfg.StorageDriver {
+	case "local":
+		if strings.TrimSpace(cfg.StorageLocalDir) == "" {
+			problems = append(problems, "STORAGE_LOCAL_DIR must not be empty")
+		}
+	case "s3":
+		if cfg.S3Endpoint == "" || cfg.S3Bucket == "" || cfg.S3AccessKey == "" || cfg.S3SecretKey == "" {
+			problems = append(problems, "S3_ENDPOINT, S3_BUCKET, S3_ACCESS_KEY and S3_SECRET_KEY must be set when STORAGE_DRIVER is s3")
+		}
+	default:
+		problems = append(problems, fmt.Sprintf("STORAGE_DRIVER must be one of local, s3, got %q", cfg.StorageDriver))
+	}
+
+	if _, err := time.LoadLocation(cfg.AppTimeZone); err != nil {
+		problems = append(problems, fmt.Sprintf("APP_TIMEZONE is not a known time zone, got %q", cfg.AppTimeZone))
+	}
+
+	if layouts, err := cfg.DateLayoutList(); err != nil {
+		problems = append(problems, err.Error())
+	} else if len(layouts) == 0 {
+		problems = append(problems, "DATE_FORMATS must not be empty")
+	} else if first, second, ok := ambiguousDateFormats(splitList(cfg.DateFormats)); ok {
+		problems = append(problems, fmt.Sprintf("DATE_FORMATS must not contain formats that only differ in the order of day and month, %q and %q are ambiguous", first, second))
+	}
+
+	if _, err := time.Parse("2006-01-02", cfg.HireDateMin); err != nil {
+		problems = append(problems, fmt.Sprintf("HIRE_DATE_MIN must be a YYYY-MM-DD date, got %q", cfg.HireDateMin))
+	}
+
+	if cfg.HireMaxFutureDays < 0 {
+		problems = append(problems, "HIRE_MAX_FUTURE_DAYS must not be negative")
+	}
+
+	switch cfg.NameCasing {
+	case "smart", "title", "preserve":
+	default:
+		problems = append(problems, fmt.Sprintf("NAME_CASING must be one of smart, title, preserve, got %q", cfg.NameCasing))
+	}
+
+	if cfg.DocumentMaxSize <= 0 {
+		problems = append(problems, "DOCUMENT_MAX_SIZE must be greater than zero")
+	}
+
+	if len(cfg.DocumentTypeList()) == 0 {
+		problems = append(problems, "DOCUMENT_TYPES must not be empty")
+	}
+
+	if cfg.PhotoMaxSize <= 0 {
+		problems = append(problems, "PHOTO_MAX_SIZE must be greater than zero")
+	}
+
+	if cfg.OutboxPollInterval <= 0 || cfg.OutboxRetryBackoff <= 0 || cfg.OutboxRetention <= 0 {
+		problems = append(problems, "OUTBOX_POLL_INTERVAL, OUTBOX_RETRY_BACKOFF and OUTBOX_RETENTION must be greater than zero")
+	}
+
+	if cfg.OutboxMaxBackoff < cfg.OutboxRetryBackoff {
+		problems = append(problems, "OUTBOX_MAX_BACKOFF must not be less than OUTBOX_RETRY_BACKOFF")
+	}
+
+	if cfg.OutboxBatchSize < 1 || cfg.OutboxMaxAttempts < 1 {
+		problems = append(problems, "OUTBOX_BATCH_SIZE and OUTBOX_MAX_ATTEMPTS must be at least 1")
+	}
+
+	if cfg.WebhookRetryBackoff <= 0 || cfg.WebhookTimeout <= 0 {
+		problems = append(problems, "WEBHOOK_RETRY_BACKOFF and WEBHOOK_TIMEOUT must be greater than zero")
+	}
+
+	if cfg.WebhookMaxBackoff < cfg.WebhookRetryBackoff {
+		problems = append(problems, "WEBHOOK_MAX_BACKOFF must not be less than WEBHOOK_RETRY_BACKOFF")
+	}
+
+	if cfg.WebhookMaxAttempts < 1 || cfg.WebhookFailureLimit < 1 || cfg.WebhookConcurrency < 1 {
+		problems = append(problems, "WEBHOOK_MAX_ATTEMPTS, WEBHOOK_FAILURE_LIMIT and WEBHOOK_CONCURRENCY must be at least 1")
+	}
+
+	if cfg.StreamPollInterval <= 0 || cfg.StreamHeartbeat <= 0 {
+		problems = append(problems, "STREAM_POLL_INTERVAL and STREAM_HEARTBEAT must be greater than zero")
+	}
+
+	if cfg.StreamBufferSize < 1 || cfg.StreamMaxClients < 1 {
+		problems = append(problems, "STREAM_BUFFER_SIZE and STREAM_MAX_CLIENTS must be at least 1")
+	}
+
+	if cfg.GqlMaxDepth < 1 || cfg.GqlMaxComplexity < 1 {
+		problems = append(problems, "GRAPHQL_MAX_DEPTH and GRAPHQL_MAX_COMPLEXITY must be at least 1")
+	}
+
+	if _, err := cfg.APIKeyList(); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidConfig, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
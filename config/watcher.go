@@ -0,0 +1,139 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/viper"
+)
+
+// Subscriber is notified after a reloadable setting changed. Both configs
+// are complete snapshots and must not be modified.
+type Subscriber interface {
+	OnConfigChange(previous, current *Config)
+}
+
+type SubscriberFunc func(previous, current *Config)
+
+func (f SubscriberFunc) OnConfigChange(previous, current *Config) {
+	f(previous, current)
+}
+
+// Watcher reloads the config files when they change on disk. Only fields
+// tagged with reload:"true" are applied, every other change is logged and
+// ignored until the next restart.
+type Watcher struct {
+	dir string
+
+	mu          sync.Mutex
+	current     *Config
+	subscribers []Subscriber
+}
+
+func NewWatcher(cfg *Config) *Watcher {
+	return &Watcher{
+		dir:     configDir,
+		current: cfg,
+	}
+}
+
+func (w *Watcher) Subscribe(s Subscriber) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.subscribers = append(w.subscribers, s)
+}
+
+func (w *Watcher) Current() *Config {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.current
+}
+
+// Start watches env.yaml and the active profile file.
+func (w *Watcher) Start() {
+	files := []string{baseConfigFile}
+	if profile := w.Current().AppProfile; profile != "" {
+		files = append(files, fmt.Sprintf("env.%s.yaml", profile))
+	}
+
+	for _, file := range files {
+		path := filepath.Join(w.dir, file)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+
+		v := viper.New()
+		v.SetConfigFile(path)
+		v.OnConfigChange(func(e fsnotify.Event) {
+			logger.Log.Info("config file changed", "file", e.Name)
+			w.Reload()
+		})
+		v.WatchConfig()
+	}
+}
+
+// Reload reads the configuration again and applies the reloadable settings.
+// An invalid file keeps the running configuration.
+func (w *Watcher) Reload() {
+	next, err := load(w.dir)
+	if err != nil {
+		logger.Log.Error(err, "config reload rejected, keeping running config")
+		return
+	}
+
+	w.apply(next)
+}
+
+func (w *Watcher) apply(next *Config) []string {
+	w.mu.Lock()
+	old := w.current
+	updated := *old
+
+	var changed []string
+	src := reflect.ValueOf(next).Elem()
+	dst := reflect.ValueOf(&updated).Elem()
+	t := dst.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if reflect.DeepEqual(src.Field(i).Interface(), dst.Field(i).Interface()) {
+			continue
+		}
+
+		key := field.Tag.Get("mapstructure")
+		if field.Tag.Get("reload") != "true" {
+			logger.Log.Info("config change requires a restart, ignored", "key", key)
+			continue
+		}
+
+		logger.Log.Info("config changed", "key", key,
+			"old", displayValue(field, dst.Field(i)),
+			"new", displayValue(field, src.Field(i)))
+		dst.Field(i).Set(src.Field(i))
+		changed = append(changed, key)
+	}
+
+	if len(changed) == 0 {
+		w.mu.Unlock()
+		return nil
+	}
+
+	w.current = &updated
+	subscribers := append([]Subscriber(nil), w.subscribers...)
+	w.mu.Unlock()
+
+	logger.Log.Info("config reloaded", "changed", strings.Join(changed, ","))
+	for _, s := range subscribers {
+		s.OnConfigChange(old, &updated)
+	}
+
+	return changed
+}
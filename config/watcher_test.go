@@ -0,0 +1,58 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher(t *testing.T) {
+	logger.Init()
+
+	t.Run("Test apply reloadable settings", func(t *testing.T) {
+		cfg := &Config{DbHost: "localhost", LogLevel: "info", RateLimitMax: 10}
+		w := NewWatcher(cfg)
+
+		var notified []string
+		w.Subscribe(SubscriberFunc(func(previous, current *Config) {
+			notified = append(notified, previous.LogLevel+"->"+current.LogLevel)
+		}))
+
+		changed := w.apply(&Config{DbHost: "other-host", LogLevel: "debug", RateLimitMax: 10})
+		assert.Equal(t, []string{"LOG_LEVEL"}, changed)
+		assert.Equal(t, []string{"info->debug"}, notified)
+		assert.Equal(t, "localhost", w.Current().DbHost)
+		assert.Equal(t, "debug", w.Current().LogLevel)
+		assert.Equal(t, "info", cfg.LogLevel)
+	})
+
+	t.Run("Test apply without reloadable change", func(t *testing.T) {
+		w := NewWatcher(&Config{DbHost: "localhost", LogLevel: "info"})
+
+		called := false
+		w.Subscribe(SubscriberFunc(func(previous, current *Config) {
+			called = true
+		}))
+
+		changed := w.apply(&Config{DbHost: "other-host", LogLevel: "info"})
+		assert.Empty(t, changed)
+		assert.False(t, called)
+	})
+
+	t.Run("Test Reload rejects invalid file", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "env.yaml"), []byte("LOG_LEVEL: loud\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		w := NewWatcher(&Config{LogLevel: "info", ShutdownTimeout: time.Second})
+		w.dir = dir
+		w.Reload()
+		assert.Equal(t, "info", w.Current().LogLevel)
+	})
+}
@@ -0,0 +1,85 @@
+package database
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// registerCivilDates makes every query return the date columns of its models
+// as midnight UTC of their calendar date, the way dates are parsed from
+// requests. The driver returns them in the time zone of the connection, which
+// would shift them by a day when compared as instants.
+func registerCivilDates(db *gorm.DB) error {
+	return db.Callback().Query().After("gorm:after_query").Register("app:civil_dates", func(tx *gorm.DB) {
+		if tx.Error != nil || tx.Statement.Schema == nil {
+			return
+		}
+
+		civilDates(tx.Statement.Schema, tx.Statement.ReflectValue)
+	})
+}
+
+func civilDates(s *schema.Schema, value reflect.Value) {
+	var fields []*schema.Field
+	for _, f := range s.Fields {
+		if strings.EqualFold(string(f.DataType), "date") {
+			fields = append(fields, f)
+		}
+	}
+
+	if len(fields) == 0 {
+		return
+	}
+
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			civilDatesOf(s, fields, value.Index(i))
+		}
+	case reflect.Struct:
+		civilDatesOf(s, fields, value)
+	}
+}
+
+func civilDatesOf(s *schema.Schema, fields []*schema.Field, value reflect.Value) {
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+
+	// Queries scanning into another type than their model are left as is.
+	if value.Type() != s.ModelType || !value.CanAddr() {
+		return
+	}
+
+	for _, f := range fields {
+		field := f.ReflectValueOf(context.Background(), value)
+		switch v := field.Addr().Interface().(type) {
+		case *time.Time:
+			if !v.IsZero() {
+				*v = utils.CivilDate(*v)
+			}
+		case **time.Time:
+			if *v != nil {
+				date := utils.CivilDate(**v)
+				*v = &date
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+func TestCivilDates(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	assert.NoError(t, err)
+
+	// Asia/Jakarta is UTC+7, the instant of its midnight is the day before in
+	// UTC.
+	effective := time.Date(2024, 3, 1, 0, 0, 0, 0, jakarta)
+	expected, _ := utils.ParseDateString("2024-03-01")
+
+	t.Run("Test civilDates slice", func(t *testing.T) {
+		s, err := schema.Parse(&domain.Compensation{}, &sync.Map{}, schema.NamingStrategy{})
+		assert.NoError(t, err)
+
+		createdAt := time.Date(2024, 3, 1, 6, 30, 0, 0, jakarta)
+		history := []domain.Compensation{{EffectiveDate: effective, CreatedAt: &createdAt}}
+		civilDates(s, reflect.ValueOf(&history))
+
+		assert.True(t, history[0].EffectiveDate.Equal(expected))
+		assert.Equal(t, time.UTC, history[0].EffectiveDate.Location())
+		assert.Equal(t, createdAt, *history[0].CreatedAt)
+	})
+
+	t.Run("Test civilDates struct with optional date", func(t *testing.T) {
+		s, err := schema.Parse(&domain.JobAssignment{}, &sync.Map{}, schema.NamingStrategy{})
+		assert.NoError(t, err)
+
+		job := domain.JobAssignment{StartDate: effective, EndDate: &effective}
+		civilDates(s, reflect.ValueOf(&job))
+
+		assert.True(t, job.StartDate.Equal(expected))
+		assert.True(t, job.EndDate.Equal(expected))
+
+		open := domain.JobAssignment{StartDate: effective}
+		civilDates(s, reflect.ValueOf(&open))
+		assert.Nil(t, open.EndDate)
+	})
+
+	t.Run("Test civilDates other type", func(t *testing.T) {
+		s, err := schema.Parse(&domain.Compensation{}, &sync.Map{}, schema.NamingStrategy{})
+		assert.NoError(t, err)
+
+		var row struct{ EffectiveDate time.Time }
+		row.EffectiveDate = effective
+		civilDates(s, reflect.ValueOf(&row))
+
+		assert.Equal(t, effective, row.EffectiveDate)
+	})
+
+	t.Run("Test registerCivilDates normalizes query results", func(t *testing.T) {
+		db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+		assert.NoError(t, err)
+		assert.NoError(t, registerCivilDates(db))
+
+		// A dry run scans nothing, the callbacks see the results as given.
+		history := []domain.Compensation{{EffectiveDate: effective}}
+		assert.NoError(t, db.Find(&history).Error)
+		assert.True(t, history[0].EffectiveDate.Equal(expected))
+	})
+}
@@ -1,17 +1,69 @@
 package database
 
 import (
+	"errors"
+
 	"github.com/RuhullahReza/Employee-App/pkg/logger"
 
 	"github.com/RuhullahReza/Employee-App/app/domain"
 	"gorm.io/gorm"
 )
 
-func AutoMigrate(db *gorm.DB) {
+var ErrMigrationFailed = errors.New("database migration failed")
+
+// searchIndexes back the employee search on Postgres, they index the
+// expressions the search query uses. pg_trgm needs a role allowed to create
+// extensions. The version suffix changes with the expressions, indexes of
+// older versions are dropped. Other databases search an in-process index.
+var searchIndexes = []string{
+	"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+	"DROP INDEX IF EXISTS idx_employees_search_document",
+	"DROP INDEX IF EXISTS idx_employees_search_text",
+	"CREATE INDEX IF NOT EXISTS idx_employees_search_document_v2 ON employees USING GIN ((" + domain.EmployeeSearchDocument + "))",
+	"CREATE INDEX IF NOT EXISTS idx_employees_search_text_v2 ON employees USING GIN (" + domain.EmployeeSearchText + " gin_trgm_ops)",
+}
+
+func AutoMigrate(db *gorm.DB) error {
 	logger.Log.Info("migrating database...")
-	err := db.AutoMigrate(&domain.Employee{})
+	err := db.AutoMigrate(
+		&domain.Employee{},
+		&domain.Position{},
+		&domain.JobAssignment{},
+		&domain.Compensation{},
+		&domain.LeaveType{},
+		&domain.LeaveBalance{},
+		&domain.Leave{},
+		&domain.WorkCalendar{},
+		&domain.Holiday{},
+		&domain.Attendance{},
+		&domain.ReviewCycle{},
+		&domain.Review{},
+		&domain.ChecklistItem{},
+		&domain.EmployeeTask{},
+		&domain.Phone{},
+		&domain.Address{},
+		&domain.EmergencyContact{},
+		&domain.CustomField{},
+		&domain.Document{},
+		&domain.OutboxEvent{},
+		&domain.Webhook{},
+		&domain.WebhookDelivery{},
+	)
 	if err != nil {
 		logger.Log.Error(err, "database migration failed")
-		panic("[ERROR] database migration failed")
+		return ErrMigrationFailed
+	}
+
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	for _, statement := range searchIndexes {
+		if err := db.Exec(statement).Error; err != nil {
+			logger.Log.Error(err, "database migration failed", "statement", statement)
+			return ErrMigrationFailed
+		}
 	}
-}
\ No newline at end of file
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"errors"
 	"log"
 	"os"
 	"time"
@@ -13,7 +14,9 @@ import (
 	"gorm.io/gorm/logger"
 )
 
-func Init(cfg *config.Config) *gorm.DB {
+var ErrConnectionFailed = errors.New("failed to connect to database")
+
+func Init(cfg *config.Config) (*gorm.DB, error) {
 	dsn := cfg.DSN()
 
 	gormConfig := &gorm.Config{}
@@ -27,11 +30,67 @@ func Init(cfg *config.Config) *gorm.DB {
 		},
 	)
 
-	db, err := gorm.Open(postgres.Open(dsn), gormConfig)
+	var db *gorm.DB
+	var err error
+
+	attempts := cfg.DbConnectRetries + 1
+	for attempt := 1; attempt <= attempts; attempt++ {
+		db, err = gorm.Open(postgres.Open(dsn), gormConfig)
+		if err == nil {
+			break
+		}
+
+		zlogr.Log.Error(err, "failed to connect to database", "attempt", attempt, "maxAttempts", attempts)
+		if attempt == attempts {
+			return nil, ErrConnectionFailed
+		}
+
+		wait := retryBackoff(attempt, cfg.DbConnectBackoff, cfg.DbConnectMaxBackoff)
+		zlogr.Log.Info("retrying database connection", "wait", wait.String())
+		time.Sleep(wait)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		zlogr.Log.Error(err, "failed to get database connection pool")
+		return nil, ErrConnectionFailed
+	}
+
+	if err := registerCivilDates(db); err != nil {
+		zlogr.Log.Error(err, "failed to register date callback")
+		return nil, err
+	}
+
+	sqlDB.SetMaxOpenConns(cfg.DbMaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.DbMaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.DbConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.DbConnMaxIdleTime)
+
+	return db, nil
+}
+
+// retryBackoff doubles the base wait for every failed attempt, capped at max.
+func retryBackoff(attempt int, base, max time.Duration) time.Duration {
+	wait := base
+	for i := 1; i < attempt; i++ {
+		wait *= 2
+		if max > 0 && wait >= max {
+			return max
+		}
+	}
+
+	if max > 0 && wait > max {
+		return max
+	}
+
+	return wait
+}
+
+func Close(db *gorm.DB) error {
+	sqlDB, err := db.DB()
 	if err != nil {
-		zlogr.Log.Error(err, "failed to connect to database")
-		panic("[ERROR] failed to connect to database")
+		return err
 	}
 
-	return db
+	return sqlDB.Close()
 }
@@ -0,0 +1,16 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, retryBackoff(1, time.Second, 30*time.Second))
+	assert.Equal(t, 2*time.Second, retryBackoff(2, time.Second, 30*time.Second))
+	assert.Equal(t, 8*time.Second, retryBackoff(4, time.Second, 30*time.Second))
+	assert.Equal(t, 30*time.Second, retryBackoff(10, time.Second, 30*time.Second))
+	assert.Equal(t, 5*time.Second, retryBackoff(1, 10*time.Second, 5*time.Second))
+}
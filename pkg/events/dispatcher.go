@@ -0,0 +1,160 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+const (
+	// claimLease keeps a claimed event away from other instances while it is
+	// delivered, it is claimed again when the instance dies before marking it.
+	claimLease      = 5 * time.Minute
+	deliveryTimeout = 30 * time.Second
+	cleanupInterval = time.Hour
+)
+
+type DispatcherOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	Retention    time.Duration
+}
+
+// Dispatcher delivers the events of the outbox to the sinks at least once.
+// Events of the same employee are delivered in order, a failing event holds
+// back the later ones until it succeeds or runs out of attempts.
+type Dispatcher struct {
+	outboxRepository repositories.OutboxRepository
+	sinks            []Sink
+	opts             DispatcherOptions
+	now              func() time.Time
+}
+
+func NewDispatcher(outboxRepository repositories.OutboxRepository, opts DispatcherOptions, sinks ...Sink) *Dispatcher {
+	return &Dispatcher{
+		outboxRepository: outboxRepository,
+		sinks:            sinks,
+		opts:             opts,
+		now:              time.Now,
+	}
+}
+
+// Run polls the outbox until ctx is done, full batches are followed by the
+// next one right away.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		for ctx.Err() == nil {
+			if d.DispatchOnce(ctx) < d.opts.BatchSize {
+				break
+			}
+		}
+
+		if d.now().Sub(lastCleanup) >= cleanupInterval {
+			d.cleanup()
+			lastCleanup = d.now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchOnce claims a batch of due events and delivers them, it returns the
+// number of claimed events.
+func (d *Dispatcher) DispatchOnce(ctx context.Context) int {
+	events, err := d.outboxRepository.Claim(d.opts.BatchSize, d.now(), claimLease)
+	if err != nil {
+		logger.Log.Error(err, "failed to claim outbox events")
+		return 0
+	}
+
+	// Events left over at shutdown are claimed again once the lease expires.
+	for _, event := range events {
+		if ctx.Err() != nil {
+			break
+		}
+
+		d.deliver(ctx, event)
+	}
+
+	return len(events)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, event domain.OutboxEvent) {
+	var failures []string
+	for _, sink := range d.sinks {
+		deliverCtx, cancel := context.WithTimeout(ctx, deliveryTimeout)
+		err := sink.Deliver(deliverCtx, event.Event())
+		cancel()
+
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", sink.Name(), err))
+		}
+	}
+
+	now := d.now()
+	if len(failures) == 0 {
+		if err := d.outboxRepository.MarkDispatched(event.ID, now); err != nil {
+			logger.Log.Error(err, "failed to mark outbox event dispatched", "id", event.ID)
+		}
+
+		return
+	}
+
+	lastError := strings.Join(failures, "; ")
+	if event.Attempts >= d.opts.MaxAttempts {
+		logger.Log.Error(fmt.Errorf("%s", lastError), "giving up on outbox event", "id", event.ID, "type", event.Type, "employeeId", event.EmployeeID, "attempts", event.Attempts)
+		if err := d.outboxRepository.MarkFailed(event.ID, now, lastError); err != nil {
+			logger.Log.Error(err, "failed to mark outbox event failed", "id", event.ID)
+		}
+
+		return
+	}
+
+	nextAttemptAt := now.Add(backoff(d.opts.RetryBackoff, d.opts.MaxBackoff, event.Attempts))
+	logger.Log.Info("outbox event delivery failed, retrying", "id", event.ID, "attempts", event.Attempts, "nextAttemptAt", nextAttemptAt, "error", lastError)
+	if err := d.outboxRepository.MarkRetry(event.ID, nextAttemptAt, lastError); err != nil {
+		logger.Log.Error(err, "failed to schedule outbox event retry", "id", event.ID)
+	}
+}
+
+// backoff doubles base with every attempt up to max.
+func backoff(base, max time.Duration, attempts int) time.Duration {
+	wait := base
+	for i := 1; i < attempts && wait < max; i++ {
+		wait *= 2
+	}
+
+	if wait > max {
+		return max
+	}
+
+	return wait
+}
+
+func (d *Dispatcher) cleanup() {
+	deleted, err := d.outboxRepository.DeleteFinishedBefore(d.now().Add(-d.opts.Retention))
+	if err != nil {
+		logger.Log.Error(err, "failed to delete old outbox events")
+		return
+	}
+
+	if deleted > 0 {
+		logger.Log.Info("deleted old outbox events", "count", deleted)
+	}
+}
@@ -0,0 +1,151 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDispatcher(t *testing.T) {
+	logger.Init()
+
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	opts := DispatcherOptions{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		MaxAttempts:  3,
+		RetryBackoff: time.Second,
+		MaxBackoff:   3 * time.Second,
+		Retention:    time.Hour,
+	}
+
+	newDispatcher := func(repo *mocks.OutboxRepository, sinks ...Sink) *Dispatcher {
+		d := NewDispatcher(repo, opts, sinks...)
+		d.now = func() time.Time { return now }
+		return d
+	}
+
+	created := domain.OutboxEvent{ID: 1, Type: domain.EventEmployeeCreated, EmployeeID: 7, Payload: `{"id":7}`, Attempts: 1, CreatedAt: now}
+	updated := domain.OutboxEvent{ID: 2, Type: domain.EventEmployeeUpdated, EmployeeID: 8, Payload: `{"id":8}`, Attempts: 2, CreatedAt: now}
+
+	t.Run("delivers claimed events in order", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Claim", opts.BatchSize, now, claimLease).Return([]domain.OutboxEvent{created, updated}, nil).Once()
+		repo.On("MarkDispatched", uint64(1), now).Return(nil).Once()
+		repo.On("MarkDispatched", uint64(2), now).Return(nil).Once()
+
+		var received []domain.Event
+		bus := NewBus()
+		bus.Subscribe(func(event domain.Event) error {
+			received = append(received, event)
+			return nil
+		})
+
+		assert.Equal(t, 2, newDispatcher(repo, bus).DispatchOnce(context.Background()))
+		assert.Equal(t, []domain.Event{
+			{Id: "1", Type: domain.EventEmployeeCreated, EmployeeId: 7, OccurredAt: now, Data: json.RawMessage(`{"id":7}`)},
+			{Id: "2", Type: domain.EventEmployeeUpdated, EmployeeId: 8, OccurredAt: now, Data: json.RawMessage(`{"id":8}`)},
+		}, received)
+	})
+
+	t.Run("retries failed delivery with backoff", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Claim", opts.BatchSize, now, claimLease).Return([]domain.OutboxEvent{updated}, nil).Once()
+		repo.On("MarkRetry", uint64(2), now.Add(2*time.Second), "broker: unavailable").Return(nil).Once()
+
+		sink := SinkFunc("broker", func(ctx context.Context, event domain.Event) error {
+			return errors.New("unavailable")
+		})
+
+		assert.Equal(t, 1, newDispatcher(repo, sink).DispatchOnce(context.Background()))
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		exhausted := updated
+		exhausted.Attempts = opts.MaxAttempts
+
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Claim", opts.BatchSize, now, claimLease).Return([]domain.OutboxEvent{exhausted}, nil).Once()
+		repo.On("MarkFailed", uint64(2), now, "broker: unavailable").Return(nil).Once()
+
+		sink := SinkFunc("broker", func(ctx context.Context, event domain.Event) error {
+			return errors.New("unavailable")
+		})
+
+		newDispatcher(repo, sink).DispatchOnce(context.Background())
+	})
+
+	t.Run("claim failure", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Claim", opts.BatchSize, now, claimLease).Return(nil, errors.New("error")).Once()
+
+		assert.Equal(t, 0, newDispatcher(repo).DispatchOnce(context.Background()))
+	})
+
+	t.Run("stops when cancelled", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Claim", opts.BatchSize, now, claimLease).Return([]domain.OutboxEvent{created}, nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		newDispatcher(repo).DispatchOnce(ctx)
+		repo.AssertNotCalled(t, "MarkDispatched", mock.Anything, mock.Anything)
+	})
+
+	t.Run("run cleans up and stops", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Claim", opts.BatchSize, now, claimLease).Return(nil, nil)
+		repo.On("DeleteFinishedBefore", now.Add(-opts.Retention)).Return(int64(3), nil).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			newDispatcher(repo).Run(ctx)
+			close(done)
+		}()
+
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+		<-done
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, backoff(time.Second, 10*time.Second, 1))
+	assert.Equal(t, 2*time.Second, backoff(time.Second, 10*time.Second, 2))
+	assert.Equal(t, 8*time.Second, backoff(time.Second, 10*time.Second, 4))
+	assert.Equal(t, 10*time.Second, backoff(time.Second, 10*time.Second, 5))
+	assert.Equal(t, 10*time.Second, backoff(time.Second, 10*time.Second, 50))
+}
+
+func TestBus(t *testing.T) {
+	bus := NewBus()
+	event := domain.Event{Id: "1", Type: domain.EventEmployeeDeleted, EmployeeId: 7}
+
+	var calls int
+	unsubscribe := bus.Subscribe(func(e domain.Event) error {
+		calls++
+		return nil
+	})
+	bus.Subscribe(func(e domain.Event) error {
+		return errors.New("busy")
+	})
+
+	err := bus.Deliver(context.Background(), event)
+	assert.EqualError(t, err, "1 of 2 subscribers failed: busy")
+	assert.Equal(t, 1, calls)
+
+	unsubscribe()
+	assert.Error(t, bus.Deliver(context.Background(), event))
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,94 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+)
+
+// Sink receives the events of the dispatcher, e.g. in-process subscribers,
+// webhooks or a message broker adapter. An event is retried on every sink
+// when one of them fails, so sinks may see an event more than once.
+type Sink interface {
+	Name() string
+	Deliver(ctx context.Context, event domain.Event) error
+}
+
+type sinkFunc struct {
+	name string
+	fn   func(ctx context.Context, event domain.Event) error
+}
+
+// SinkFunc adapts fn to a Sink.
+func SinkFunc(name string, fn func(ctx context.Context, event domain.Event) error) Sink {
+	return sinkFunc{name: name, fn: fn}
+}
+
+func (s sinkFunc) Name() string {
+	return s.name
+}
+
+func (s sinkFunc) Deliver(ctx context.Context, event domain.Event) error {
+	return s.fn(ctx, event)
+}
+
+// Bus is the sink of in-process subscribers. Subscribers run on the
+// dispatcher goroutine and should return quickly.
+type Bus struct {
+	mu          sync.RWMutex
+	nextId      int
+	subscribers map[int]func(event domain.Event) error
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subscribers: make(map[int]func(event domain.Event) error),
+	}
+}
+
+func (b *Bus) Name() string {
+	return "bus"
+}
+
+// Subscribe adds fn until the returned function is called. An error from fn
+// makes the dispatcher retry the event.
+func (b *Bus) Subscribe(fn func(event domain.Event) error) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextId
+	b.nextId++
+	b.subscribers[id] = fn
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers, id)
+	}
+}
+
+func (b *Bus) Deliver(ctx context.Context, event domain.Event) error {
+	b.mu.RLock()
+	subscribers := make([]func(event domain.Event) error, 0, len(b.subscribers))
+	for _, fn := range b.subscribers {
+		subscribers = append(subscribers, fn)
+	}
+	b.mu.RUnlock()
+
+	var failures []string
+	for _, fn := range subscribers {
+		if err := fn(event); err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("%d of %d subscribers failed: %s", len(failures), len(subscribers), strings.Join(failures, "; "))
+	}
+
+	return nil
+}
@@ -0,0 +1,338 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+var (
+	ErrStreamUnavailable = errors.New("event stream is not available")
+	ErrTooManyClients    = errors.New("too many event stream clients")
+	ErrEventExpired      = errors.New("event is no longer in the event log")
+)
+
+const streamBatchSize = 500
+
+type StreamOptions struct {
+	PollInterval time.Duration
+	BufferSize   int
+	MaxClients   int
+}
+
+// StreamFilter selects the events a client receives, empty fields match
+// every event. Department is matched against the employee after the change.
+type StreamFilter struct {
+	Types      []string
+	Department string
+}
+
+func (f StreamFilter) match(event domain.Event, department string) bool {
+	if len(f.Types) > 0 {
+		found := false
+		for _, t := range f.Types {
+			if t == event.Type {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return f.Department == "" || strings.EqualFold(f.Department, department)
+}
+
+// Subscription receives the events published after it was created on
+// Events, which is closed when the client is dropped or the stream closes.
+type Subscription struct {
+	Events <-chan domain.Event
+
+	events chan domain.Event
+	filter StreamFilter
+	// after skips events the client has already seen.
+	after uint64
+	// cursor is the last event published before the subscription.
+	cursor uint64
+	stream *Stream
+}
+
+// Close removes the subscription from the stream.
+func (s *Subscription) Close() {
+	s.stream.remove(s)
+}
+
+// Stream tails the outbox and publishes every event to the subscribed
+// clients in id order. Each instance tails the outbox itself, so clients
+// receive all events whichever instance dispatches them. Clients that fall
+// behind are dropped and resume from the outbox with Replay.
+//
+// Ids are taken when the event is written but become visible when the
+// transaction commits, so a missing id may still show up. The stream waits
+// at a missing id until every transaction that could have taken it has
+// finished, every event up to the cursor has then been published.
+type Stream struct {
+	outboxRepository repositories.OutboxRepository
+	opts             StreamOptions
+
+	// Only poll uses these. The stream is ready once the transactions
+	// running at the first poll, below startHorizon, have finished. gap is
+	// the missing id the stream waits at and gapHorizon the Xmax of the
+	// snapshot that found it.
+	started      bool
+	startHorizon uint64
+	gap          uint64
+	gapHorizon   uint64
+
+	mu      sync.Mutex
+	cursor  uint64
+	ready   bool
+	closed  bool
+	clients map[*Subscription]struct{}
+}
+
+func NewStream(outboxRepository repositories.OutboxRepository, opts StreamOptions) *Stream {
+	return &Stream{
+		outboxRepository: outboxRepository,
+		opts:             opts,
+		clients:          make(map[*Subscription]struct{}),
+	}
+}
+
+// Run tails the outbox until ctx is done.
+func (s *Stream) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.poll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll publishes the events written since the last poll. The first poll only
+// sets the cursor, the stream starts with the events after it.
+func (s *Stream) poll(ctx context.Context) {
+	snapshot, err := s.outboxRepository.Snapshot()
+	if err != nil {
+		logger.Log.Error(err, "failed to read outbox snapshot")
+		return
+	}
+
+	if !s.started {
+		s.started = true
+		s.startHorizon = snapshot.Xmax
+
+		s.mu.Lock()
+		s.cursor = snapshot.LatestId
+		s.mu.Unlock()
+	}
+
+	if snapshot.Xmin < s.startHorizon {
+		return
+	}
+
+	s.mu.Lock()
+	s.ready = true
+	cursor := s.cursor
+	s.mu.Unlock()
+
+	for cursor < snapshot.LatestId && ctx.Err() == nil {
+		events, err := s.outboxRepository.FindAfter(cursor, snapshot.LatestId, streamBatchSize)
+		if err != nil {
+			logger.Log.Error(err, "failed to find outbox events", "after", cursor)
+			return
+		}
+
+		// A short batch holds every event up to the latest id.
+		end := snapshot.LatestId
+		if len(events) == streamBatchSize {
+			end = events[len(events)-1].ID
+		}
+
+		s.mu.Lock()
+		waiting := false
+		for _, e := range events {
+			if e.ID > cursor+1 && !s.skipGap(cursor+1, snapshot) {
+				waiting = true
+				break
+			}
+
+			s.publish(e)
+			cursor = e.ID
+		}
+
+		if !waiting && cursor < end {
+			if waiting = !s.skipGap(cursor+1, snapshot); !waiting {
+				cursor = end
+			}
+		}
+		s.cursor = cursor
+		s.mu.Unlock()
+
+		if waiting {
+			return
+		}
+	}
+}
+
+// skipGap reports whether the ids missing from id on can be skipped. They are
+// below the latest id of the snapshot that found them, so they were taken
+// before that snapshot by transactions that had already written the change
+// their event records, and therefore have a transaction id below its Xmax.
+// Once Xmin passes it those transactions have finished, the ids belong to
+// ones that rolled back.
+func (s *Stream) skipGap(id uint64, snapshot domain.OutboxSnapshot) bool {
+	if s.gap != id {
+		s.gap = id
+		s.gapHorizon = snapshot.Xmax
+	}
+
+	return snapshot.Xmin >= s.gapHorizon
+}
+
+// publish sends the event to the matching clients and drops the ones whose
+// buffer is full. It must be called with mu held.
+func (s *Stream) publish(e domain.OutboxEvent) {
+	if len(s.clients) == 0 {
+		return
+	}
+
+	event := e.Event()
+	department := eventDepartment(event)
+	for sub := range s.clients {
+		if e.ID <= sub.after || !sub.filter.match(event, department) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			logger.Log.Info("dropping slow event stream client", "eventId", event.Id)
+			s.drop(sub)
+		}
+	}
+}
+
+// Subscribe registers a client that has seen the events up to lastEventId,
+// zero for a new client.
+func (s *Stream) Subscribe(filter StreamFilter, lastEventId uint64) (*Subscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.ready || s.closed {
+		return nil, ErrStreamUnavailable
+	}
+
+	if len(s.clients) >= s.opts.MaxClients {
+		return nil, ErrTooManyClients
+	}
+
+	events := make(chan domain.Event, s.opts.BufferSize)
+	sub := &Subscription{
+		Events: events,
+		events: events,
+		filter: filter,
+		after:  lastEventId,
+		cursor: s.cursor,
+		stream: s,
+	}
+	s.clients[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// Replay calls fn with the events the subscription missed before it was
+// created, it returns ErrEventExpired when the last event the client has
+// seen was already removed from the outbox.
+func (s *Stream) Replay(ctx context.Context, sub *Subscription, fn func(domain.Event) error) error {
+	after := sub.after
+	if after == 0 || after >= sub.cursor {
+		return nil
+	}
+
+	exists, err := s.outboxRepository.Exists(after)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrEventExpired
+	}
+
+	for after < sub.cursor && ctx.Err() == nil {
+		events, err := s.outboxRepository.FindAfter(after, sub.cursor, streamBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			event := e.Event()
+			if sub.filter.match(event, eventDepartment(event)) {
+				if err := fn(event); err != nil {
+					return err
+				}
+			}
+
+			after = e.ID
+		}
+
+		if len(events) < streamBatchSize {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+// Close drops every client and refuses new ones, open responses end so the
+// server can shut down.
+func (s *Stream) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for sub := range s.clients {
+		s.drop(sub)
+	}
+}
+
+func (s *Stream) remove(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.clients[sub]; ok {
+		s.drop(sub)
+	}
+}
+
+func (s *Stream) drop(sub *Subscription) {
+	delete(s.clients, sub)
+	close(sub.events)
+}
+
+func eventDepartment(event domain.Event) string {
+	var data struct {
+		Department string `json:"department"`
+	}
+
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return ""
+	}
+
+	return data.Department
+}
@@ -0,0 +1,224 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	logger.Init()
+
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	opts := StreamOptions{PollInterval: time.Second, BufferSize: 2, MaxClients: 2}
+
+	// snapshot has no transactions running.
+	snapshot := func(latestId uint64) domain.OutboxSnapshot {
+		return domain.OutboxSnapshot{LatestId: latestId, Xmin: 100, Xmax: 100}
+	}
+
+	newStream := func(repo *mocks.OutboxRepository, cursor uint64) *Stream {
+		repo.On("Snapshot").Return(snapshot(cursor), nil).Once()
+
+		s := NewStream(repo, opts)
+		s.poll(context.Background())
+		return s
+	}
+
+	created := domain.OutboxEvent{ID: 11, Type: domain.EventEmployeeCreated, EmployeeID: 7, Payload: `{"id":7,"department":"Engineering"}`, CreatedAt: now}
+	updated := domain.OutboxEvent{ID: 12, Type: domain.EventEmployeeUpdated, EmployeeID: 8, Payload: `{"id":8,"department":"Sales"}`, CreatedAt: now}
+	deleted := domain.OutboxEvent{ID: 14, Type: domain.EventEmployeeDeleted, EmployeeID: 7, Payload: `{"id":7,"department":"Engineering"}`, CreatedAt: now}
+
+	t.Run("unavailable before the first poll", func(t *testing.T) {
+		_, err := NewStream(mocks.NewOutboxRepository(t), opts).Subscribe(StreamFilter{}, 0)
+		assert.ErrorIs(t, err, ErrStreamUnavailable)
+	})
+
+	t.Run("publishes new events to matching clients", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 10)
+
+		all, err := s.Subscribe(StreamFilter{}, 0)
+		assert.NoError(t, err)
+		engineering, err := s.Subscribe(StreamFilter{Department: "engineering", Types: []string{domain.EventEmployeeDeleted}}, 0)
+		assert.NoError(t, err)
+
+		repo.On("Snapshot").Return(snapshot(14), nil).Once()
+		repo.On("FindAfter", uint64(10), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{created, updated, deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, created.Event(), <-all.Events)
+		assert.Equal(t, updated.Event(), <-all.Events)
+		assert.Equal(t, deleted.Event(), <-engineering.Events)
+		assert.Equal(t, uint64(14), s.cursor)
+
+		// all had two events buffered when the third arrived.
+		_, ok := <-all.Events
+		assert.False(t, ok)
+	})
+
+	t.Run("skips ids without events", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 10)
+
+		repo.On("Snapshot").Return(snapshot(13), nil).Once()
+		repo.On("FindAfter", uint64(10), uint64(13), streamBatchSize).Return(nil, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, uint64(13), s.cursor)
+	})
+
+	t.Run("waits for transactions that can still commit a missing id", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 10)
+		sub, _ := s.Subscribe(StreamFilter{}, 0)
+
+		late := domain.OutboxEvent{ID: 13, Type: domain.EventEmployeeUpdated, EmployeeID: 9, Payload: `{"id":9}`, CreatedAt: now}
+
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 14, Xmin: 100, Xmax: 105}, nil).Once()
+		repo.On("FindAfter", uint64(10), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{created, updated, deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, created.Event(), <-sub.Events)
+		assert.Equal(t, updated.Event(), <-sub.Events)
+		assert.Equal(t, uint64(12), s.cursor)
+
+		// A transaction that started before 13 went missing is still running.
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 14, Xmin: 104, Xmax: 110}, nil).Once()
+		repo.On("FindAfter", uint64(12), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, uint64(12), s.cursor)
+
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 14, Xmin: 104, Xmax: 110}, nil).Once()
+		repo.On("FindAfter", uint64(12), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{late, deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, late.Event(), <-sub.Events)
+		assert.Equal(t, deleted.Event(), <-sub.Events)
+		assert.Equal(t, uint64(14), s.cursor)
+	})
+
+	t.Run("skips a missing id once its transaction rolled back", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 12)
+
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 14, Xmin: 100, Xmax: 105}, nil).Once()
+		repo.On("FindAfter", uint64(12), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, uint64(12), s.cursor)
+
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 14, Xmin: 105, Xmax: 110}, nil).Once()
+		repo.On("FindAfter", uint64(12), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, uint64(14), s.cursor)
+	})
+
+	t.Run("unavailable until transactions running at start finished", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 10, Xmin: 100, Xmax: 105}, nil).Once()
+
+		s := NewStream(repo, opts)
+		s.poll(context.Background())
+
+		_, err := s.Subscribe(StreamFilter{}, 0)
+		assert.ErrorIs(t, err, ErrStreamUnavailable)
+
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{LatestId: 10, Xmin: 105, Xmax: 106}, nil).Once()
+		s.poll(context.Background())
+
+		_, err = s.Subscribe(StreamFilter{}, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(10), s.cursor)
+	})
+
+	t.Run("limits clients", func(t *testing.T) {
+		s := newStream(mocks.NewOutboxRepository(t), 10)
+
+		first, _ := s.Subscribe(StreamFilter{}, 0)
+		s.Subscribe(StreamFilter{}, 0)
+		_, err := s.Subscribe(StreamFilter{}, 0)
+		assert.ErrorIs(t, err, ErrTooManyClients)
+
+		first.Close()
+		_, err = s.Subscribe(StreamFilter{}, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("replays missed events", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 14)
+
+		repo.On("Exists", uint64(10)).Return(true, nil).Once()
+		repo.On("FindAfter", uint64(10), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{created, updated, deleted}, nil).Once()
+
+		sub, _ := s.Subscribe(StreamFilter{Types: []string{domain.EventEmployeeCreated, domain.EventEmployeeDeleted}}, 10)
+
+		var replayed []domain.Event
+		err := s.Replay(context.Background(), sub, func(event domain.Event) error {
+			replayed = append(replayed, event)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.Event{created.Event(), deleted.Event()}, replayed)
+	})
+
+	t.Run("replay of removed event", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 14)
+
+		repo.On("Exists", uint64(3)).Return(false, nil).Once()
+
+		sub, _ := s.Subscribe(StreamFilter{}, 3)
+		err := s.Replay(context.Background(), sub, func(event domain.Event) error { return nil })
+		assert.ErrorIs(t, err, ErrEventExpired)
+	})
+
+	t.Run("skips events the client has seen", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		s := newStream(repo, 10)
+
+		sub, _ := s.Subscribe(StreamFilter{}, 12)
+		assert.NoError(t, s.Replay(context.Background(), sub, nil))
+
+		repo.On("Snapshot").Return(snapshot(14), nil).Once()
+		repo.On("FindAfter", uint64(10), uint64(14), streamBatchSize).Return([]domain.OutboxEvent{created, updated, deleted}, nil).Once()
+		s.poll(context.Background())
+
+		assert.Equal(t, deleted.Event(), <-sub.Events)
+	})
+
+	t.Run("close ends subscriptions", func(t *testing.T) {
+		s := newStream(mocks.NewOutboxRepository(t), 10)
+		sub, _ := s.Subscribe(StreamFilter{}, 0)
+
+		s.Close()
+		sub.Close()
+
+		_, ok := <-sub.Events
+		assert.False(t, ok)
+
+		_, err := s.Subscribe(StreamFilter{}, 0)
+		assert.ErrorIs(t, err, ErrStreamUnavailable)
+	})
+
+	t.Run("snapshot failure", func(t *testing.T) {
+		repo := mocks.NewOutboxRepository(t)
+		repo.On("Snapshot").Return(domain.OutboxSnapshot{}, errors.New("error")).Once()
+
+		s := NewStream(repo, opts)
+		s.poll(context.Background())
+
+		_, err := s.Subscribe(StreamFilter{}, 0)
+		assert.ErrorIs(t, err, ErrStreamUnavailable)
+	})
+}
@@ -0,0 +1,399 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/repositories"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+const (
+	HeaderWebhookDelivery  = "X-Webhook-Delivery"
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+// Sign returns the signature header of body sent at timestamp, the hex
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook secret.
+// Receivers should reject old timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookSink records a delivery of the event for every enabled webhook
+// subscribed to it, the WebhookSender sends them. Slow or failing webhooks
+// thereby never hold back the outbox.
+type WebhookSink struct {
+	webhookRepository repositories.WebhookRepository
+	now               func() time.Time
+}
+
+func NewWebhookSink(webhookRepository repositories.WebhookRepository) *WebhookSink {
+	return &WebhookSink{
+		webhookRepository: webhookRepository,
+		now:               time.Now,
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhooks"
+}
+
+func (s *WebhookSink) Deliver(ctx context.Context, event domain.Event) error {
+	webhooks, err := s.webhookRepository.FindActive(event.Type)
+	if err != nil {
+		return err
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(webhooks))
+	for _, w := range webhooks {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			WebhookID:     w.ID,
+			EventId:       event.Id,
+			EventType:     event.Type,
+			EmployeeID:    event.EmployeeId,
+			Payload:       string(payload),
+			Status:        domain.DeliveryPending,
+			NextAttemptAt: s.now(),
+		})
+	}
+
+	return s.webhookRepository.StoreDeliveries(deliveries)
+}
+
+type WebhookOptions struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	Timeout      time.Duration
+	FailureLimit int
+	Concurrency  int
+}
+
+const (
+	// maxErrorBody is how much of a failed response is kept in the delivery
+	// log.
+	maxErrorBody = 512
+
+	// leaseRenewal is how often the lease of the deliveries still being sent
+	// is extended, well before claimLease runs out.
+	leaseRenewal = claimLease / 3
+)
+
+// WebhookSender posts pending deliveries to their webhooks. Deliveries of the
+// same webhook and employee are sent in order, failed ones are retried with
+// exponential backoff and a webhook is disabled after FailureLimit
+// deliveries in a row gave up. Up to Concurrency webhooks are sent to at the
+// same time, so a slow or dead endpoint only holds back its own deliveries.
+type WebhookSender struct {
+	webhookRepository repositories.WebhookRepository
+	client            *http.Client
+	opts              WebhookOptions
+	now               func() time.Time
+}
+
+func NewWebhookSender(webhookRepository repositories.WebhookRepository, opts WebhookOptions) *WebhookSender {
+	return &WebhookSender{
+		webhookRepository: webhookRepository,
+		client: &http.Client{
+			Timeout: opts.Timeout,
+			// A redirect counts as a failure, the payload is never sent to
+			// a URL the admin did not register.
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		opts: opts,
+		now:  time.Now,
+	}
+}
+
+// Run polls for due deliveries until ctx is done.
+func (s *WebhookSender) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			if s.SendOnce(ctx) < s.opts.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendOnce claims a batch of due deliveries and sends them, it returns the
+// number of claimed deliveries. The lease of the batch is renewed until every
+// delivery is sent, however long the endpoints take.
+func (s *WebhookSender) SendOnce(ctx context.Context) int {
+	deliveries, err := s.webhookRepository.ClaimDeliveries(s.opts.BatchSize, s.now(), claimLease)
+	if err != nil {
+		logger.Log.Error(err, "failed to claim webhook deliveries")
+		return 0
+	}
+
+	if len(deliveries) == 0 {
+		return 0
+	}
+
+	claimed := &deliveryClaim{ids: make(map[uint64]struct{}, len(deliveries))}
+	var webhookIds []uint
+	byWebhook := make(map[uint][]domain.WebhookDelivery)
+	for _, delivery := range deliveries {
+		claimed.ids[delivery.ID] = struct{}{}
+		if _, ok := byWebhook[delivery.WebhookID]; !ok {
+			webhookIds = append(webhookIds, delivery.WebhookID)
+		}
+		byWebhook[delivery.WebhookID] = append(byWebhook[delivery.WebhookID], delivery)
+	}
+
+	done := make(chan struct{})
+	renewed := make(chan struct{})
+	go func() {
+		defer close(renewed)
+
+		ticker := time.NewTicker(leaseRenewal)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				s.renewLease(claimed)
+			}
+		}
+	}()
+
+	// Deliveries left over at shutdown are claimed again once the lease
+	// expires.
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, s.opts.Concurrency)
+	for _, webhookId := range webhookIds {
+		select {
+		case <-ctx.Done():
+		case slots <- struct{}{}:
+			wg.Add(1)
+			go func(webhookId uint) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+
+				s.sendWebhook(ctx, claimed, webhookId, byWebhook[webhookId])
+			}(webhookId)
+		}
+	}
+
+	wg.Wait()
+	close(done)
+	<-renewed
+
+	return len(deliveries)
+}
+
+// deliveryClaim holds the claimed deliveries that are not finished yet.
+// Renewals hold the lock while they write, a delivery finishes before it is
+// updated, so a renewal never overwrites the outcome of a delivery.
+type deliveryClaim struct {
+	mu  sync.Mutex
+	ids map[uint64]struct{}
+}
+
+func (c *deliveryClaim) finish(id uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.ids, id)
+}
+
+func (s *WebhookSender) renewLease(claimed *deliveryClaim) {
+	claimed.mu.Lock()
+	defer claimed.mu.Unlock()
+
+	if len(claimed.ids) == 0 {
+		return
+	}
+
+	ids := make([]uint64, 0, len(claimed.ids))
+	for id := range claimed.ids {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	if err := s.webhookRepository.ExtendDeliveryLease(ids, s.now().Add(claimLease)); err != nil {
+		logger.Log.Error(err, "failed to extend webhook delivery lease", "count", len(ids))
+	}
+}
+
+// sendWebhook sends the deliveries of one webhook in order. Once one fails
+// the endpoint is likely down, the rest are released unsent until the retry
+// backoff has passed instead of waiting for a timeout each.
+func (s *WebhookSender) sendWebhook(ctx context.Context, claimed *deliveryClaim, webhookId uint, deliveries []domain.WebhookDelivery) {
+	webhook, err := s.webhookRepository.FindById(webhookId)
+	if err != nil {
+		logger.Log.Error(err, "failed to find webhook by id", "id", webhookId)
+		for _, delivery := range deliveries {
+			claimed.finish(delivery.ID)
+		}
+
+		return
+	}
+
+	for i, delivery := range deliveries {
+		if ctx.Err() != nil {
+			for _, rest := range deliveries[i:] {
+				claimed.finish(rest.ID)
+			}
+
+			return
+		}
+
+		if !s.send(ctx, claimed, webhook, delivery) {
+			s.release(claimed, deliveries[i+1:])
+			return
+		}
+	}
+}
+
+func (s *WebhookSender) release(claimed *deliveryClaim, deliveries []domain.WebhookDelivery) {
+	if len(deliveries) == 0 {
+		return
+	}
+
+	ids := make([]uint64, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		claimed.finish(delivery.ID)
+		ids = append(ids, delivery.ID)
+	}
+
+	if err := s.webhookRepository.ReleaseDeliveries(ids, s.now().Add(s.opts.RetryBackoff)); err != nil {
+		logger.Log.Error(err, "failed to release webhook deliveries", "count", len(ids))
+	}
+}
+
+// send posts the delivery and records the outcome, it reports whether the
+// post succeeded.
+func (s *WebhookSender) send(ctx context.Context, claimed *deliveryClaim, webhook domain.Webhook, delivery domain.WebhookDelivery) bool {
+	code, err := s.post(ctx, webhook, delivery)
+	now := s.now()
+	claimed.finish(delivery.ID)
+
+	delivery.ResponseCode = code
+	if err == nil {
+		delivery.Status = domain.DeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+
+		s.updateDelivery(&delivery)
+		if webhook.ConsecutiveFailures > 0 {
+			if err := s.webhookRepository.RecordSuccess(webhook.ID); err != nil {
+				logger.Log.Error(err, "failed to reset webhook failures", "id", webhook.ID)
+			}
+		}
+
+		return true
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts < s.opts.MaxAttempts {
+		delivery.NextAttemptAt = now.Add(backoff(s.opts.RetryBackoff, s.opts.MaxBackoff, delivery.Attempts))
+		s.updateDelivery(&delivery)
+		return false
+	}
+
+	delivery.Status = domain.DeliveryFailed
+	s.updateDelivery(&delivery)
+
+	disabled, recordErr := s.webhookRepository.RecordFailure(webhook.ID, s.opts.FailureLimit, now)
+	if recordErr != nil {
+		logger.Log.Error(recordErr, "failed to record webhook failure", "id", webhook.ID)
+		return false
+	}
+
+	if disabled {
+		logger.Log.Error(err, "webhook disabled after repeated failures", "id", webhook.ID, "url", webhook.Url)
+	}
+
+	return false
+}
+
+// post sends the delivery and returns the response status, any status other
+// than 2xx is an error.
+func (s *WebhookSender) post(ctx context.Context, webhook domain.Webhook, delivery domain.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := s.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Employee-App-Webhook")
+	req.Header.Set(HeaderWebhookDelivery, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(HeaderWebhookEvent, delivery.EventType)
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderWebhookSignature, Sign(webhook.Secret, timestamp, body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		io.Copy(io.Discard, io.LimitReader(res.Body, maxErrorBody))
+		return res.StatusCode, nil
+	}
+
+	// The excerpt is stored as text, which must be valid UTF-8 without NUL.
+	excerpt, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBody))
+	text := strings.ToValidUTF8(strings.ReplaceAll(string(bytes.TrimSpace(excerpt)), "\x00", ""), "")
+	return res.StatusCode, fmt.Errorf("unexpected status %d: %s", res.StatusCode, text)
+}
+
+func (s *WebhookSender) updateDelivery(delivery *domain.WebhookDelivery) {
+	if err := s.webhookRepository.UpdateDelivery(delivery); err != nil {
+		logger.Log.Error(err, "failed to update webhook delivery", "id", delivery.ID)
+	}
+}
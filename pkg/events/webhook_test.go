@@ -0,0 +1,206 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+	"github.com/RuhullahReza/Employee-App/app/mocks"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSign(t *testing.T) {
+	// printf '1714554000.{"id":"1"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=2667d4f193459b0c49b95e19f1a2aa09bd669249b1062aec21e97f54dc607d6d", Sign("secret", 1714554000, []byte(`{"id":"1"}`)))
+	assert.NotEqual(t, Sign("secret", 1714554000, []byte(`{"id":"1"}`)), Sign("secret", 1714554001, []byte(`{"id":"1"}`)))
+	assert.NotEqual(t, Sign("secret", 1714554000, []byte(`{"id":"1"}`)), Sign("other", 1714554000, []byte(`{"id":"1"}`)))
+}
+
+func TestWebhookSink(t *testing.T) {
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	event := domain.Event{Id: "5", Type: domain.EventEmployeeCreated, EmployeeId: 7, OccurredAt: now}
+
+	t.Run("records a delivery per webhook", func(t *testing.T) {
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("FindActive", domain.EventEmployeeCreated).Return([]domain.Webhook{{ID: 1}, {ID: 2}}, nil).Once()
+		repo.On("StoreDeliveries", mock.MatchedBy(func(d []domain.WebhookDelivery) bool {
+			return len(d) == 2 && d[0].WebhookID == 1 && d[1].WebhookID == 2 &&
+				d[0].EventId == "5" && d[0].EmployeeID == 7 && d[0].Status == domain.DeliveryPending &&
+				d[0].NextAttemptAt.Equal(now) && d[0].Payload == d[1].Payload
+		})).Return(nil).Once()
+
+		sink := NewWebhookSink(repo)
+		sink.now = func() time.Time { return now }
+		assert.NoError(t, sink.Deliver(context.Background(), event))
+	})
+
+	t.Run("no subscribers", func(t *testing.T) {
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("FindActive", domain.EventEmployeeCreated).Return(nil, nil).Once()
+
+		assert.NoError(t, NewWebhookSink(repo).Deliver(context.Background(), event))
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("FindActive", domain.EventEmployeeCreated).Return(nil, errors.New("error")).Once()
+
+		assert.Error(t, NewWebhookSink(repo).Deliver(context.Background(), event))
+	})
+}
+
+func TestWebhookSender(t *testing.T) {
+	logger.Init()
+
+	now := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	opts := WebhookOptions{
+		PollInterval: time.Second,
+		BatchSize:    10,
+		MaxAttempts:  3,
+		RetryBackoff: time.Second,
+		MaxBackoff:   time.Minute,
+		Timeout:      time.Second,
+		FailureLimit: 2,
+		Concurrency:  2,
+	}
+
+	newSender := func(repo *mocks.WebhookRepository) *WebhookSender {
+		s := NewWebhookSender(repo, opts)
+		s.now = func() time.Time { return now }
+		return s
+	}
+
+	delivery := domain.WebhookDelivery{ID: 11, WebhookID: 1, EventId: "5", EventType: domain.EventEmployeeUpdated, EmployeeID: 7, Payload: `{"id":"5"}`, Status: domain.DeliveryPending, Attempts: 1}
+
+	t.Run("sends signed delivery", func(t *testing.T) {
+		var header http.Header
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header.Clone()
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		webhook := domain.Webhook{ID: 1, Url: server.URL, Secret: "0123456789abcdef", Active: true, ConsecutiveFailures: 1}
+
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("ClaimDeliveries", opts.BatchSize, now, claimLease).Return([]domain.WebhookDelivery{delivery}, nil).Once()
+		repo.On("FindById", uint(1)).Return(webhook, nil).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.ID == 11 && d.Status == domain.DeliverySucceeded && d.ResponseCode == http.StatusNoContent && d.DeliveredAt != nil
+		})).Return(nil).Once()
+		repo.On("RecordSuccess", uint(1)).Return(nil).Once()
+
+		assert.Equal(t, 1, newSender(repo).SendOnce(context.Background()))
+
+		timestamp := strconv.FormatInt(now.Unix(), 10)
+		assert.Equal(t, `{"id":"5"}`, string(body))
+		assert.Equal(t, "11", header.Get(HeaderWebhookDelivery))
+		assert.Equal(t, domain.EventEmployeeUpdated, header.Get(HeaderWebhookEvent))
+		assert.Equal(t, timestamp, header.Get(HeaderWebhookTimestamp))
+		assert.Equal(t, Sign(webhook.Secret, now.Unix(), body), header.Get(HeaderWebhookSignature))
+	})
+
+	t.Run("retries failed delivery with backoff", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "maintenance", http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		retry := delivery
+		retry.Attempts = 2
+
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("ClaimDeliveries", opts.BatchSize, now, claimLease).Return([]domain.WebhookDelivery{retry}, nil).Once()
+		repo.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Url: server.URL, Active: true}, nil).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.DeliveryPending && d.ResponseCode == http.StatusServiceUnavailable &&
+				d.LastError == "unexpected status 503: maintenance" && d.NextAttemptAt.Equal(now.Add(2*time.Second))
+		})).Return(nil).Once()
+
+		newSender(repo).SendOnce(context.Background())
+	})
+
+	t.Run("gives up and disables webhook", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "https://example.com", http.StatusFound)
+		}))
+		defer server.Close()
+
+		exhausted := delivery
+		exhausted.Attempts = opts.MaxAttempts
+
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("ClaimDeliveries", opts.BatchSize, now, claimLease).Return([]domain.WebhookDelivery{exhausted}, nil).Once()
+		repo.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Url: server.URL, Active: true}, nil).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.Status == domain.DeliveryFailed && d.ResponseCode == http.StatusFound
+		})).Return(nil).Once()
+		repo.On("RecordFailure", uint(1), opts.FailureLimit, now).Return(true, nil).Once()
+
+		newSender(repo).SendOnce(context.Background())
+	})
+
+	t.Run("claim failure", func(t *testing.T) {
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("ClaimDeliveries", opts.BatchSize, now, claimLease).Return(nil, errors.New("error")).Once()
+
+		assert.Equal(t, 0, newSender(repo).SendOnce(context.Background()))
+	})
+
+	t.Run("releases the rest of a failing webhook", func(t *testing.T) {
+		down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "down", http.StatusBadGateway)
+		}))
+		defer down.Close()
+
+		up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer up.Close()
+
+		first := delivery
+		second := delivery
+		second.ID, second.EmployeeID = 12, 8
+		other := delivery
+		other.ID, other.WebhookID = 21, 2
+
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("ClaimDeliveries", opts.BatchSize, now, claimLease).Return([]domain.WebhookDelivery{first, second, other}, nil).Once()
+		repo.On("FindById", uint(1)).Return(domain.Webhook{ID: 1, Url: down.URL, Active: true}, nil).Once()
+		repo.On("FindById", uint(2)).Return(domain.Webhook{ID: 2, Url: up.URL, Active: true}, nil).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.ID == 11 && d.Status == domain.DeliveryPending && d.ResponseCode == http.StatusBadGateway
+		})).Return(nil).Once()
+		repo.On("ReleaseDeliveries", []uint64{12}, now.Add(opts.RetryBackoff)).Return(nil).Once()
+		repo.On("UpdateDelivery", mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+			return d.ID == 21 && d.Status == domain.DeliverySucceeded
+		})).Return(nil).Once()
+
+		assert.Equal(t, 3, newSender(repo).SendOnce(context.Background()))
+	})
+
+	t.Run("renews the lease of unfinished deliveries", func(t *testing.T) {
+		claimed := &deliveryClaim{ids: map[uint64]struct{}{12: {}, 11: {}}}
+
+		repo := mocks.NewWebhookRepository(t)
+		repo.On("ExtendDeliveryLease", []uint64{11, 12}, now.Add(claimLease)).Return(nil).Once()
+
+		sender := newSender(repo)
+		sender.renewLease(claimed)
+
+		claimed.finish(11)
+		claimed.finish(12)
+		sender.renewLease(claimed)
+	})
+}
@@ -0,0 +1,25 @@
+package featureflag
+
+import (
+	"sync/atomic"
+)
+
+var flags atomic.Value
+
+func init() {
+	flags.Store(map[string]bool{})
+}
+
+// Set replaces the enabled flags.
+func Set(names []string) {
+	enabled := make(map[string]bool, len(names))
+	for _, name := range names {
+		enabled[name] = true
+	}
+
+	flags.Store(enabled)
+}
+
+func Enabled(name string) bool {
+	return flags.Load().(map[string]bool)[name]
+}
@@ -0,0 +1,150 @@
+// Package ical reads the events of an iCalendar (RFC 5545) file, it only
+// understands what is needed to import public holidays.
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidCalendar = errors.New("invalid iCalendar data")
+	ErrInvalidDate     = errors.New("invalid iCalendar date")
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+)
+
+// Event is a VEVENT. Start and End are dates, End is exclusive as in the
+// iCalendar format, so a one day event ends the day after it starts.
+type Event struct {
+	Summary string
+	Start   time.Time
+	End     time.Time
+}
+
+// Days returns every date covered by the event.
+func (e Event) Days() []time.Time {
+	var days []time.Time
+	for d := e.Start; d.Before(e.End); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+
+	return days
+}
+
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(lines) == 0 || !strings.EqualFold(lines[0], "BEGIN:VCALENDAR") {
+		return nil, ErrInvalidCalendar
+	}
+
+	var (
+		events  []Event
+		current *Event
+	)
+	for _, line := range lines {
+		name, params, value := splitLine(line)
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			current = &Event{}
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			if current == nil || current.Start.IsZero() {
+				return nil, ErrInvalidCalendar
+			}
+
+			if !current.End.After(current.Start) {
+				current.End = current.Start.AddDate(0, 0, 1)
+			}
+
+			events = append(events, *current)
+			current = nil
+		case current == nil:
+			continue
+		case name == "SUMMARY":
+			current.Summary = unescape(value)
+		case name == "DTSTART":
+			if current.Start, err = parseDate(value, params); err != nil {
+				return nil, err
+			}
+		case name == "DTEND":
+			if current.End, err = parseDate(value, params); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if current != nil {
+		return nil, ErrInvalidCalendar
+	}
+
+	return events, nil
+}
+
+// unfold joins continuation lines, which start with a space or a tab.
+func unfold(r io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, scanner.Err()
+}
+
+func splitLine(line string) (name, params, value string) {
+	property, value, _ := strings.Cut(line, ":")
+	name, params, _ = strings.Cut(property, ";")
+	return strings.ToUpper(name), params, value
+}
+
+// parseDate keeps the calendar date of DATE and DATE-TIME values, holidays
+// are whole days whatever time zone the file was exported in.
+func parseDate(value, params string) (time.Time, error) {
+	if strings.Contains(strings.ToUpper(params), "VALUE=DATE") && !strings.Contains(value, "T") {
+		return parse(dateLayout, value)
+	}
+
+	if len(value) >= len(dateTimeLayout) {
+		t, err := parse(dateTimeLayout, value[:len(dateTimeLayout)])
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+
+	return parse(dateLayout, value)
+}
+
+func parse(layout, value string) (time.Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, ErrInvalidDate
+	}
+
+	return t, nil
+}
+
+func unescape(value string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(value)
+}
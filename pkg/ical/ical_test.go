@@ -0,0 +1,67 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("Test Parse all day and timed events", func(t *testing.T) {
+		data := "BEGIN:VCALENDAR\r\n" +
+			"VERSION:2.0\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"DTSTART;VALUE=DATE:20240101\r\n" +
+			"DTEND;VALUE=DATE:20240102\r\n" +
+			"SUMMARY:New Year\\, Day\r\n" +
+			"END:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"DTSTART;VALUE=DATE:20240410\r\n" +
+			"DTEND;VALUE=DATE:20240412\r\n" +
+			"SUMMARY:Idul\r\n" +
+			" Fitri\r\n" +
+			"END:VEVENT\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			"DTSTART:20240817T000000Z\r\n" +
+			"SUMMARY:Independence Day\r\n" +
+			"END:VEVENT\r\n" +
+			"END:VCALENDAR\r\n"
+
+		events, err := Parse(strings.NewReader(data))
+		assert.NoError(t, err)
+		assert.Len(t, events, 3)
+
+		assert.Equal(t, "New Year, Day", events[0].Summary)
+		assert.Len(t, events[0].Days(), 1)
+
+		assert.Equal(t, "IdulFitri", events[1].Summary)
+		assert.Equal(t, []time.Time{
+			time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 4, 11, 0, 0, 0, 0, time.UTC),
+		}, events[1].Days())
+
+		assert.Equal(t, time.Date(2024, 8, 17, 0, 0, 0, 0, time.UTC), events[2].Start)
+		assert.Len(t, events[2].Days(), 1)
+	})
+
+	t.Run("Test Parse not a calendar", func(t *testing.T) {
+		_, err := Parse(strings.NewReader("hello"))
+		assert.ErrorIs(t, err, ErrInvalidCalendar)
+	})
+
+	t.Run("Test Parse invalid date", func(t *testing.T) {
+		data := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:2024-01-01\nEND:VEVENT\nEND:VCALENDAR\n"
+
+		_, err := Parse(strings.NewReader(data))
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+
+	t.Run("Test Parse unterminated event", func(t *testing.T) {
+		data := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART;VALUE=DATE:20240101\n"
+
+		_, err := Parse(strings.NewReader(data))
+		assert.ErrorIs(t, err, ErrInvalidCalendar)
+	})
+}
@@ -0,0 +1,86 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const jpegQuality = 85
+
+var (
+	ErrUnsupportedFormat = errors.New("image must be JPEG, PNG or WebP")
+	ErrInvalidDimensions = errors.New("invalid image dimensions")
+)
+
+// Image is a decoded JPEG, PNG or WebP image. Metadata of the source is not
+// kept, only the EXIF orientation of JPEG images.
+type Image struct {
+	img         image.Image
+	orientation int
+}
+
+// Decode checks the format and the dimensions from the header before the
+// pixels are decoded, so oversized images are rejected cheaply.
+func Decode(r io.Reader, minDimension, maxDimension int) (Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Image{}, err
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != "jpeg" && format != "png" && format != "webp") {
+		return Image{}, ErrUnsupportedFormat
+	}
+
+	if cfg.Width < minDimension || cfg.Height < minDimension || cfg.Width > maxDimension || cfg.Height > maxDimension {
+		return Image{}, fmt.Errorf("%w %dx%d, width and height must be within %d and %d pixels",
+			ErrInvalidDimensions, cfg.Width, cfg.Height, minDimension, maxDimension)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Image{}, ErrUnsupportedFormat
+	}
+
+	orientation := 1
+	if format == "jpeg" {
+		orientation = jpegOrientation(data)
+	}
+
+	return Image{img: img, orientation: orientation}, nil
+}
+
+// Thumbnail crops the center square of the image and scales it to size
+// pixels. Transparent areas become white.
+func (i Image) Thumbnail(size int) image.Image {
+	b := i.img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+
+	x := b.Min.X + (b.Dx()-side)/2
+	y := b.Min.Y + (b.Dy()-side)/2
+	crop := image.Rect(x, y, x+side, y+side)
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), i.img, crop, draw.Over, nil)
+
+	// The center square of a rotated image is the rotated center square, so
+	// orienting the small thumbnail is enough.
+	return orient(dst, i.orientation)
+}
+
+func EncodeJPEG(w io.Writer, img image.Image) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+}
@@ -0,0 +1,142 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// halves returns an image with a red left half and a blue right half.
+func halves(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= w/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}))
+	return buf.Bytes()
+}
+
+// withOrientation inserts an EXIF segment holding only the orientation tag
+// right after the start of image marker.
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, exifOrientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, app1...)
+	return append(out, data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return r > 0xc000 && b < 0x4000
+}
+
+func isBlue(c color.Color) bool {
+	r, _, b, _ := c.RGBA()
+	return b > 0xc000 && r < 0x4000
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("jpeg", func(t *testing.T) {
+		img, err := Decode(bytes.NewReader(encodeJPEG(t, halves(200, 100))), 64, 400)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, img.orientation)
+	})
+
+	t.Run("exif orientation", func(t *testing.T) {
+		data := withOrientation(encodeJPEG(t, halves(200, 100)), 6)
+		assert.Equal(t, 6, jpegOrientation(data))
+
+		img, err := Decode(bytes.NewReader(data), 64, 400)
+		assert.NoError(t, err)
+		assert.Equal(t, 6, img.orientation)
+	})
+
+	t.Run("too small", func(t *testing.T) {
+		_, err := Decode(bytes.NewReader(encodeJPEG(t, halves(200, 32))), 64, 400)
+		assert.ErrorIs(t, err, ErrInvalidDimensions)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := Decode(bytes.NewReader(encodeJPEG(t, halves(500, 100))), 64, 400)
+		assert.ErrorIs(t, err, ErrInvalidDimensions)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := Decode(bytes.NewReader([]byte("GIF89a not really")), 64, 400)
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
+
+func TestThumbnail(t *testing.T) {
+	t.Run("center crop", func(t *testing.T) {
+		img, err := Decode(bytes.NewReader(encodeJPEG(t, halves(400, 100))), 64, 400)
+		assert.NoError(t, err)
+
+		thumb := img.Thumbnail(64)
+		assert.Equal(t, image.Rect(0, 0, 64, 64), thumb.Bounds())
+		assert.True(t, isRed(thumb.At(4, 32)))
+		assert.True(t, isBlue(thumb.At(60, 32)))
+	})
+
+	t.Run("rotated upright", func(t *testing.T) {
+		data := withOrientation(encodeJPEG(t, halves(200, 100)), 6)
+		img, err := Decode(bytes.NewReader(data), 64, 400)
+		assert.NoError(t, err)
+
+		// Turning clockwise moves the red left half to the top.
+		thumb := img.Thumbnail(64)
+		assert.True(t, isRed(thumb.At(32, 4)))
+		assert.True(t, isBlue(thumb.At(32, 60)))
+	})
+
+	t.Run("transparent becomes white", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 100, 100))))
+
+		img, err := Decode(&buf, 64, 400)
+		assert.NoError(t, err)
+
+		r, g, b, _ := img.Thumbnail(64).At(32, 32).RGBA()
+		assert.Equal(t, []uint32{0xffff, 0xffff, 0xffff}, []uint32{r, g, b})
+	})
+}
+
+func TestEncodeJPEGStripsMetadata(t *testing.T) {
+	img, err := Decode(bytes.NewReader(withOrientation(encodeJPEG(t, halves(200, 100)), 6)), 64, 400)
+	assert.NoError(t, err)
+
+	var buf bytes.Buffer
+	assert.NoError(t, EncodeJPEG(&buf, img.Thumbnail(64)))
+	assert.False(t, bytes.Contains(buf.Bytes(), []byte("Exif")))
+	assert.Equal(t, 1, jpegOrientation(buf.Bytes()))
+}
@@ -0,0 +1,139 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+)
+
+var ErrDrainTimeout = errors.New("timed out waiting for background workers to stop")
+
+type closer struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Manager coordinates the readiness flag, the background workers and the
+// resources that have to be released when the process is stopping.
+type Manager struct {
+	ready   atomic.Bool
+	ctx     context.Context
+	cancel  context.CancelFunc
+	workers sync.WaitGroup
+
+	mu      sync.Mutex
+	closers []closer
+}
+
+func NewManager() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (m *Manager) Ready() bool {
+	return m.ready.Load()
+}
+
+func (m *Manager) SetReady(ready bool) {
+	m.ready.Store(ready)
+}
+
+// Context is cancelled as soon as the shutdown starts, background workers
+// should stop picking up new work when it is done.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+// Go runs fn in a tracked goroutine, the shutdown waits for it to return.
+func (m *Manager) Go(name string, fn func(ctx context.Context)) {
+	m.workers.Add(1)
+	go func() {
+		defer m.workers.Done()
+		fn(m.ctx)
+		logger.Log.Info("background worker stopped", "worker", name)
+	}()
+}
+
+// OnClose registers a resource to release after the server and the workers
+// are stopped. Closers run in reverse registration order.
+func (m *Manager) OnClose(name string, fn func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closers = append(m.closers, closer{name: name, fn: fn})
+}
+
+// Shutdown flips readiness to false, stops the server through stopServer,
+// waits for background workers and finally runs the registered closers. All
+// steps share the deadline of ctx.
+func (m *Manager) Shutdown(ctx context.Context, drainDelay time.Duration, stopServer func(ctx context.Context) error) error {
+	var failures []string
+
+	m.SetReady(false)
+	if drainDelay > 0 {
+		logger.Log.Info("waiting before draining connections", "delay", drainDelay.String())
+		select {
+		case <-time.After(drainDelay):
+		case <-ctx.Done():
+		}
+	}
+
+	if stopServer != nil {
+		if err := stopServer(ctx); err != nil {
+			logger.Log.Error(err, "failed to stop server")
+			failures = append(failures, fmt.Sprintf("server: %s", err))
+		}
+	}
+
+	m.cancel()
+	if err := m.waitWorkers(ctx); err != nil {
+		logger.Log.Error(err, "failed to drain background workers")
+		failures = append(failures, fmt.Sprintf("workers: %s", err))
+	}
+
+	m.mu.Lock()
+	closers := m.closers
+	m.closers = nil
+	m.mu.Unlock()
+
+	for i := len(closers) - 1; i >= 0; i-- {
+		c := closers[i]
+		if err := c.fn(ctx); err != nil {
+			logger.Log.Error(err, "failed to close resource", "resource", c.name)
+			failures = append(failures, fmt.Sprintf("%s: %s", c.name, err))
+			continue
+		}
+
+		logger.Log.Info("resource closed", "resource", c.name)
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("shutdown incomplete: %s", strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+func (m *Manager) waitWorkers(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		m.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ErrDrainTimeout
+	}
+}
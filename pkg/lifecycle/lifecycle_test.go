@@ -0,0 +1,80 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManager(t *testing.T) {
+	logger.Init()
+
+	t.Run("Test Shutdown SUCCESS", func(t *testing.T) {
+		m := NewManager()
+		m.SetReady(true)
+
+		var order []string
+		m.Go("worker", func(ctx context.Context) {
+			<-ctx.Done()
+			order = append(order, "worker")
+		})
+		m.OnClose("first", func(ctx context.Context) error {
+			order = append(order, "first")
+			return nil
+		})
+		m.OnClose("second", func(ctx context.Context) error {
+			order = append(order, "second")
+			return nil
+		})
+
+		stopServer := func(ctx context.Context) error {
+			assert.False(t, m.Ready())
+			order = append(order, "server")
+			return nil
+		}
+
+		err := m.Shutdown(context.Background(), 0, stopServer)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"server", "worker", "second", "first"}, order)
+	})
+
+	t.Run("Test Shutdown worker drain timeout", func(t *testing.T) {
+		m := NewManager()
+
+		block := make(chan struct{})
+		defer close(block)
+		m.Go("stuck", func(ctx context.Context) {
+			<-block
+		})
+
+		closed := false
+		m.OnClose("database", func(ctx context.Context) error {
+			closed = true
+			return nil
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := m.Shutdown(ctx, 0, nil)
+		assert.Error(t, err)
+		assert.True(t, closed)
+	})
+
+	t.Run("Test Shutdown closer error", func(t *testing.T) {
+		m := NewManager()
+		m.OnClose("database", func(ctx context.Context) error {
+			return errors.New("error")
+		})
+
+		err := m.Shutdown(context.Background(), 0, func(ctx context.Context) error {
+			return nil
+		})
+		assert.Error(t, err)
+	})
+}
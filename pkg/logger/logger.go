@@ -19,6 +19,16 @@ func Init() {
 		Caller().
 		Logger()
 
- 	Log = zerologr.New(&logger)
+	Log = zerologr.New(&logger)
 }
 
+// SetLevel changes the minimum level of every logger at runtime.
+func SetLevel(level string) error {
+	l, err := zerolog.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	zerolog.SetGlobalLevel(l)
+	return nil
+}
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"sync/atomic"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+	"github.com/RuhullahReza/Employee-App/pkg/logger"
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	apiKeyHeader = "X-API-Key"
+	bearerPrefix = "Bearer "
+
+	// PrincipalKey is the ctx.Locals key holding the name of the API key
+	// that authorized the request.
+	PrincipalKey = "principal"
+
+	// EmployeeKey is the ctx.Locals key holding the id of the employee the
+	// API key belongs to.
+	EmployeeKey = "employee"
+)
+
+var ErrNoEmployee = errors.New("the API key does not belong to an employee")
+
+// Authorizer checks API keys from API_KEYS against the permission required by
+// a route. Keys are reloaded when the config changes.
+type Authorizer struct {
+	keys atomic.Value
+}
+
+func NewAuthorizer(cfg *config.Config) *Authorizer {
+	a := &Authorizer{}
+	a.keys.Store(parseAPIKeys(cfg))
+
+	return a
+}
+
+func (a *Authorizer) OnConfigChange(previous, current *config.Config) {
+	if previous.ApiKeys != current.ApiKeys {
+		a.keys.Store(parseAPIKeys(current))
+	}
+}
+
+// Require only lets requests through when the API key grants permission.
+func (a *Authorizer) Require(permission string) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		key := requestAPIKey(ctx)
+		if key == "" {
+			return utils.ResponseUnauthorized(ctx, "missing API key")
+		}
+
+		apiKey, ok := a.lookup(key)
+		if !ok {
+			return utils.ResponseUnauthorized(ctx, "invalid API key")
+		}
+
+		if !hasPermission(apiKey.Permissions, permission) {
+			return utils.ResponseForbidden(ctx, "missing permission "+permission)
+		}
+
+		ctx.Locals(PrincipalKey, apiKey.Name)
+		if apiKey.EmployeeID != 0 {
+			ctx.Locals(EmployeeKey, apiKey.EmployeeID)
+		}
+
+		return ctx.Next()
+	}
+}
+
+func (a *Authorizer) lookup(key string) (config.APIKey, bool) {
+	for _, apiKey := range a.keys.Load().([]config.APIKey) {
+		if subtle.ConstantTimeCompare([]byte(apiKey.Key), []byte(key)) == 1 {
+			return apiKey, true
+		}
+	}
+
+	return config.APIKey{}, false
+}
+
+// Principal returns the name of the API key that authorized the request.
+func Principal(ctx *fiber.Ctx) string {
+	name, _ := ctx.Locals(PrincipalKey).(string)
+	return name
+}
+
+// Employee returns the id of the employee acting through the request, false
+// when the API key does not belong to one.
+func Employee(ctx *fiber.Ctx) (uint, bool) {
+	id, ok := ctx.Locals(EmployeeKey).(uint)
+	return id, ok
+}
+
+func parseAPIKeys(cfg *config.Config) []config.APIKey {
+	keys, err := cfg.APIKeyList()
+	if err != nil {
+		logger.Log.Error(err, "failed to parse API keys")
+		return []config.APIKey{}
+	}
+
+	return keys
+}
+
+func requestAPIKey(ctx *fiber.Ctx) string {
+	if key := ctx.Get(apiKeyHeader); key != "" {
+		return key
+	}
+
+	if auth := ctx.Get(fiber.HeaderAuthorization); strings.HasPrefix(auth, bearerPrefix) {
+		return strings.TrimSpace(strings.TrimPrefix(auth, bearerPrefix))
+	}
+
+	return ""
+}
+
+func hasPermission(permissions []string, permission string) bool {
+	for _, p := range permissions {
+		if p == permission || p == "*" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizer(t *testing.T) {
+	cfg := &config.Config{ApiKeys: "payroll:secret-1=compensation.read|compensation.write,viewer:secret-2=employees.read"}
+	authorizer := NewAuthorizer(cfg)
+
+	app := fiber.New()
+	app.Get("/api/compensations", authorizer.Require("compensation.read"), func(ctx *fiber.Ctx) error {
+		return ctx.SendString(Principal(ctx))
+	})
+
+	request := func(header, value string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/compensations", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	t.Run("Test missing key", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request("", ""))
+	})
+
+	t.Run("Test unknown key", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, request("X-API-Key", "wrong"))
+	})
+
+	t.Run("Test key without permission", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, request("X-API-Key", "secret-2"))
+	})
+
+	t.Run("Test key with permission", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("X-API-Key", "secret-1"))
+		assert.Equal(t, http.StatusOK, request("Authorization", "Bearer secret-1"))
+	})
+
+	t.Run("Test keys reloaded after config change", func(t *testing.T) {
+		authorizer.OnConfigChange(cfg, &config.Config{ApiKeys: "viewer:secret-2=compensation.read"})
+
+		assert.Equal(t, http.StatusUnauthorized, request("X-API-Key", "secret-1"))
+		assert.Equal(t, http.StatusOK, request("X-API-Key", "secret-2"))
+	})
+}
+
+func TestEmployee(t *testing.T) {
+	cfg := &config.Config{ApiKeys: "jane@12:secret-1=leave.approve,ops:secret-2=*"}
+	authorizer := NewAuthorizer(cfg)
+
+	app := fiber.New()
+	app.Get("/api/whoami", authorizer.Require("leave.approve"), func(ctx *fiber.Ctx) error {
+		id, ok := Employee(ctx)
+		if !ok {
+			return ctx.SendStatus(http.StatusForbidden)
+		}
+
+		return ctx.JSON(id)
+	})
+
+	request := func(key string) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/whoami", nil)
+		req.Header.Set("X-API-Key", key)
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	t.Run("Test key of an employee", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, request("secret-1"))
+	})
+
+	t.Run("Test key without employee", func(t *testing.T) {
+		assert.Equal(t, http.StatusForbidden, request("secret-2"))
+	})
+}
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/RuhullahReza/Employee-App/pkg/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// BodyLimit rejects request bodies above limit bytes. The server streams
+// request bodies so uploads are never held in memory, routes that skip
+// selects read them as they arrive and every other route reads its body
+// through this limit.
+func BodyLimit(limit int, skip func(ctx *fiber.Ctx) bool) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		if skip(ctx) {
+			return ctx.Next()
+		}
+
+		req := ctx.Request()
+		tooLarge := req.Header.ContentLength() > limit
+		if body := req.BodyStream(); body != nil && !tooLarge {
+			content, err := io.ReadAll(io.LimitReader(body, int64(limit)+1))
+			if err != nil {
+				return utils.ResponseBadRequest(ctx, err.Error())
+			}
+
+			tooLarge = len(content) > limit
+			req.SetBodyRaw(content)
+		}
+
+		if tooLarge {
+			// The rest of the body is not read, the connection cannot
+			// serve another request.
+			ctx.Set(fiber.HeaderConnection, "close")
+			return utils.ResponsePayloadTooLarge(ctx, fmt.Sprintf("the request body is limited to %d bytes", limit))
+		}
+
+		return ctx.Next()
+	}
+}
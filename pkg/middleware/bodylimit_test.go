@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBodyLimit(t *testing.T) {
+	app := fiber.New(fiber.Config{StreamRequestBody: true, BodyLimit: 8})
+	app.Use(BodyLimit(16, func(ctx *fiber.Ctx) bool {
+		return ctx.Path() == "/upload"
+	}))
+
+	echo := func(ctx *fiber.Ctx) error {
+		return ctx.Send(ctx.Body())
+	}
+	app.Post("/json", echo)
+	app.Post("/upload", func(ctx *fiber.Ctx) error {
+		content, err := io.ReadAll(ctx.Request().BodyStream())
+		if err != nil {
+			return err
+		}
+
+		return ctx.Send(content)
+	})
+
+	request := func(path, body string, contentLength int64) (int, string) {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.ContentLength = contentLength
+		if contentLength < 0 {
+			req.TransferEncoding = []string{"chunked"}
+		}
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+
+		content, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(content)
+	}
+
+	t.Run("Test body within the limit", func(t *testing.T) {
+		status, body := request("/json", `{"a": "long"}`, 13)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, `{"a": "long"}`, body)
+	})
+
+	t.Run("Test body above the limit", func(t *testing.T) {
+		status, _ := request("/json", `{"a": "longer value"}`, 21)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+	})
+
+	t.Run("Test chunked body above the limit", func(t *testing.T) {
+		status, _ := request("/json", `{"a": "longer value"}`, -1)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, status)
+	})
+
+	t.Run("Test skipped route streams its body", func(t *testing.T) {
+		status, body := request("/upload", strings.Repeat("x", 64), 64)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, strings.Repeat("x", 64), body)
+	})
+}
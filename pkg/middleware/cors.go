@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"strings"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/cors"
+)
+
+// NewCORS allows cross origin requests from CORS_ORIGINS, an empty list
+// disables CORS headers.
+func NewCORS(cfg *config.Config) *Reloadable {
+	return newReloadable(cfg, buildCORS, func(previous, current *config.Config) bool {
+		return previous.CorsOrigins != current.CorsOrigins
+	})
+}
+
+func buildCORS(cfg *config.Config) fiber.Handler {
+	origins := cfg.CorsOriginList()
+	if len(origins) == 0 {
+		return next
+	}
+
+	return cors.New(cors.Config{
+		AllowOrigins: strings.Join(origins, ","),
+	})
+}
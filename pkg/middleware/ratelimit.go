@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"strings"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+// NewRateLimiter limits every client IP to RATE_LIMIT_MAX requests per
+// RATE_LIMIT_WINDOW, zero disables the limit. Health checks are never
+// limited. Counters start over when the limit is changed.
+func NewRateLimiter(cfg *config.Config) *Reloadable {
+	return newReloadable(cfg, buildRateLimiter, func(previous, current *config.Config) bool {
+		return previous.RateLimitMax != current.RateLimitMax || previous.RateLimitWindow != current.RateLimitWindow
+	})
+}
+
+func buildRateLimiter(cfg *config.Config) fiber.Handler {
+	if cfg.RateLimitMax <= 0 {
+		return next
+	}
+
+	return limiter.New(limiter.Config{
+		Max:        cfg.RateLimitMax,
+		Expiration: cfg.RateLimitWindow,
+		Next: func(ctx *fiber.Ctx) bool {
+			return strings.HasPrefix(ctx.Path(), "/health")
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"sync/atomic"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Reloadable wraps a middleware that is built from the config, the handler is
+// rebuilt whenever one of the settings it depends on changes.
+type Reloadable struct {
+	handler atomic.Value
+	build   func(cfg *config.Config) fiber.Handler
+	changed func(previous, current *config.Config) bool
+}
+
+func newReloadable(cfg *config.Config, build func(cfg *config.Config) fiber.Handler, changed func(previous, current *config.Config) bool) *Reloadable {
+	r := &Reloadable{
+		build:   build,
+		changed: changed,
+	}
+	r.handler.Store(build(cfg))
+
+	return r
+}
+
+func (r *Reloadable) Handler(ctx *fiber.Ctx) error {
+	return r.handler.Load().(fiber.Handler)(ctx)
+}
+
+func (r *Reloadable) OnConfigChange(previous, current *config.Config) {
+	if r.changed(previous, current) {
+		r.handler.Store(r.build(current))
+	}
+}
+
+func next(ctx *fiber.Ctx) error {
+	return ctx.Next()
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	config "github.com/RuhullahReza/Employee-App/config"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	cfg := &config.Config{RateLimitMax: 0, RateLimitWindow: time.Minute}
+	rateLimiter := NewRateLimiter(cfg)
+
+	app := fiber.New()
+	app.Use(rateLimiter.Handler)
+	app.Get("/api/employees", func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusOK)
+	})
+
+	request := func() int {
+		resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/api/employees", nil), 2)
+		assert.NoError(t, err)
+		return resp.StatusCode
+	}
+
+	t.Run("Test disabled limit", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, http.StatusOK, request())
+		}
+	})
+
+	t.Run("Test limit applied after config change", func(t *testing.T) {
+		rateLimiter.OnConfigChange(cfg, &config.Config{RateLimitMax: 1, RateLimitWindow: time.Minute})
+
+		assert.Equal(t, http.StatusOK, request())
+		assert.Equal(t, http.StatusTooManyRequests, request())
+	})
+}
+
+func TestCORS(t *testing.T) {
+	cfg := &config.Config{}
+	corsMiddleware := NewCORS(cfg)
+
+	app := fiber.New()
+	app.Use(corsMiddleware.Handler)
+	app.Get("/api/employees", func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusOK)
+	})
+
+	request := func() string {
+		req := httptest.NewRequest(http.MethodGet, "/api/employees", nil)
+		req.Header.Set("Origin", "https://hr.example.com")
+		resp, err := app.Test(req, 2)
+		assert.NoError(t, err)
+		return resp.Header.Get("Access-Control-Allow-Origin")
+	}
+
+	assert.Equal(t, "", request())
+
+	corsMiddleware.OnConfigChange(cfg, &config.Config{CorsOrigins: "https://hr.example.com"})
+	assert.Equal(t, "https://hr.example.com", request())
+}
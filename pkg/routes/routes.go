@@ -1,32 +1,213 @@
 package routes
 
 import (
+	"regexp"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
 	"github.com/RuhullahReza/Employee-App/app/handlers"
+	"github.com/RuhullahReza/Employee-App/pkg/middleware"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+type Handlers struct {
+	Employee     *handlers.EmployeeHandler
+	Health       *handlers.HealthHandler
+	Position     *handlers.PositionHandler
+	Job          *handlers.JobHandler
+	Stream       *handlers.StreamHandler
+	GraphQL      *handlers.GraphQLHandler
+	Compensation *handlers.CompensationHandler
+	Leave        *handlers.LeaveHandler
+	Calendar     *handlers.CalendarHandler
+	Attendance   *handlers.AttendanceHandler
+	Review       *handlers.ReviewHandler
+	Checklist    *handlers.ChecklistHandler
+	Contact      *handlers.ContactHandler
+	CustomField  *handlers.CustomFieldHandler
+	Document     *handlers.DocumentHandler
+	Photo        *handlers.PhotoHandler
+	Webhook      *handlers.WebhookHandler
+}
+
 type Routes struct {
-	router          fiber.Router
-	employeeHandler *handlers.EmployeeHandler
+	router     fiber.Router
+	handlers   Handlers
+	authorizer *middleware.Authorizer
 }
 
-func NewRoutes(app *fiber.App, h *handlers.EmployeeHandler) *Routes {
+func NewRoutes(app *fiber.App, h Handlers, authorizer *middleware.Authorizer) *Routes {
 	return &Routes{
-		router:          app,
-		employeeHandler: h,
+		router:     app,
+		handlers:   h,
+		authorizer: authorizer,
 	}
 }
 
+func (r *Routes) healthRoutes() {
+	resources := r.router.Group("/health")
+	resources.Get("/live", r.handlers.Health.Live)
+	resources.Get("/ready", r.handlers.Health.Ready)
+}
+
 func (r *Routes) employeeRoutes(prefix string) {
 	resources := r.router.Group(prefix + "/employees")
-	resources.Post("/", r.employeeHandler.CreateNewEmployee)
-	resources.Get("/", r.employeeHandler.FindAllEmployee)
-	resources.Get("/:id", r.employeeHandler.FindEmployeeById)
-	resources.Put("/:id", r.employeeHandler.UpdateEmployeeById)
-	resources.Delete("/:id", r.employeeHandler.DeleteEmployeeById)
+	resources.Post("/", r.handlers.Employee.CreateNewEmployee)
+	resources.Get("/", r.handlers.Employee.FindAllEmployee)
+	resources.Get("/search", r.handlers.Employee.SearchEmployees)
+	resources.Get("/stream", r.handlers.Stream.StreamEmployees)
+	resources.Get("/:id", r.handlers.Employee.FindEmployeeById)
+	resources.Put("/:id", r.handlers.Employee.UpdateEmployeeById)
+	resources.Delete("/:id", r.handlers.Employee.DeleteEmployeeById)
+	resources.Post("/:id/activate", r.handlers.Employee.ActivateEmployee)
+	resources.Post("/:id/place-on-leave", r.handlers.Employee.PlaceEmployeeOnLeave)
+	resources.Post("/:id/return-from-leave", r.handlers.Employee.ReturnEmployeeFromLeave)
+	resources.Post("/:id/terminate", r.handlers.Employee.TerminateEmployee)
+	resources.Post("/:id/rehire", r.handlers.Employee.RehireEmployee)
+	resources.Put("/:id/manager", r.handlers.Employee.AssignManager)
+	resources.Put("/:id/calendar", r.handlers.Calendar.AssignCalendar)
+	resources.Get("/:id/calendar/check", r.handlers.Calendar.CheckEmployeeDate)
+	resources.Put("/:id/photo", r.authorizer.Require(domain.PermissionPhotoWrite), r.handlers.Photo.UploadPhoto)
+	resources.Get("/:id/photo", r.handlers.Photo.FindPhoto)
+
+	resources.Get("/:id/jobs", r.handlers.Job.FindJobHistory)
+	resources.Post("/:id/jobs", r.handlers.Job.AssignJob)
+
+	resources.Get("/:id/leave-balances", r.handlers.Leave.FindLeaveBalances)
+	resources.Get("/:id/leave-requests", r.handlers.Leave.FindLeaveRequests)
+	resources.Post("/:id/leave-requests", r.handlers.Leave.RequestLeave)
+
+	resources.Post("/:id/attendance/clock-in", r.handlers.Attendance.ClockIn)
+	resources.Post("/:id/attendance/clock-out", r.handlers.Attendance.ClockOut)
+	resources.Get("/:id/attendance/timesheet", r.handlers.Attendance.FindTimesheet)
+
+	resources.Get("/:id/tasks", r.handlers.Checklist.FindEmployeeTasks)
+	resources.Put("/:id/tasks/:taskId/assignee", r.authorizer.Require(domain.PermissionChecklistManage), r.handlers.Checklist.AssignTask)
+	resources.Post("/:id/tasks/:taskId/complete", r.authorizer.Require(domain.PermissionChecklistWrite), r.handlers.Checklist.CompleteTask)
+
+	read := r.authorizer.Require(domain.PermissionCompensationRead)
+	write := r.authorizer.Require(domain.PermissionCompensationWrite)
+	resources.Get("/:id/compensations", read, r.handlers.Compensation.FindCompensationHistory)
+	resources.Get("/:id/compensations/current", read, r.handlers.Compensation.FindCurrentCompensation)
+	resources.Post("/:id/compensations", write, r.handlers.Compensation.AddCompensation)
+
+	read = r.authorizer.Require(domain.PermissionContactRead)
+	write = r.authorizer.Require(domain.PermissionContactWrite)
+	resources.Get("/:id/contacts", read, r.handlers.Contact.FindContacts)
+	resources.Post("/:id/contacts/phones", write, r.handlers.Contact.AddPhone)
+	resources.Put("/:id/contacts/phones/:contactId", write, r.handlers.Contact.UpdatePhone)
+	resources.Delete("/:id/contacts/phones/:contactId", write, r.handlers.Contact.DeletePhone)
+	resources.Post("/:id/contacts/addresses", write, r.handlers.Contact.AddAddress)
+	resources.Put("/:id/contacts/addresses/:contactId", write, r.handlers.Contact.UpdateAddress)
+	resources.Delete("/:id/contacts/addresses/:contactId", write, r.handlers.Contact.DeleteAddress)
+	resources.Post("/:id/contacts/emergency-contacts", write, r.handlers.Contact.AddEmergencyContact)
+	resources.Put("/:id/contacts/emergency-contacts/:contactId", write, r.handlers.Contact.UpdateEmergencyContact)
+	resources.Delete("/:id/contacts/emergency-contacts/:contactId", write, r.handlers.Contact.DeleteEmergencyContact)
+
+	read = r.authorizer.Require(domain.PermissionDocumentRead)
+	write = r.authorizer.Require(domain.PermissionDocumentWrite)
+	resources.Get("/:id/documents", read, r.handlers.Document.FindDocuments)
+	resources.Post("/:id/documents", write, r.handlers.Document.UploadDocument)
+	resources.Get("/:id/documents/:documentId", read, r.handlers.Document.DownloadDocument)
+	resources.Delete("/:id/documents/:documentId", write, r.handlers.Document.DeleteDocument)
+
+	read = r.authorizer.Require(domain.PermissionReviewRead)
+	write = r.authorizer.Require(domain.PermissionReviewWrite)
+	manage := r.authorizer.Require(domain.PermissionReviewManage)
+	resources.Get("/:id/reviews", read, r.handlers.Review.FindReviews)
+	resources.Post("/:id/reviews", manage, r.handlers.Review.StartReview)
+	resources.Put("/:id/reviews/:reviewId/self-assessment", write, r.handlers.Review.WriteSelfAssessment)
+	resources.Put("/:id/reviews/:reviewId/manager-assessment", write, r.handlers.Review.WriteManagerAssessment)
+	resources.Post("/:id/reviews/:reviewId/submit", write, r.handlers.Review.SubmitReview)
+	resources.Post("/:id/reviews/:reviewId/acknowledge", write, r.handlers.Review.AcknowledgeReview)
+}
+
+func (r *Routes) positionRoutes(prefix string) {
+	resources := r.router.Group(prefix + "/positions")
+	resources.Post("/", r.handlers.Position.CreatePosition)
+	resources.Get("/", r.handlers.Position.FindAllPosition)
+	resources.Get("/:id", r.handlers.Position.FindPositionById)
+	resources.Put("/:id", r.handlers.Position.UpdatePositionById)
+	resources.Delete("/:id", r.handlers.Position.DeletePositionById)
+}
+
+func (r *Routes) leaveRoutes(prefix string) {
+	leaveTypes := r.router.Group(prefix + "/leave-types")
+	leaveTypes.Post("/", r.handlers.Leave.CreateLeaveType)
+	leaveTypes.Get("/", r.handlers.Leave.FindAllLeaveType)
+
+	leaveRequests := r.router.Group(prefix + "/leave-requests")
+	leaveRequests.Post("/:id/approve", r.authorizer.Require(domain.PermissionLeaveDecide), r.handlers.Leave.ApproveLeave)
+	leaveRequests.Post("/:id/reject", r.authorizer.Require(domain.PermissionLeaveDecide), r.handlers.Leave.RejectLeave)
+}
+
+func (r *Routes) calendarRoutes(prefix string) {
+	resources := r.router.Group(prefix + "/calendars")
+	resources.Post("/", r.handlers.Calendar.CreateCalendar)
+	resources.Get("/", r.handlers.Calendar.FindAllCalendar)
+	resources.Get("/:id", r.handlers.Calendar.FindCalendarById)
+	resources.Post("/:id/holidays", r.handlers.Calendar.AddHoliday)
+	resources.Post("/:id/holidays/import", r.handlers.Calendar.ImportHolidays)
+	resources.Get("/:id/working-days", r.handlers.Calendar.CountWorkingDays)
+}
+
+func (r *Routes) reviewRoutes(prefix string) {
+	resources := r.router.Group(prefix + "/review-cycles")
+	resources.Post("/", r.authorizer.Require(domain.PermissionReviewManage), r.handlers.Review.CreateReviewCycle)
+	resources.Get("/", r.authorizer.Require(domain.PermissionReviewRead), r.handlers.Review.FindAllReviewCycle)
+}
+
+func (r *Routes) checklistRoutes(prefix string) {
+	items := r.router.Group(prefix+"/checklist-items", r.authorizer.Require(domain.PermissionChecklistManage))
+	items.Post("/", r.handlers.Checklist.CreateChecklistItem)
+	items.Get("/", r.handlers.Checklist.FindChecklistItems)
+	items.Delete("/:id", r.handlers.Checklist.DeleteChecklistItem)
+
+	tasks := r.router.Group(prefix + "/tasks")
+	tasks.Get("/", r.handlers.Checklist.FindOpenTasks)
+}
+
+func (r *Routes) customFieldRoutes(prefix string) {
+	manage := r.authorizer.Require(domain.PermissionCustomFieldManage)
+	resources := r.router.Group(prefix + "/custom-fields")
+	resources.Post("/", manage, r.handlers.CustomField.CreateCustomField)
+	resources.Get("/", r.handlers.CustomField.FindAllCustomField)
+	resources.Delete("/:id", manage, r.handlers.CustomField.DeleteCustomField)
+}
+
+func (r *Routes) webhookRoutes(prefix string) {
+	resources := r.router.Group(prefix+"/webhooks", r.authorizer.Require(domain.PermissionWebhookManage))
+	resources.Post("/", r.handlers.Webhook.CreateWebhook)
+	resources.Get("/", r.handlers.Webhook.FindAllWebhook)
+	resources.Get("/:id", r.handlers.Webhook.FindWebhookById)
+	resources.Put("/:id", r.handlers.Webhook.UpdateWebhook)
+	resources.Delete("/:id", r.handlers.Webhook.DeleteWebhook)
+	resources.Get("/:id/deliveries", r.handlers.Webhook.FindDeliveries)
+	resources.Post("/:id/deliveries/:deliveryId/redeliver", r.handlers.Webhook.Redeliver)
+}
+
+func (r *Routes) graphQLRoutes(prefix string) {
+	r.router.Post(prefix+"/graphql", r.handlers.GraphQL.Execute)
+}
+
+// StreamsBody selects the upload routes, they read their body as it arrives
+// and are not bound by the body limit.
+func StreamsBody(prefix string) func(ctx *fiber.Ctx) bool {
+	uploads := regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + `/employees/\d+/(documents|photo)/?$`)
+	return func(ctx *fiber.Ctx) bool {
+		return (ctx.Method() == fiber.MethodPost || ctx.Method() == fiber.MethodPut) && uploads.MatchString(ctx.Path())
+	}
 }
 
 func (r *Routes) Init(prefix string) {
+	r.healthRoutes()
 	r.employeeRoutes(prefix)
+	r.positionRoutes(prefix)
+	r.leaveRoutes(prefix)
+	r.calendarRoutes(prefix)
+	r.reviewRoutes(prefix)
+	r.checklistRoutes(prefix)
+	r.customFieldRoutes(prefix)
+	r.webhookRoutes(prefix)
+	r.graphQLRoutes(prefix)
 }
@@ -0,0 +1,282 @@
+// Package search is an in-memory full-text index for databases without
+// trigram support. It follows the matching of the Postgres search: a document
+// matches when every term is the prefix of one of its words, or when the
+// terms are similar enough to its words by trigrams to tolerate typos.
+package search
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// SimilarityThreshold is the trigram similarity a word needs to match a term
+// it is not prefixed by, low enough for one typo in a short name.
+const SimilarityThreshold = 0.3
+
+// prefixBonus is added to the score of documents matching every term as a
+// prefix so they rank above typo matches of the same similarity.
+const prefixBonus = 0.1
+
+const (
+	markStart = "<mark>"
+	markEnd   = "</mark>"
+)
+
+// Match is a matching document. Highlights holds its fields in the order they
+// were put, with the matching words wrapped in <mark>.
+type Match struct {
+	ID         uint
+	Highlights []string
+	Score      float64
+}
+
+type word struct {
+	text       string
+	start, end int
+	trigrams   map[string]struct{}
+}
+
+type document struct {
+	fields []string
+	words  [][]word
+}
+
+// Index is safe for concurrent use.
+type Index struct {
+	mu        sync.RWMutex
+	documents map[uint]document
+	postings  map[string]map[uint]struct{}
+}
+
+func NewIndex() *Index {
+	return &Index{
+		documents: make(map[uint]document),
+		postings:  make(map[string]map[uint]struct{}),
+	}
+}
+
+// Put indexes fields as the document with id, replacing what was indexed for
+// it before.
+func (idx *Index) Put(id uint, fields ...string) {
+	doc := document{fields: fields}
+	for _, field := range fields {
+		doc.words = append(doc.words, splitWords(field))
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(id)
+	idx.documents[id] = doc
+	for _, words := range doc.words {
+		for _, w := range words {
+			for trigram := range w.trigrams {
+				if idx.postings[trigram] == nil {
+					idx.postings[trigram] = make(map[uint]struct{})
+				}
+				idx.postings[trigram][id] = struct{}{}
+			}
+		}
+	}
+}
+
+func (idx *Index) Delete(id uint) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id uint) {
+	doc, ok := idx.documents[id]
+	if !ok {
+		return
+	}
+
+	for _, words := range doc.words {
+		for _, w := range words {
+			for trigram := range w.trigrams {
+				delete(idx.postings[trigram], id)
+				if len(idx.postings[trigram]) == 0 {
+					delete(idx.postings, trigram)
+				}
+			}
+		}
+	}
+
+	delete(idx.documents, id)
+}
+
+// Search returns at most limit documents matching terms, the lowercased words
+// of the query, by descending score.
+func (idx *Index) Search(terms []string, limit int) []Match {
+	if len(terms) == 0 || limit <= 0 {
+		return nil
+	}
+
+	termTrigrams := make([]map[string]struct{}, len(terms))
+	for i, term := range terms {
+		termTrigrams[i] = trigrams(term)
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// Every word a term is the prefix of or is similar to shares at least one
+	// trigram with it, so the postings of the trigrams of the terms hold all
+	// the candidates.
+	candidates := make(map[uint]struct{})
+	for _, set := range termTrigrams {
+		for trigram := range set {
+			for id := range idx.postings[trigram] {
+				candidates[id] = struct{}{}
+			}
+		}
+	}
+
+	var matches []Match
+	for id := range candidates {
+		doc := idx.documents[id]
+
+		prefixed := true
+		similarity := 0.0
+		for i, term := range terms {
+			hasPrefix, best := false, 0.0
+			for _, words := range doc.words {
+				for _, w := range words {
+					hasPrefix = hasPrefix || strings.HasPrefix(w.text, term)
+					if s := jaccard(termTrigrams[i], w.trigrams); s > best {
+						best = s
+					}
+				}
+			}
+
+			prefixed = prefixed && hasPrefix
+			similarity += best
+		}
+		similarity /= float64(len(terms))
+
+		if !prefixed && similarity < SimilarityThreshold {
+			continue
+		}
+
+		score := similarity
+		if prefixed {
+			score += prefixBonus
+		}
+
+		matches = append(matches, Match{
+			ID:         id,
+			Highlights: doc.highlight(terms, termTrigrams),
+			Score:      score,
+		})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
+
+// highlight marks the words a term is the prefix of or is similar to.
+func (d document) highlight(terms []string, termTrigrams []map[string]struct{}) []string {
+	highlights := make([]string, len(d.fields))
+	for i, field := range d.fields {
+		var b strings.Builder
+		last := 0
+		for _, w := range d.words[i] {
+			if !w.matches(terms, termTrigrams) {
+				continue
+			}
+
+			b.WriteString(field[last:w.start])
+			b.WriteString(markStart)
+			b.WriteString(field[w.start:w.end])
+			b.WriteString(markEnd)
+			last = w.end
+		}
+		b.WriteString(field[last:])
+
+		highlights[i] = b.String()
+	}
+
+	return highlights
+}
+
+func (w word) matches(terms []string, termTrigrams []map[string]struct{}) bool {
+	for i, term := range terms {
+		if strings.HasPrefix(w.text, term) || jaccard(termTrigrams[i], w.trigrams) >= SimilarityThreshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitWords splits s on everything but letters and digits, the way the
+// search terms are split.
+func splitWords(s string) []word {
+	var words []word
+	start := -1
+	for i, r := range s {
+		isWordRune := unicode.IsLetter(r) || unicode.IsDigit(r)
+		if isWordRune && start < 0 {
+			start = i
+		}
+
+		if !isWordRune && start >= 0 {
+			words = append(words, newWord(s, start, i))
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		words = append(words, newWord(s, start, len(s)))
+	}
+
+	return words
+}
+
+func newWord(s string, start, end int) word {
+	text := strings.ToLower(s[start:end])
+	return word{text: text, start: start, end: end, trigrams: trigrams(text)}
+}
+
+// trigrams returns the trigrams of a word padded like pg_trgm does, two
+// spaces before and one after, so short words and word starts count.
+func trigrams(text string) map[string]struct{} {
+	runes := []rune("  " + text + " ")
+	set := make(map[string]struct{}, len(runes)-2)
+	for i := 0; i+3 <= len(runes); i++ {
+		set[string(runes[i:i+3])] = struct{}{}
+	}
+
+	return set
+}
+
+// jaccard is the share of the trigrams of a and b they have in common.
+func jaccard(a, b map[string]struct{}) float64 {
+	shared := 0
+	for trigram := range a {
+		if _, ok := b[trigram]; ok {
+			shared++
+		}
+	}
+
+	total := len(a) + len(b) - shared
+	if total == 0 {
+		return 0
+	}
+
+	return float64(shared) / float64(total)
+}
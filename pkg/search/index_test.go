@@ -0,0 +1,73 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexSearch(t *testing.T) {
+	newIndex := func() *Index {
+		idx := NewIndex()
+		idx.Put(1, "Jane Doe", "jane.doe@example.com")
+		idx.Put(2, "John Smith", "john@example.com")
+		idx.Put(3, "Janet Dorsey", "janet@example.com")
+		return idx
+	}
+
+	t.Run("Test Search prefixes of every term", func(t *testing.T) {
+		matches := newIndex().Search([]string{"jan", "do"}, 10)
+
+		assert.Len(t, matches, 2)
+		assert.Equal(t, uint(1), matches[0].ID)
+		assert.Equal(t, uint(3), matches[1].ID)
+		assert.Equal(t, []string{"<mark>Jane</mark> <mark>Doe</mark>", "<mark>jane</mark>.<mark>doe</mark>@example.com"}, matches[0].Highlights)
+		assert.Equal(t, []string{"<mark>Janet</mark> <mark>Dorsey</mark>", "<mark>janet</mark>@example.com"}, matches[1].Highlights)
+	})
+
+	t.Run("Test Search tolerates a typo", func(t *testing.T) {
+		matches := newIndex().Search([]string{"smiht"}, 10)
+
+		assert.Len(t, matches, 1)
+		assert.Equal(t, uint(2), matches[0].ID)
+		assert.Equal(t, "John <mark>Smith</mark>", matches[0].Highlights[0])
+		assert.Greater(t, matches[0].Score, SimilarityThreshold)
+	})
+
+	t.Run("Test Search ranks prefix matches above typos", func(t *testing.T) {
+		matches := newIndex().Search([]string{"janne"}, 10)
+
+		assert.Len(t, matches, 2)
+		for _, m := range matches {
+			assert.Less(t, m.Score, 1.0)
+		}
+
+		matches = newIndex().Search([]string{"jane"}, 10)
+		assert.Equal(t, uint(1), matches[0].ID)
+		assert.Greater(t, matches[0].Score, 1.0)
+	})
+
+	t.Run("Test Search limit", func(t *testing.T) {
+		matches := newIndex().Search([]string{"example"}, 2)
+
+		assert.Len(t, matches, 2)
+		assert.Equal(t, uint(1), matches[0].ID)
+		assert.Equal(t, uint(2), matches[1].ID)
+	})
+
+	t.Run("Test Search no match", func(t *testing.T) {
+		assert.Empty(t, newIndex().Search([]string{"zzz"}, 10))
+	})
+
+	t.Run("Test Put replaces and Delete removes", func(t *testing.T) {
+		idx := newIndex()
+		idx.Put(2, "Jon Snow", "jon@example.com")
+		idx.Delete(1)
+
+		assert.Empty(t, idx.Search([]string{"smith"}, 10))
+
+		matches := idx.Search([]string{"jan"}, 10)
+		assert.Len(t, matches, 1)
+		assert.Equal(t, uint(3), matches[0].ID)
+	})
+}
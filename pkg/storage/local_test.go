@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorage(t *testing.T) {
+	s := NewLocal(t.TempDir())
+
+	t.Run("put open and delete", func(t *testing.T) {
+		err := s.Put("employees/1/contract", strings.NewReader("signed"), 6, "application/pdf")
+		assert.NoError(t, err)
+
+		r, err := s.Open("employees/1/contract")
+		assert.NoError(t, err)
+		content, _ := io.ReadAll(r)
+		r.Close()
+		assert.Equal(t, "signed", string(content))
+
+		assert.NoError(t, s.Delete("employees/1/contract"))
+		assert.NoError(t, s.Delete("employees/1/contract"))
+
+		_, err = s.Open("employees/1/contract")
+		assert.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		for _, key := range []string{"", "/etc/passwd", "employees/../../etc", "employees//1", `employees\1`} {
+			err := s.Put(key, strings.NewReader("x"), 1, "")
+			assert.ErrorIs(t, err, ErrInvalidKey, key)
+		}
+	})
+}
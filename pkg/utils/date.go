@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ISODate is the layout of dates in responses, it is always accepted as
+// input.
+const ISODate = "2006-01-02"
+
+var (
+	dateLayouts atomic.Value
+	timeZone    atomic.Value
+)
+
+func init() {
+	dateLayouts.Store([]string{})
+	timeZone.Store(time.UTC)
+}
+
+// SetDateLayouts replaces the layouts ParseDateString accepts besides
+// ISODate, they are tried in order.
+func SetDateLayouts(layouts []string) {
+	dateLayouts.Store(layouts)
+}
+
+// SetTimeZone sets the time zone of the business, it decides which date
+// today is.
+func SetTimeZone(loc *time.Location) {
+	timeZone.Store(loc)
+}
+
+// ParseDateString parses a calendar date. Dates carry no time zone, they are
+// returned as midnight UTC so they never shift to the day before or after.
+func ParseDateString(dateString string) (time.Time, error) {
+	parsedTime, err := time.Parse(ISODate, dateString)
+	if err == nil {
+		return parsedTime, nil
+	}
+
+	for _, layout := range dateLayouts.Load().([]string) {
+		if parsed, layoutErr := time.Parse(layout, dateString); layoutErr == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// CivilDate drops the time and time zone of t and keeps its calendar date,
+// as midnight UTC. Drivers may return dates at midnight of the session time
+// zone, which would print as the day before.
+func CivilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// Today returns the current date in the business time zone as midnight UTC,
+// comparable with dates from ParseDateString and the database.
+func Today() time.Time {
+	return DateOf(time.Now())
+}
+
+// DateOf returns the date of the instant t in the business time zone as
+// midnight UTC.
+func DateOf(t time.Time) time.Time {
+	return CivilDate(t.In(timeZone.Load().(*time.Location)))
+}
+
+// CountWeekdays returns the number of days from start to end, both
+// inclusive, that fall on Monday to Friday.
+func CountWeekdays(start, end time.Time) int {
+	days := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			days++
+		}
+	}
+
+	return days
+}
@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountWeekdays(t *testing.T) {
+	t.Run("skips weekends", func(t *testing.T) {
+		start, _ := ParseDateString("2024-05-02")
+		end, _ := ParseDateString("2024-05-08")
+
+		assert.Equal(t, 5, CountWeekdays(start, end))
+	})
+
+	t.Run("weekend only", func(t *testing.T) {
+		start, _ := ParseDateString("2024-05-04")
+		end, _ := ParseDateString("2024-05-05")
+
+		assert.Equal(t, 0, CountWeekdays(start, end))
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		start, _ := ParseDateString("2024-05-08")
+		end, _ := ParseDateString("2024-05-02")
+
+		assert.Equal(t, 0, CountWeekdays(start, end))
+	})
+}
+
+func TestParseDateStringLayouts(t *testing.T) {
+	SetDateLayouts([]string{"02/01/2006", "2.1.2006"})
+	defer SetDateLayouts([]string{})
+
+	expected := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	for _, s := range []string{"2024-03-05", "05/03/2024", "5.3.2024"} {
+		d, err := ParseDateString(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, d, s)
+	}
+
+	_, err := ParseDateString("03/05/24")
+	assert.Error(t, err)
+}
+
+func TestCivilDate(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	d := time.Date(2024, 3, 5, 0, 0, 0, 0, jakarta)
+
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), CivilDate(d))
+}
+
+func TestDateOf(t *testing.T) {
+	defer SetTimeZone(time.UTC)
+
+	SetTimeZone(time.FixedZone("WIB", 7*60*60))
+	instant := time.Date(2024, 3, 5, 20, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC), DateOf(instant))
+}
+
+func TestToday(t *testing.T) {
+	defer SetTimeZone(time.UTC)
+
+	for _, loc := range []*time.Location{time.FixedZone("east", 14*60*60), time.FixedZone("west", -12*60*60)} {
+		SetTimeZone(loc)
+		y, m, d := time.Now().In(loc).Date()
+
+		assert.Equal(t, time.Date(y, m, d, 0, 0, 0, 0, time.UTC), Today())
+	}
+}
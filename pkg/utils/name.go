@@ -0,0 +1,152 @@
+package utils
+
+import (
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Casing policies for person names. Smart fixes names typed in all lower or
+// all upper case and leaves mixed case names such as "McDonald" alone.
+const (
+	NameCasingSmart    = "smart"
+	NameCasingTitle    = "title"
+	NameCasingPreserve = "preserve"
+)
+
+const maxNameLength = 100
+
+var nameCasing atomic.Value
+
+func init() {
+	nameCasing.Store(NameCasingSmart)
+}
+
+// nameParticles stay lower case in smart casing unless they are the whole
+// name, "van der berg" becomes "van der Berg".
+var nameParticles = map[string]bool{
+	"al": true, "bin": true, "binti": true, "da": true, "de": true, "del": true, "della": true,
+	"der": true, "di": true, "dos": true, "du": true, "la": true, "le": true, "ten": true,
+	"ter": true, "van": true, "von": true,
+}
+
+// SetNameCasing replaces the casing policy, unknown policies fall back to
+// smart.
+func SetNameCasing(policy string) {
+	if policy != NameCasingTitle && policy != NameCasingPreserve {
+		policy = NameCasingSmart
+	}
+
+	nameCasing.Store(policy)
+}
+
+// isNamePunctuation reports apostrophes and hyphens, which may join the
+// letters of a name.
+func isNamePunctuation(r rune) bool {
+	switch r {
+	case '\'', '’', '-', '‐':
+		return true
+	}
+
+	return false
+}
+
+// isPersonName accepts words of Unicode letters and combining marks, joined
+// by single apostrophes or hyphens. Words start and end with a letter.
+func isPersonName(name string) bool {
+	for _, word := range strings.Fields(name) {
+		prev := ' '
+		for i, r := range word {
+			switch {
+			case unicode.IsLetter(r):
+			case unicode.IsMark(r):
+				if i == 0 || isNamePunctuation(prev) {
+					return false
+				}
+			case isNamePunctuation(r):
+				if i == 0 || isNamePunctuation(prev) {
+					return false
+				}
+			default:
+				return false
+			}
+
+			prev = r
+		}
+
+		if isNamePunctuation(prev) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sanitizePersonName normalizes a given, family or preferred name to NFC,
+// collapses spaces and applies the casing policy. An empty name is returned
+// as is, callers decide whether it is required.
+func sanitizePersonName(name string) (string, error) {
+	words := strings.Fields(norm.NFC.String(name))
+	if len(words) == 0 {
+		return "", nil
+	}
+
+	name = strings.Join(words, " ")
+	if !isPersonName(name) {
+		return "", ErrInvalidName
+	}
+
+	if utf8.RuneCountInString(name) > maxNameLength {
+		return "", ErrNameTooLong
+	}
+
+	switch nameCasing.Load().(string) {
+	case NameCasingPreserve:
+		return name, nil
+	case NameCasingTitle:
+		for i, word := range words {
+			words[i] = cases.Title(language.Und, cases.NoLower).String(word)
+		}
+	default:
+		for i, word := range words {
+			words[i] = smartCase(word, len(words) > 1)
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+func smartCase(word string, hasOtherWords bool) string {
+	lower := strings.ToLower(word)
+	if word != lower && word != strings.ToUpper(word) {
+		return word
+	}
+
+	if hasOtherWords && nameParticles[lower] {
+		return lower
+	}
+
+	// Every part after an apostrophe or hyphen starts upper case, as in
+	// "O'Brien" and "Anne-Marie".
+	var b strings.Builder
+	upper := true
+	for _, r := range lower {
+		if upper && unicode.IsLetter(r) {
+			r = unicode.ToTitle(r)
+			upper = false
+		}
+
+		if isNamePunctuation(r) {
+			upper = true
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/RuhullahReza/Employee-App/app/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizePersonName(t *testing.T) {
+	t.Run("smart casing", func(t *testing.T) {
+		cases := map[string]string{
+			"josé":            "José",
+			"ZOË":             "Zoë",
+			"o'brien":         "O'Brien",
+			"anne-marie":      "Anne-Marie",
+			"nguyễn văn an":   "Nguyễn Văn An",
+			"van der Berg":    "van der Berg",
+			"van":             "Van",
+			"McDonald":        "McDonald",
+			"  siti   aminah": "Siti Aminah",
+			"d’angelo":        "D’Angelo",
+		}
+
+		for input, want := range cases {
+			got, err := sanitizePersonName(input)
+			assert.NoError(t, err, input)
+			assert.Equal(t, want, got, input)
+		}
+	})
+
+	t.Run("normalizes to NFC", func(t *testing.T) {
+		got, err := sanitizePersonName("Jose\u0301")
+		assert.NoError(t, err)
+		assert.Equal(t, "Jos\u00e9", got)
+	})
+
+	t.Run("title casing", func(t *testing.T) {
+		SetNameCasing(NameCasingTitle)
+		defer SetNameCasing(NameCasingSmart)
+
+		got, err := sanitizePersonName("van der berg")
+		assert.NoError(t, err)
+		assert.Equal(t, "Van Der Berg", got)
+	})
+
+	t.Run("preserve casing", func(t *testing.T) {
+		SetNameCasing(NameCasingPreserve)
+		defer SetNameCasing(NameCasingSmart)
+
+		got, err := sanitizePersonName("bell hooks")
+		assert.NoError(t, err)
+		assert.Equal(t, "bell hooks", got)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := sanitizePersonName("   ")
+		assert.NoError(t, err)
+		assert.Equal(t, "", got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, input := range []string{"ozza 1", "-anne", "anne-", "o''brien", "́a", "a_b", "<b>"} {
+			_, err := sanitizePersonName(input)
+			assert.ErrorIs(t, err, ErrInvalidName, input)
+		}
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		_, err := sanitizePersonName(strings.Repeat("é", maxNameLength+1))
+		assert.ErrorIs(t, err, ErrNameTooLong)
+	})
+}
+
+func TestValidateAndSanitizeRequestMononym(t *testing.T) {
+	req := domain.EmployeeRequest{FirstName: "sukarno", Email: "sukarno@gmail.com"}
+
+	err := ValidateAndSanitizeRequest(&req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Sukarno", req.FirstName)
+	assert.Equal(t, "", req.LastName)
+}
+
+func TestValidateAndSanitizeRequestPreferences(t *testing.T) {
+	str := func(s string) *string { return &s }
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.EmployeeRequest{
+			FirstName:     "elizabeth",
+			LastName:      "smith",
+			PreferredName: str(" liz "),
+			Pronouns:      str(" she /  her "),
+			NameLocale:    str("en-us"),
+			Email:         "liz@gmail.com",
+		}
+
+		err := ValidateAndSanitizeRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Liz", *req.PreferredName)
+		assert.Equal(t, "she / her", *req.Pronouns)
+		assert.Equal(t, "en-US", *req.NameLocale)
+	})
+
+	t.Run("empty values clear", func(t *testing.T) {
+		req := domain.EmployeeRequest{FirstName: "Liz", PreferredName: str(" "), Pronouns: str(""), NameLocale: str(""), Email: "liz@gmail.com"}
+
+		err := ValidateAndSanitizeRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "", *req.PreferredName)
+	})
+
+	t.Run("invalid pronouns", func(t *testing.T) {
+		for _, pronouns := range []string{"she/", "<b>", strings.Repeat("they/", 7) + "them"} {
+			req := domain.EmployeeRequest{FirstName: "Liz", Pronouns: str(pronouns), Email: "liz@gmail.com"}
+			assert.ErrorIs(t, ValidateAndSanitizeRequest(&req), ErrInvalidPronouns, pronouns)
+		}
+	})
+
+	t.Run("invalid locale", func(t *testing.T) {
+		req := domain.EmployeeRequest{FirstName: "Liz", NameLocale: str("not a locale"), Email: "liz@gmail.com"}
+		assert.ErrorIs(t, ValidateAndSanitizeRequest(&req), ErrInvalidNameLocale)
+	})
+
+	t.Run("invalid preferred name", func(t *testing.T) {
+		req := domain.EmployeeRequest{FirstName: "Liz", PreferredName: str("L1z"), Email: "liz@gmail.com"}
+		assert.ErrorIs(t, ValidateAndSanitizeRequest(&req), ErrInvalidName)
+	})
+}
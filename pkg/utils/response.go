@@ -48,6 +48,30 @@ func ResponseBadRequest(ctx *fiber.Ctx, msg string) error {
 	return JSONWithCode(ctx, fiber.StatusBadRequest, msg, nil)
 }
 
+func ResponseUnauthorized(ctx *fiber.Ctx, msg string) error {
+	return JSONWithCode(ctx, fiber.StatusUnauthorized, msg, nil)
+}
+
+func ResponseForbidden(ctx *fiber.Ctx, msg string) error {
+	return JSONWithCode(ctx, fiber.StatusForbidden, msg, nil)
+}
+
+func ResponseConflict(ctx *fiber.Ctx, msg string) error {
+	return JSONWithCode(ctx, fiber.StatusConflict, msg, nil)
+}
+
+func ResponsePayloadTooLarge(ctx *fiber.Ctx, msg string) error {
+	return JSONWithCode(ctx, fiber.StatusRequestEntityTooLarge, msg, nil)
+}
+
+func ResponseUnsupportedMediaType(ctx *fiber.Ctx, msg string) error {
+	return JSONWithCode(ctx, fiber.StatusUnsupportedMediaType, msg, nil)
+}
+
 func ResponseInternalServerError(ctx *fiber.Ctx, msg string) error {
 	return JSONWithCode(ctx, fiber.StatusInternalServerError, msg, nil)
-}
\ No newline at end of file
+}
+
+func ResponseServiceUnavailable(ctx *fiber.Ctx, msg string) error {
+	return JSONWithCode(ctx, fiber.StatusServiceUnavailable, msg, nil)
+}
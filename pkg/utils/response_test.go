@@ -43,4 +43,34 @@ func TestResponse(t *testing.T) {
 		assert.Equal(t, result, nil)
 	})
 
+	t.Run("ResponseUnauthorized", func(t *testing.T) {
+		result := ResponseUnauthorized(ctx, "unauthorized")
+		assert.Equal(t, result, nil)
+	})
+
+	t.Run("ResponseForbidden", func(t *testing.T) {
+		result := ResponseForbidden(ctx, "forbidden")
+		assert.Equal(t, result, nil)
+	})
+
+	t.Run("ResponseConflict", func(t *testing.T) {
+		result := ResponseConflict(ctx, "conflict")
+		assert.Equal(t, result, nil)
+	})
+
+	t.Run("ResponsePayloadTooLarge", func(t *testing.T) {
+		result := ResponsePayloadTooLarge(ctx, "too large")
+		assert.Equal(t, result, nil)
+	})
+
+	t.Run("ResponseUnsupportedMediaType", func(t *testing.T) {
+		result := ResponseUnsupportedMediaType(ctx, "unsupported")
+		assert.Equal(t, result, nil)
+	})
+
+	t.Run("ResponseServiceUnavailable", func(t *testing.T) {
+		result := ResponseServiceUnavailable(ctx, "not ready")
+		assert.Equal(t, result, nil)
+	})
+
 }
\ No newline at end of file
@@ -2,88 +2,479 @@ package utils
 
 import (
 	"errors"
+	"net/url"
+	"path"
 	"regexp"
 	"strings"
-	"time"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/RuhullahReza/Employee-App/app/domain"
 
-	"golang.org/x/text/cases"
+	"golang.org/x/text/currency"
 	"golang.org/x/text/language"
 )
 
 var (
 	ErrEmptyName    = errors.New("empty name field")
 	ErrInvalidEmail = errors.New("invalid email format")
-	ErrInvalidName  = errors.New("invalid name format")
+	ErrInvalidName  = errors.New("invalid name format, names may only contain letters, spaces, apostrophes and hyphens")
+	ErrNameTooLong  = errors.New("name must not exceed 100 characters")
+	ErrEmptyTitle   = errors.New("empty title field")
+
+	ErrInvalidPronouns   = errors.New("pronouns may only contain letters, spaces and slashes, at most 32 characters, e.g. they/them")
+	ErrInvalidNameLocale = errors.New("name_locale must be a BCP 47 language tag, e.g. ja-JP")
+
+	ErrInvalidSalary       = errors.New("base salary must be a positive amount with at most 2 decimals")
+	ErrInvalidCurrency     = errors.New("currency must be an ISO 4217 code")
+	ErrInvalidPayFrequency = errors.New("invalid pay frequency")
+
+	ErrEmptyCode        = errors.New("empty code field")
+	ErrInvalidLeaveDays = errors.New("annual_days and carry_over_days must not be negative")
+
+	ErrInvalidCountry = errors.New("country must be an ISO 3166 alpha-2 code")
+
+	ErrInvalidGeotag = errors.New("latitude and longitude must be sent together, latitude within -90 and 90, longitude within -180 and 180")
+	ErrNotesTooLong  = errors.New("notes must not exceed 500 characters")
+
+	ErrInvalidRatingScale = errors.New("rating_min must not be negative and must be below rating_max, rating_max must not exceed 100")
+	ErrAssessmentTooLong  = errors.New("assessment must not exceed 10000 characters")
+
+	ErrInvalidChecklistKind = errors.New("kind must be onboarding or offboarding")
+	ErrInvalidDueDays       = errors.New("due_days must be within -365 and 365")
+
+	ErrInvalidPhone       = errors.New("phone number must be in E.164 format, e.g. +6281234567890")
+	ErrInvalidPhoneType   = errors.New("phone type must be mobile, home or work")
+	ErrInvalidAddressType = errors.New("address type must be home or mailing")
+	ErrEmptyAddress       = errors.New("line1 and city must not be empty")
+	ErrEmptyRelationship  = errors.New("empty relationship field")
+
+	ErrInvalidCustomFieldName = errors.New("name must start with a letter and contain only lowercase letters, digits and underscores, at most 63 characters")
+	ErrInvalidCustomFieldType = errors.New("type must be string, number, date, enum or bool")
+	ErrInvalidPattern         = errors.New("pattern must be a valid regular expression and is only allowed for string fields")
+	ErrMissingOptions         = errors.New("enum fields need at least one option")
+
+	ErrInvalidDocumentCategory = errors.New("category must be contract, identity, certificate or other")
+	ErrInvalidFileName         = errors.New("file name must not be empty or exceed 255 characters")
+
+	ErrInvalidWebhookUrl    = errors.New("url must be an absolute http or https URL of at most 2048 characters")
+	ErrInvalidEventTypes    = errors.New("event_types must list at least one of employee.created, employee.updated, employee.deleted")
+	ErrInvalidWebhookSecret = errors.New("secret must be between 16 and 128 characters")
+)
+
+const (
+	maxNotesLength      = 500
+	maxAssessmentLength = 10000
+	maxRating           = 100
+	maxDueDays          = 365
+	maxFileNameLength   = 255
+	maxPronounsLength   = 32
+	maxWebhookUrlLength = 2048
+	minSecretLength     = 16
+	maxSecretLength     = 128
 )
 
+var salaryPattern = regexp.MustCompile(`^\d{1,16}(\.\d{1,2})?$`)
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+
+var pronounsPattern = regexp.MustCompile(`^\pL+( ?/ ?\pL+| \pL+)*$`)
+
+var customFieldNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,62}$`)
+
+// phoneSeparators are stripped from phone numbers before validation.
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+
 func isValidEmail(email string) bool {
 	regex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	return regex.MatchString(email)
 }
 
-func isWhitespace(str string) bool {
-	for _, char := range str {
-		if !unicode.IsSpace(char) {
-			return false
+// isCountryCode accepts ISO 3166 alpha-2 codes.
+func isCountryCode(code string) bool {
+	region, err := language.ParseRegion(code)
+	return err == nil && len(code) == 2 && region.IsCountry()
+}
+
+// ValidateAndSanitizeRequest requires a first name only, employees known by
+// a single name leave last_name empty.
+func ValidateAndSanitizeRequest(req *domain.EmployeeRequest) error {
+	firstName, err := sanitizePersonName(req.FirstName)
+	if err != nil {
+		return err
+	}
+
+	if len(firstName) == 0 {
+		return ErrEmptyName
+	}
+
+	lastName, err := sanitizePersonName(req.LastName)
+	if err != nil {
+		return err
+	}
+
+	if !isValidEmail(req.Email) {
+		return ErrInvalidEmail
+	}
+
+	if req.PreferredName != nil {
+		preferredName, err := sanitizePersonName(*req.PreferredName)
+		if err != nil {
+			return err
 		}
+
+		req.PreferredName = &preferredName
 	}
-	return true
+
+	if req.Pronouns != nil {
+		pronouns := strings.Join(strings.Fields(*req.Pronouns), " ")
+		if pronouns != "" && (!pronounsPattern.MatchString(pronouns) || utf8.RuneCountInString(pronouns) > maxPronounsLength) {
+			return ErrInvalidPronouns
+		}
+
+		req.Pronouns = &pronouns
+	}
+
+	if req.NameLocale != nil {
+		locale := strings.TrimSpace(*req.NameLocale)
+		if locale != "" {
+			tag, err := language.Parse(locale)
+			if err != nil {
+				return ErrInvalidNameLocale
+			}
+
+			locale = tag.String()
+		}
+
+		req.NameLocale = &locale
+	}
+
+	req.FirstName = firstName
+	req.LastName = lastName
+
+	return nil
 }
 
-func isAlphaAndSpace(str string) bool {
-	pattern := "^[a-zA-Z ]+$"
+func ValidateAndSanitizePositionRequest(req *domain.PositionRequest) error {
+	req.Title = strings.Join(strings.Fields(req.Title), " ")
+	req.Department = strings.TrimSpace(req.Department)
+	req.Grade = strings.TrimSpace(req.Grade)
+	req.Description = strings.TrimSpace(req.Description)
 
-	regex := regexp.MustCompile(pattern)
-	return regex.MatchString(str)
+	if len(req.Title) == 0 {
+		return ErrEmptyTitle
+	}
+
+	return nil
 }
 
-func sanitizeName(name string) string {
-	nameList := strings.Split(name, " ")
-	var trimedNameList []string
-	for _, s := range nameList {
-		if isWhitespace(s) {
-			continue
-		}
+func ValidateAndSanitizeCompensationRequest(req *domain.CompensationRequest) error {
+	req.BaseSalary = strings.TrimSpace(req.BaseSalary)
+	req.Currency = strings.ToUpper(strings.TrimSpace(req.Currency))
+	req.PayFrequency = strings.ToLower(strings.TrimSpace(req.PayFrequency))
+	req.Reason = strings.TrimSpace(req.Reason)
+
+	if !salaryPattern.MatchString(req.BaseSalary) || strings.Trim(req.BaseSalary, "0.") == "" {
+		return ErrInvalidSalary
+	}
+
+	if _, err := currency.ParseISO(req.Currency); err != nil || len(req.Currency) != 3 {
+		return ErrInvalidCurrency
+	}
 
-		cap := cases.Title(language.Und, cases.NoLower).String(s)
-		trimedNameList = append(trimedNameList, cap)
+	if !domain.IsValidPayFrequency(req.PayFrequency) {
+		return ErrInvalidPayFrequency
 	}
 
-	return strings.Join(trimedNameList, " ")
+	return nil
 }
 
-func ValidateAndSanitizeRequest(req *domain.EmployeeRequest) error {
-	firstName := strings.TrimSpace(req.FirstName)
-	lastName := strings.TrimSpace(req.LastName)
+func ValidateAndSanitizeLeaveTypeRequest(req *domain.LeaveTypeRequest) error {
+	req.Code = strings.ToLower(strings.TrimSpace(req.Code))
+	req.Name = strings.Join(strings.Fields(req.Name), " ")
 
-	if len(firstName) == 0 || len(lastName) == 0 {
+	if len(req.Code) == 0 {
+		return ErrEmptyCode
+	}
+
+	if len(req.Name) == 0 {
 		return ErrEmptyName
 	}
 
-	if !isAlphaAndSpace(firstName) || !isAlphaAndSpace(lastName) {
-		return ErrInvalidName
+	if req.AnnualDays < 0 || req.CarryOverDays < 0 {
+		return ErrInvalidLeaveDays
 	}
 
-	if !isValidEmail(req.Email) {
-		return ErrInvalidEmail
+	return nil
+}
+
+func ValidateAndSanitizeCalendarRequest(req *domain.WorkCalendarRequest) error {
+	req.Name = strings.Join(strings.Fields(req.Name), " ")
+	req.Country = strings.ToUpper(strings.TrimSpace(req.Country))
+	req.Region = strings.TrimSpace(req.Region)
+
+	if len(req.Name) == 0 {
+		return ErrEmptyName
+	}
+
+	if req.Country != "" && !isCountryCode(req.Country) {
+		return ErrInvalidCountry
+	}
+
+	if len(req.WorkingDays) == 0 {
+		req.WorkingDays = []string{"mon", "tue", "wed", "thu", "fri"}
+	}
+
+	return nil
+}
+
+func ValidateAndSanitizeAttendanceRequest(req *domain.AttendanceRequest) error {
+	req.Notes = strings.TrimSpace(req.Notes)
+
+	if (req.Latitude == nil) != (req.Longitude == nil) {
+		return ErrInvalidGeotag
+	}
+
+	if req.Latitude != nil && (*req.Latitude < -90 || *req.Latitude > 90 || *req.Longitude < -180 || *req.Longitude > 180) {
+		return ErrInvalidGeotag
 	}
 
-	req.FirstName = sanitizeName(firstName)
-	req.LastName = sanitizeName(lastName)
+	if len([]rune(req.Notes)) > maxNotesLength {
+		return ErrNotesTooLong
+	}
 
 	return nil
 }
 
-func ParseDateString(dateString string) (time.Time, error) {
-	layout := "2006-01-02"
+// ValidateAndSanitizeReviewCycleRequest defaults the rating scale to 1-5.
+func ValidateAndSanitizeReviewCycleRequest(req *domain.ReviewCycleRequest) error {
+	req.Name = strings.Join(strings.Fields(req.Name), " ")
+
+	if len(req.Name) == 0 {
+		return ErrEmptyName
+	}
+
+	if req.RatingMin == 0 && req.RatingMax == 0 {
+		req.RatingMin, req.RatingMax = 1, 5
+	}
+
+	if req.RatingMin < 0 || req.RatingMin >= req.RatingMax || req.RatingMax > maxRating {
+		return ErrInvalidRatingScale
+	}
+
+	return nil
+}
+
+func ValidateAndSanitizeAssessmentRequest(req *domain.AssessmentRequest) error {
+	req.Assessment = strings.TrimSpace(req.Assessment)
+
+	if len([]rune(req.Assessment)) > maxAssessmentLength {
+		return ErrAssessmentTooLong
+	}
 
-	parsedTime, err := time.Parse(layout, dateString)
+	return nil
+}
+
+func ValidateAndSanitizeChecklistItemRequest(req *domain.ChecklistItemRequest) error {
+	req.Kind = strings.ToLower(strings.TrimSpace(req.Kind))
+	req.Title = strings.Join(strings.Fields(req.Title), " ")
+	req.Description = strings.TrimSpace(req.Description)
+
+	if !domain.IsValidChecklistKind(req.Kind) {
+		return ErrInvalidChecklistKind
+	}
+
+	if len(req.Title) == 0 {
+		return ErrEmptyTitle
+	}
+
+	if req.DueDays < -maxDueDays || req.DueDays > maxDueDays {
+		return ErrInvalidDueDays
+	}
+
+	return nil
+}
+
+func ValidateAndSanitizePhoneRequest(req *domain.PhoneRequest) error {
+	req.Type = strings.ToLower(strings.TrimSpace(req.Type))
+	req.Number = phoneSeparators.Replace(strings.TrimSpace(req.Number))
+
+	if req.Type == "" {
+		req.Type = domain.PhoneTypeMobile
+	}
+
+	if !domain.IsValidPhoneType(req.Type) {
+		return ErrInvalidPhoneType
+	}
+
+	if !e164Pattern.MatchString(req.Number) {
+		return ErrInvalidPhone
+	}
+
+	return nil
+}
+
+func ValidateAndSanitizeAddressRequest(req *domain.AddressRequest) error {
+	req.Type = strings.ToLower(strings.TrimSpace(req.Type))
+	req.Line1 = strings.Join(strings.Fields(req.Line1), " ")
+	req.Line2 = strings.Join(strings.Fields(req.Line2), " ")
+	req.City = strings.Join(strings.Fields(req.City), " ")
+	req.Region = strings.Join(strings.Fields(req.Region), " ")
+	req.PostalCode = strings.ToUpper(strings.TrimSpace(req.PostalCode))
+	req.Country = strings.ToUpper(strings.TrimSpace(req.Country))
+
+	if !domain.IsValidAddressType(req.Type) {
+		return ErrInvalidAddressType
+	}
+
+	if len(req.Line1) == 0 || len(req.City) == 0 {
+		return ErrEmptyAddress
+	}
+
+	if !isCountryCode(req.Country) {
+		return ErrInvalidCountry
+	}
+
+	return nil
+}
+
+func ValidateAndSanitizeEmergencyContactRequest(req *domain.EmergencyContactRequest) error {
+	name, err := sanitizePersonName(req.Name)
 	if err != nil {
-		return time.Time{}, err
+		return err
+	}
+
+	req.Relationship = strings.ToLower(strings.Join(strings.Fields(req.Relationship), " "))
+	req.Phone = phoneSeparators.Replace(strings.TrimSpace(req.Phone))
+	req.Email = strings.TrimSpace(req.Email)
+
+	if len(name) == 0 {
+		return ErrEmptyName
+	}
+
+	if len(req.Relationship) == 0 {
+		return ErrEmptyRelationship
+	}
+
+	if !e164Pattern.MatchString(req.Phone) {
+		return ErrInvalidPhone
+	}
+
+	if req.Email != "" && !isValidEmail(req.Email) {
+		return ErrInvalidEmail
+	}
+
+	req.Name = name
+	return nil
+}
+
+// ValidateAndSanitizeCustomFieldRequest drops options from non enum fields
+// and removes duplicate options.
+func ValidateAndSanitizeCustomFieldRequest(req *domain.CustomFieldRequest) error {
+	req.Name = strings.ToLower(strings.TrimSpace(req.Name))
+	req.Label = strings.Join(strings.Fields(req.Label), " ")
+	req.Type = strings.ToLower(strings.TrimSpace(req.Type))
+
+	if !customFieldNamePattern.MatchString(req.Name) {
+		return ErrInvalidCustomFieldName
+	}
+
+	if !domain.IsValidCustomFieldType(req.Type) {
+		return ErrInvalidCustomFieldType
+	}
+
+	if req.Pattern != "" {
+		if _, err := regexp.Compile(req.Pattern); err != nil || req.Type != domain.CustomFieldString {
+			return ErrInvalidPattern
+		}
+	}
+
+	if req.Type != domain.CustomFieldEnum {
+		req.Options = nil
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	var options []string
+	for _, option := range req.Options {
+		option = strings.TrimSpace(option)
+		if option == "" || seen[option] {
+			continue
+		}
+
+		seen[option] = true
+		options = append(options, option)
+	}
+
+	if len(options) == 0 {
+		return ErrMissingOptions
+	}
+
+	req.Options = options
+	return nil
+}
+
+// ValidateAndSanitizeDocumentRequest defaults the category to other and keeps
+// only the base name of the file, without control characters.
+func ValidateAndSanitizeDocumentRequest(req *domain.DocumentRequest) error {
+	req.Category = strings.ToLower(strings.TrimSpace(req.Category))
+	if req.Category == "" {
+		req.Category = domain.DocumentOther
 	}
 
-	return parsedTime, nil
+	if !domain.IsValidDocumentCategory(req.Category) {
+		return ErrInvalidDocumentCategory
+	}
+
+	name := path.Base(strings.ReplaceAll(req.FileName, `\`, "/"))
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, name))
+
+	if name == "" || name == "." || name == "/" || len([]rune(name)) > maxFileNameLength {
+		return ErrInvalidFileName
+	}
+
+	req.FileName = name
+	return nil
+}
+
+// ValidateAndSanitizeWebhookRequest drops duplicate event types, an empty
+// secret is allowed and generated or kept by the usecase.
+func ValidateAndSanitizeWebhookRequest(req *domain.WebhookRequest) error {
+	req.Url = strings.TrimSpace(req.Url)
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(req.Url) > maxWebhookUrlLength {
+		return ErrInvalidWebhookUrl
+	}
+
+	seen := make(map[string]bool)
+	var eventTypes []string
+	for _, eventType := range req.EventTypes {
+		eventType = strings.ToLower(strings.TrimSpace(eventType))
+		if !domain.IsValidEventType(eventType) {
+			return ErrInvalidEventTypes
+		}
+
+		if !seen[eventType] {
+			seen[eventType] = true
+			eventTypes = append(eventTypes, eventType)
+		}
+	}
+
+	if len(eventTypes) == 0 {
+		return ErrInvalidEventTypes
+	}
+
+	req.EventTypes = eventTypes
+
+	if req.Secret != "" && (len(req.Secret) < minSecretLength || len(req.Secret) > maxSecretLength) {
+		return ErrInvalidWebhookSecret
+	}
+
+	return nil
 }
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -78,3 +79,435 @@ func TestParseDateString(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestValidateAndSanitizePositionRequest(t *testing.T) {
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.PositionRequest{
+			Title:      "  Senior   Engineer ",
+			Department: " Engineering ",
+		}
+
+		err := ValidateAndSanitizePositionRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Senior Engineer", req.Title)
+		assert.Equal(t, "Engineering", req.Department)
+	})
+
+	t.Run("empty title", func(t *testing.T) {
+		req := domain.PositionRequest{Title: "   "}
+
+		err := ValidateAndSanitizePositionRequest(&req)
+		assert.ErrorIs(t, err, ErrEmptyTitle)
+	})
+}
+
+func TestValidateAndSanitizeCompensationRequest(t *testing.T) {
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.CompensationRequest{
+			BaseSalary:   " 8500000.50 ",
+			Currency:     "idr",
+			PayFrequency: "Monthly",
+		}
+
+		err := ValidateAndSanitizeCompensationRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "8500000.50", req.BaseSalary)
+		assert.Equal(t, "IDR", req.Currency)
+		assert.Equal(t, domain.PayFrequencyMonthly, req.PayFrequency)
+	})
+
+	t.Run("invalid salary", func(t *testing.T) {
+		for _, salary := range []string{"", "0", "-10", "10.123", "1e6"} {
+			req := domain.CompensationRequest{BaseSalary: salary, Currency: "USD", PayFrequency: "annual"}
+
+			err := ValidateAndSanitizeCompensationRequest(&req)
+			assert.ErrorIs(t, err, ErrInvalidSalary, salary)
+		}
+	})
+
+	t.Run("invalid currency", func(t *testing.T) {
+		req := domain.CompensationRequest{BaseSalary: "100", Currency: "ABC", PayFrequency: "annual"}
+
+		err := ValidateAndSanitizeCompensationRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidCurrency)
+	})
+
+	t.Run("invalid pay frequency", func(t *testing.T) {
+		req := domain.CompensationRequest{BaseSalary: "100", Currency: "USD", PayFrequency: "daily"}
+
+		err := ValidateAndSanitizeCompensationRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidPayFrequency)
+	})
+}
+
+func TestValidateAndSanitizeLeaveTypeRequest(t *testing.T) {
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.LeaveTypeRequest{Code: " Annual ", Name: " Annual  Leave ", AnnualDays: 12, CarryOverDays: 5}
+
+		err := ValidateAndSanitizeLeaveTypeRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "annual", req.Code)
+		assert.Equal(t, "Annual Leave", req.Name)
+	})
+
+	t.Run("empty code", func(t *testing.T) {
+		req := domain.LeaveTypeRequest{Name: "Annual Leave"}
+
+		err := ValidateAndSanitizeLeaveTypeRequest(&req)
+		assert.ErrorIs(t, err, ErrEmptyCode)
+	})
+
+	t.Run("negative days", func(t *testing.T) {
+		req := domain.LeaveTypeRequest{Code: "annual", Name: "Annual Leave", AnnualDays: -1}
+
+		err := ValidateAndSanitizeLeaveTypeRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidLeaveDays)
+	})
+}
+
+func TestValidateAndSanitizeCalendarRequest(t *testing.T) {
+
+	t.Run("success with default working days", func(t *testing.T) {
+		req := domain.WorkCalendarRequest{Name: " Indonesia  Jakarta ", Country: "id"}
+
+		err := ValidateAndSanitizeCalendarRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Indonesia Jakarta", req.Name)
+		assert.Equal(t, "ID", req.Country)
+		assert.Equal(t, []string{"mon", "tue", "wed", "thu", "fri"}, req.WorkingDays)
+	})
+
+	t.Run("invalid country", func(t *testing.T) {
+		req := domain.WorkCalendarRequest{Name: "Nowhere", Country: "XX"}
+
+		err := ValidateAndSanitizeCalendarRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidCountry)
+	})
+}
+
+func TestValidateAndSanitizeAttendanceRequest(t *testing.T) {
+	lat, lng := -6.2, 106.8
+	invalid := 91.0
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.AttendanceRequest{Latitude: &lat, Longitude: &lng, Notes: " kiosk 2 "}
+
+		err := ValidateAndSanitizeAttendanceRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "kiosk 2", req.Notes)
+	})
+
+	t.Run("latitude without longitude", func(t *testing.T) {
+		req := domain.AttendanceRequest{Latitude: &lat}
+
+		err := ValidateAndSanitizeAttendanceRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidGeotag)
+	})
+
+	t.Run("latitude out of range", func(t *testing.T) {
+		req := domain.AttendanceRequest{Latitude: &invalid, Longitude: &lng}
+
+		err := ValidateAndSanitizeAttendanceRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidGeotag)
+	})
+
+	t.Run("notes too long", func(t *testing.T) {
+		req := domain.AttendanceRequest{Notes: strings.Repeat("a", 501)}
+
+		err := ValidateAndSanitizeAttendanceRequest(&req)
+		assert.ErrorIs(t, err, ErrNotesTooLong)
+	})
+}
+
+func TestValidateAndSanitizeReviewCycleRequest(t *testing.T) {
+	t.Run("default scale", func(t *testing.T) {
+		req := domain.ReviewCycleRequest{Name: " 2024  Annual Review "}
+
+		err := ValidateAndSanitizeReviewCycleRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "2024 Annual Review", req.Name)
+		assert.Equal(t, 1, req.RatingMin)
+		assert.Equal(t, 5, req.RatingMax)
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		req := domain.ReviewCycleRequest{}
+
+		err := ValidateAndSanitizeReviewCycleRequest(&req)
+		assert.ErrorIs(t, err, ErrEmptyName)
+	})
+
+	t.Run("invalid scale", func(t *testing.T) {
+		req := domain.ReviewCycleRequest{Name: "Q1", RatingMin: 5, RatingMax: 1}
+
+		err := ValidateAndSanitizeReviewCycleRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidRatingScale)
+	})
+}
+
+func TestValidateAndSanitizeAssessmentRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req := domain.AssessmentRequest{Assessment: " met all goals "}
+
+		err := ValidateAndSanitizeAssessmentRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "met all goals", req.Assessment)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		req := domain.AssessmentRequest{Assessment: strings.Repeat("a", 10001)}
+
+		err := ValidateAndSanitizeAssessmentRequest(&req)
+		assert.ErrorIs(t, err, ErrAssessmentTooLong)
+	})
+}
+
+func TestValidateAndSanitizeChecklistItemRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req := domain.ChecklistItemRequest{Kind: " Offboarding ", Title: " Revoke  badge ", DueDays: 0}
+
+		err := ValidateAndSanitizeChecklistItemRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ChecklistOffboarding, req.Kind)
+		assert.Equal(t, "Revoke badge", req.Title)
+	})
+
+	t.Run("invalid kind", func(t *testing.T) {
+		req := domain.ChecklistItemRequest{Kind: "probation", Title: "Review"}
+
+		err := ValidateAndSanitizeChecklistItemRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidChecklistKind)
+	})
+
+	t.Run("empty title", func(t *testing.T) {
+		req := domain.ChecklistItemRequest{Kind: domain.ChecklistOnboarding}
+
+		err := ValidateAndSanitizeChecklistItemRequest(&req)
+		assert.ErrorIs(t, err, ErrEmptyTitle)
+	})
+
+	t.Run("due days out of range", func(t *testing.T) {
+		req := domain.ChecklistItemRequest{Kind: domain.ChecklistOnboarding, Title: "Laptop", DueDays: -400}
+
+		err := ValidateAndSanitizeChecklistItemRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidDueDays)
+	})
+}
+
+func TestValidateAndSanitizePhoneRequest(t *testing.T) {
+	t.Run("success strips separators", func(t *testing.T) {
+		req := domain.PhoneRequest{Number: " +62 (812) 3456-7890 "}
+
+		err := ValidateAndSanitizePhoneRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "+6281234567890", req.Number)
+		assert.Equal(t, domain.PhoneTypeMobile, req.Type)
+	})
+
+	t.Run("missing country code", func(t *testing.T) {
+		req := domain.PhoneRequest{Number: "081234567890"}
+
+		err := ValidateAndSanitizePhoneRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidPhone)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		req := domain.PhoneRequest{Number: "+1234567890123456"}
+
+		err := ValidateAndSanitizePhoneRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidPhone)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		req := domain.PhoneRequest{Type: "fax", Number: "+6281234567890"}
+
+		err := ValidateAndSanitizePhoneRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidPhoneType)
+	})
+}
+
+func TestValidateAndSanitizeAddressRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req := domain.AddressRequest{Type: "Home", Line1: " Jl.  Sudirman 1 ", City: "Jakarta", PostalCode: " 10220 ", Country: "id"}
+
+		err := ValidateAndSanitizeAddressRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AddressTypeHome, req.Type)
+		assert.Equal(t, "Jl. Sudirman 1", req.Line1)
+		assert.Equal(t, "ID", req.Country)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		req := domain.AddressRequest{Type: "work", Line1: "Jl. Sudirman 1", City: "Jakarta", Country: "ID"}
+
+		err := ValidateAndSanitizeAddressRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidAddressType)
+	})
+
+	t.Run("empty city", func(t *testing.T) {
+		req := domain.AddressRequest{Type: "mailing", Line1: "PO Box 1", Country: "ID"}
+
+		err := ValidateAndSanitizeAddressRequest(&req)
+		assert.ErrorIs(t, err, ErrEmptyAddress)
+	})
+
+	t.Run("invalid country", func(t *testing.T) {
+		req := domain.AddressRequest{Type: "home", Line1: "Jl. Sudirman 1", City: "Jakarta", Country: "IDN"}
+
+		err := ValidateAndSanitizeAddressRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidCountry)
+	})
+}
+
+func TestValidateAndSanitizeEmergencyContactRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req := domain.EmergencyContactRequest{Name: " siti  aminah ", Relationship: " Spouse ", Phone: "+62 812 3456 7890"}
+
+		err := ValidateAndSanitizeEmergencyContactRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "Siti Aminah", req.Name)
+		assert.Equal(t, "spouse", req.Relationship)
+		assert.Equal(t, "+6281234567890", req.Phone)
+	})
+
+	t.Run("empty relationship", func(t *testing.T) {
+		req := domain.EmergencyContactRequest{Name: "Siti", Phone: "+6281234567890"}
+
+		err := ValidateAndSanitizeEmergencyContactRequest(&req)
+		assert.ErrorIs(t, err, ErrEmptyRelationship)
+	})
+
+	t.Run("invalid email", func(t *testing.T) {
+		req := domain.EmergencyContactRequest{Name: "Siti", Relationship: "spouse", Phone: "+6281234567890", Email: "siti"}
+
+		err := ValidateAndSanitizeEmergencyContactRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidEmail)
+	})
+}
+
+func TestValidateAndSanitizeCustomFieldRequest(t *testing.T) {
+	t.Run("success enum", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: " Shirt_Size ", Label: " Shirt  size ", Type: "ENUM", Options: []string{"S", " M ", "M", ""}}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "shirt_size", req.Name)
+		assert.Equal(t, "Shirt size", req.Label)
+		assert.Equal(t, []string{"S", "M"}, req.Options)
+	})
+
+	t.Run("options dropped for non enum", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: "badge", Type: "number", Options: []string{"1"}}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.NoError(t, err)
+		assert.Nil(t, req.Options)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: "1st-field", Type: "string"}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidCustomFieldName)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: "badge", Type: "json"}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidCustomFieldType)
+	})
+
+	t.Run("pattern on number field", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: "badge", Type: "number", Pattern: `^\d+$`}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidPattern)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: "badge", Type: "string", Pattern: `^(\d+$`}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidPattern)
+	})
+
+	t.Run("enum without options", func(t *testing.T) {
+		req := domain.CustomFieldRequest{Name: "size", Type: "enum", Options: []string{" "}}
+
+		err := ValidateAndSanitizeCustomFieldRequest(&req)
+		assert.ErrorIs(t, err, ErrMissingOptions)
+	})
+}
+
+func TestValidateAndSanitizeDocumentRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req := domain.DocumentRequest{Category: " Contract ", FileName: `C:\Users\hr\contract` + "\x00" + `.pdf`}
+
+		err := ValidateAndSanitizeDocumentRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "contract", req.Category)
+		assert.Equal(t, "contract.pdf", req.FileName)
+	})
+
+	t.Run("default category", func(t *testing.T) {
+		req := domain.DocumentRequest{FileName: "../../etc/passwd"}
+
+		err := ValidateAndSanitizeDocumentRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.DocumentOther, req.Category)
+		assert.Equal(t, "passwd", req.FileName)
+	})
+
+	t.Run("invalid category", func(t *testing.T) {
+		req := domain.DocumentRequest{Category: "payslip", FileName: "a.pdf"}
+
+		err := ValidateAndSanitizeDocumentRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidDocumentCategory)
+	})
+
+	t.Run("empty file name", func(t *testing.T) {
+		req := domain.DocumentRequest{FileName: " "}
+
+		err := ValidateAndSanitizeDocumentRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidFileName)
+	})
+}
+
+func TestValidateAndSanitizeWebhookRequest(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		req := domain.WebhookRequest{Url: " https://hooks.example.com/employees ", EventTypes: []string{"Employee.Created", "employee.created", " employee.deleted "}}
+
+		err := ValidateAndSanitizeWebhookRequest(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://hooks.example.com/employees", req.Url)
+		assert.Equal(t, []string{"employee.created", "employee.deleted"}, req.EventTypes)
+	})
+
+	t.Run("invalid url", func(t *testing.T) {
+		for _, u := range []string{"", "hooks.example.com", "ftp://hooks.example.com", "https://"} {
+			req := domain.WebhookRequest{Url: u, EventTypes: []string{"employee.created"}}
+
+			err := ValidateAndSanitizeWebhookRequest(&req)
+			assert.ErrorIs(t, err, ErrInvalidWebhookUrl, u)
+		}
+	})
+
+	t.Run("invalid event types", func(t *testing.T) {
+		req := domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{"employee.hired"}}
+		assert.ErrorIs(t, ValidateAndSanitizeWebhookRequest(&req), ErrInvalidEventTypes)
+
+		req = domain.WebhookRequest{Url: "https://hooks.example.com"}
+		assert.ErrorIs(t, ValidateAndSanitizeWebhookRequest(&req), ErrInvalidEventTypes)
+	})
+
+	t.Run("short secret", func(t *testing.T) {
+		req := domain.WebhookRequest{Url: "https://hooks.example.com", EventTypes: []string{"employee.created"}, Secret: "secret"}
+
+		err := ValidateAndSanitizeWebhookRequest(&req)
+		assert.ErrorIs(t, err, ErrInvalidWebhookSecret)
+	})
+}